@@ -0,0 +1,189 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Command-line tool for simulating the Driver Stations of one or more teams, for testing the arena without robots.
+//
+// Example: go run ./cmd/dssim -fms 127.0.0.1 -teams 254,1114,2056
+//
+// Once running, conditions can be changed by typing commands on standard input; type "help" for the list.
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Team254/cheesy-arena/dssim"
+	"github.com/Team254/cheesy-arena/network"
+)
+
+const helpText = `Commands (apply to all teams unless a team number is given at the end):
+  battery <volts> [team]        set the reported battery voltage
+  link <on|off> [team]          connect or disconnect the robot
+  radio <on|off> [team]         connect or disconnect the radio
+  rio <on|off> [team]           connect or disconnect the roboRIO
+  trip <ms> [team]              set the reported DS-robot trip time
+  loss <percent> [team]         set the percentage of control packets that go unanswered
+  status                        show the state of each simulated driver station
+  quit                          disconnect and exit`
+
+func main() {
+	fmsAddress := flag.String("fms", network.DefaultServerIpAddress, "IP address of the FMS")
+	teamsFlag := flag.String("teams", "", "comma-separated list of team numbers to simulate")
+	useLiteUdpPort := flag.Bool("lite", false, "listen for control packets on the FMS Lite port")
+	teamAddresses := flag.Bool(
+		"team-addresses",
+		true,
+		"when the FMS is on a loopback address, bind each team to its own loopback address of the form 127.TE.AM.5 so "+
+			"that several teams can run at once",
+	)
+	battery := flag.Float64("battery", 12.5, "initial battery voltage")
+	tripTimeMs := flag.Int("trip", 5, "initial DS-robot trip time in milliseconds")
+	packetLoss := flag.Float64("loss", 0, "initial percentage of control packets that go unanswered")
+	flag.Parse()
+
+	var teamIds []int
+	for _, team := range strings.Split(*teamsFlag, ",") {
+		teamId, err := strconv.Atoi(strings.TrimSpace(team))
+		if err != nil || teamId <= 0 {
+			log.Fatalf("Invalid team number %q; use -teams to give a comma-separated list of team numbers.", team)
+		}
+		teamIds = append(teamIds, teamId)
+	}
+	useTeamAddresses := *teamAddresses && net.ParseIP(*fmsAddress).IsLoopback()
+	if len(teamIds) > 1 && !useTeamAddresses {
+		log.Fatalln(
+			"Only one team can be simulated per computer unless the FMS is on a loopback address, since each driver " +
+				"station must receive control packets on the same port.",
+		)
+	}
+
+	simulators := make(map[int]*dssim.Simulator)
+	for _, teamId := range teamIds {
+		config := dssim.Config{TeamId: teamId, FmsAddress: *fmsAddress, UseLiteUdpPort: *useLiteUdpPort}
+		if useTeamAddresses {
+			config.LocalAddress = fmt.Sprintf("127.%d.%d.5", teamId/100, teamId%100)
+		}
+		sim := dssim.NewSimulator(config)
+		conditions := sim.Conditions()
+		conditions.BatteryVoltage = *battery
+		conditions.TripTimeMs = *tripTimeMs
+		conditions.PacketLossPercent = *packetLoss
+		sim.SetConditions(conditions)
+		if err := sim.Start(); err != nil {
+			log.Fatalf("Error starting simulated driver station for Team %d: %v", teamId, err)
+		}
+		defer sim.Stop()
+		simulators[teamId] = sim
+	}
+
+	fmt.Println(helpText)
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "quit", "exit":
+			return
+		case "help":
+			fmt.Println(helpText)
+		case "status":
+			for _, teamId := range teamIds {
+				printStatus(teamId, simulators[teamId])
+			}
+		default:
+			if err := handleCommand(fields, simulators); err != nil {
+				fmt.Println(err)
+			}
+		}
+	}
+}
+
+// Applies the given command that changes the conditions of one or all of the simulated driver stations.
+func handleCommand(fields []string, simulators map[int]*dssim.Simulator) error {
+	if len(fields) < 2 || len(fields) > 3 {
+		return fmt.Errorf("invalid command; type \"help\" for the list of commands")
+	}
+	targets := simulators
+	if len(fields) == 3 {
+		teamId, _ := strconv.Atoi(fields[2])
+		sim, ok := simulators[teamId]
+		if !ok {
+			return fmt.Errorf("team %s is not being simulated", fields[2])
+		}
+		targets = map[int]*dssim.Simulator{teamId: sim}
+	}
+
+	value := fields[1]
+	number, numberErr := strconv.ParseFloat(value, 64)
+	on := value == "on"
+	if !on && value != "off" && (fields[0] == "link" || fields[0] == "radio" || fields[0] == "rio") {
+		return fmt.Errorf("expected \"on\" or \"off\"; got %q", value)
+	}
+	if numberErr != nil && (fields[0] == "battery" || fields[0] == "trip" || fields[0] == "loss") {
+		return fmt.Errorf("expected a number; got %q", value)
+	}
+
+	for _, sim := range targets {
+		conditions := sim.Conditions()
+		switch fields[0] {
+		case "battery":
+			conditions.BatteryVoltage = number
+		case "link":
+			conditions.RobotLinked = on
+		case "radio":
+			conditions.RadioLinked = on
+		case "rio":
+			conditions.RioLinked = on
+		case "trip":
+			conditions.TripTimeMs = int(number)
+		case "loss":
+			conditions.PacketLossPercent = number
+		default:
+			return fmt.Errorf("unknown command %q; type \"help\" for the list of commands", fields[0])
+		}
+		sim.SetConditions(conditions)
+	}
+	return nil
+}
+
+// Prints a one-line summary of the given simulated driver station's state.
+func printStatus(teamId int, sim *dssim.Simulator) {
+	status := sim.Status()
+	conditions := sim.Conditions()
+	mode := "Disabled"
+	if status.EStop {
+		mode = "E-Stopped"
+	} else if status.AStop {
+		mode = "A-Stopped"
+	} else if status.Enabled && status.Auto {
+		mode = "Auto"
+	} else if status.Enabled {
+		mode = "Teleop"
+	}
+	fmt.Printf(
+		"Team %d %s: connected=%t wrongStation=%t mode=%s remaining=%ds packets=%d missed=%d | robot=%t radio=%t "+
+			"rio=%t battery=%.2fV trip=%dms loss=%.0f%%\n",
+		teamId,
+		status.AllianceStation,
+		status.Connected,
+		status.WrongStation,
+		mode,
+		status.MatchSecondsRemaining,
+		status.ControlPacketCount,
+		status.MissedPacketCount,
+		conditions.RobotLinked,
+		conditions.RadioLinked,
+		conditions.RioLinked,
+		conditions.BatteryVoltage,
+		conditions.TripTimeMs,
+		conditions.PacketLossPercent,
+	)
+}
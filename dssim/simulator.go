@@ -0,0 +1,381 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Simulator of an FRC Driver Station's side of the FMS protocol, for exercising the arena without real robots.
+
+package dssim
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Ports used by the FMS and the Driver Station; these mirror the ones in field/driver_station_connection.go.
+const (
+	fmsTcpPort              = 1750
+	fmsUdpReceivePort       = 1160
+	dsUdpReceivePort        = 1121
+	dsUdpReceivePortLite    = 1120
+	statusPacketPeriod      = 500 * time.Millisecond
+	handshakeTimeout        = 3 * time.Second
+	maxTcpPacketBytes       = 4096
+	tcpStatusPacketType     = 22
+	tcpTeamPacketType       = 24
+	tcpAssignmentPacketType = 25
+	tcpGameDataPacketType   = 28
+)
+
+var allianceStations = []string{"R1", "R2", "R3", "B1", "B2", "B3"}
+
+// Config specifies which team the simulator connects as and where it finds the FMS.
+type Config struct {
+	TeamId int
+
+	// IP address of the FMS.
+	FmsAddress string
+
+	// Optional local IP address to bind to, so that several simulators can run on the same computer.
+	LocalAddress string
+
+	// Whether to listen for control packets on the port used by FMS Lite rather than the one used by the full FMS.
+	UseLiteUdpPort bool
+
+	// Overrides for testing; the standard ports and timing are used if these are zero.
+	FmsTcpPort   int
+	FmsUdpPort   int
+	DsUdpPort    int
+	StatusPeriod time.Duration
+}
+
+// Conditions are the robot and network conditions the simulator reports back to the FMS.
+type Conditions struct {
+	RadioLinked       bool
+	RioLinked         bool
+	RobotLinked       bool
+	BatteryVoltage    float64
+	TripTimeMs        int
+	PacketLossPercent float64
+}
+
+// Status is what the simulator has been told by the FMS.
+type Status struct {
+	Connected             bool
+	AllianceStation       string
+	WrongStation          bool
+	Auto                  bool
+	Enabled               bool
+	EStop                 bool
+	AStop                 bool
+	MatchSecondsRemaining int
+	ControlPacketCount    int
+	MissedPacketCount     int
+	GameData              string
+}
+
+// Simulator impersonates a single team's Driver Station.
+type Simulator struct {
+	config      Config
+	mutex       sync.Mutex
+	conditions  Conditions
+	status      Status
+	random      *rand.Rand
+	tcpConn     net.Conn
+	udpListener *net.UDPConn
+	udpConn     net.Conn
+	udpSequence int
+	done        chan struct{}
+	waitGroup   sync.WaitGroup
+}
+
+// DefaultConditions returns the conditions of a healthy robot.
+func DefaultConditions() Conditions {
+	return Conditions{RadioLinked: true, RioLinked: true, RobotLinked: true, BatteryVoltage: 12.5, TripTimeMs: 5}
+}
+
+// NewSimulator creates a simulator for the given configuration, reporting a healthy robot until told otherwise.
+func NewSimulator(config Config) *Simulator {
+	if config.FmsTcpPort == 0 {
+		config.FmsTcpPort = fmsTcpPort
+	}
+	if config.FmsUdpPort == 0 {
+		config.FmsUdpPort = fmsUdpReceivePort
+	}
+	if config.DsUdpPort == 0 {
+		config.DsUdpPort = dsUdpReceivePort
+		if config.UseLiteUdpPort {
+			config.DsUdpPort = dsUdpReceivePortLite
+		}
+	}
+	if config.StatusPeriod == 0 {
+		config.StatusPeriod = statusPacketPeriod
+	}
+	return &Simulator{
+		config:     config,
+		conditions: DefaultConditions(),
+		random:     rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Start connects to the FMS as the configured team and begins exchanging packets with it in the background. Returns
+// an error if the FMS can't be reached or doesn't accept the team.
+func (sim *Simulator) Start() error {
+	// Listen for control packets before connecting, since the FMS starts sending them as soon as it accepts the team.
+	udpListener, err := net.ListenUDP(
+		"udp4", &net.UDPAddr{IP: net.ParseIP(sim.config.LocalAddress), Port: sim.config.DsUdpPort},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to listen for control packets: %v", err)
+	}
+
+	dialer := net.Dialer{Timeout: handshakeTimeout}
+	if sim.config.LocalAddress != "" {
+		dialer.LocalAddr = &net.TCPAddr{IP: net.ParseIP(sim.config.LocalAddress)}
+	}
+	tcpConn, err := dialer.Dial("tcp4", net.JoinHostPort(sim.config.FmsAddress, strconv.Itoa(sim.config.FmsTcpPort)))
+	if err != nil {
+		udpListener.Close()
+		return fmt.Errorf("failed to connect to FMS: %v", err)
+	}
+	station, wrongStation, err := sim.handshake(tcpConn)
+	if err != nil {
+		tcpConn.Close()
+		udpListener.Close()
+		return err
+	}
+
+	udpDialer := net.Dialer{}
+	if sim.config.LocalAddress != "" {
+		udpDialer.LocalAddr = &net.UDPAddr{IP: net.ParseIP(sim.config.LocalAddress)}
+	}
+	udpConn, err := udpDialer.Dial(
+		"udp4", net.JoinHostPort(sim.config.FmsAddress, strconv.Itoa(sim.config.FmsUdpPort)),
+	)
+	if err != nil {
+		tcpConn.Close()
+		udpListener.Close()
+		return fmt.Errorf("failed to open UDP connection to FMS: %v", err)
+	}
+
+	sim.mutex.Lock()
+	sim.tcpConn = tcpConn
+	sim.udpListener = udpListener
+	sim.udpConn = udpConn
+	sim.done = make(chan struct{})
+	sim.status = Status{Connected: true, AllianceStation: station, WrongStation: wrongStation}
+	sim.mutex.Unlock()
+	log.Printf("Simulated driver station for Team %d assigned to station %s.", sim.config.TeamId, station)
+
+	sim.waitGroup.Add(3)
+	go sim.listenForControlPackets()
+	go sim.listenForTcpPackets()
+	go sim.sendStatusPackets()
+	return nil
+}
+
+// Stop disconnects from the FMS and waits for the background goroutines to finish.
+func (sim *Simulator) Stop() {
+	sim.mutex.Lock()
+	if sim.done == nil {
+		sim.mutex.Unlock()
+		return
+	}
+	close(sim.done)
+	sim.done = nil
+	sim.tcpConn.Close()
+	sim.udpListener.Close()
+	sim.udpConn.Close()
+	sim.status.Connected = false
+	sim.mutex.Unlock()
+	sim.waitGroup.Wait()
+}
+
+// SetConditions changes the robot and network conditions reported from now on.
+func (sim *Simulator) SetConditions(conditions Conditions) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	sim.conditions = conditions
+}
+
+// Conditions returns the robot and network conditions currently being reported.
+func (sim *Simulator) Conditions() Conditions {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	return sim.conditions
+}
+
+// Status returns a snapshot of what the simulator has most recently been told by the FMS.
+func (sim *Simulator) Status() Status {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	return sim.status
+}
+
+// Sends the team number to the FMS and waits for its station assignment.
+func (sim *Simulator) handshake(tcpConn net.Conn) (string, bool, error) {
+	teamPacket := [5]byte{0, 3, tcpTeamPacketType, byte(sim.config.TeamId >> 8), byte(sim.config.TeamId & 0xff)}
+	if _, err := tcpConn.Write(teamPacket[:]); err != nil {
+		return "", false, fmt.Errorf("failed to send team number to FMS: %v", err)
+	}
+
+	var assignmentPacket [5]byte
+	tcpConn.SetReadDeadline(time.Now().Add(handshakeTimeout))
+	if _, err := io.ReadFull(tcpConn, assignmentPacket[:]); err != nil {
+		return "", false, fmt.Errorf(
+			"FMS did not accept Team %d; is it in the current match? (%v)", sim.config.TeamId, err,
+		)
+	}
+	tcpConn.SetReadDeadline(time.Time{})
+	if assignmentPacket[2] != tcpAssignmentPacketType || int(assignmentPacket[3]) >= len(allianceStations) {
+		return "", false, fmt.Errorf("invalid station assignment packet received: %v", assignmentPacket)
+	}
+	return allianceStations[assignmentPacket[3]], assignmentPacket[4] != 0, nil
+}
+
+// Loops to receive control packets from the FMS and answer each one with a status packet.
+func (sim *Simulator) listenForControlPackets() {
+	defer sim.waitGroup.Done()
+	var data [64]byte
+	for {
+		n, err := sim.udpListener.Read(data[:])
+		if err != nil {
+			return
+		}
+		if n < 22 {
+			continue
+		}
+
+		sim.mutex.Lock()
+		sim.decodeControlPacket(data[:n])
+		dropped := sim.random.Float64()*100 < sim.conditions.PacketLossPercent
+		if dropped {
+			sim.status.MissedPacketCount++
+		}
+		packet := sim.encodeUdpStatusPacket()
+		sim.mutex.Unlock()
+
+		if !dropped {
+			sim.udpConn.Write(packet[:])
+		}
+	}
+}
+
+// Updates the status from the given control packet; must be called with the mutex held.
+func (sim *Simulator) decodeControlPacket(data []byte) {
+	sim.status.ControlPacketCount++
+	sim.status.Auto = data[3]&0x02 != 0
+	sim.status.Enabled = data[3]&0x04 != 0
+	sim.status.AStop = data[3]&0x40 != 0
+	sim.status.EStop = data[3]&0x80 != 0
+	if int(data[5]) < len(allianceStations) {
+		sim.status.AllianceStation = allianceStations[data[5]]
+	}
+	sim.status.MatchSecondsRemaining = int(data[20])<<8 + int(data[21])
+}
+
+// Serializes the robot status into a UDP packet for the FMS; must be called with the mutex held.
+func (sim *Simulator) encodeUdpStatusPacket() [8]byte {
+	var packet [8]byte
+
+	// Packet number, stored big-endian in two bytes.
+	packet[0] = byte((sim.udpSequence >> 8) & 0xff)
+	packet[1] = byte(sim.udpSequence & 0xff)
+	sim.udpSequence++
+
+	// Protocol version.
+	packet[2] = 0
+
+	// Link status byte, echoing back the control state from the FMS.
+	conditions := sim.conditions
+	if sim.status.Auto {
+		packet[3] |= 0x02
+	}
+	if sim.status.Enabled && conditions.RobotLinked {
+		packet[3] |= 0x04
+	}
+	if conditions.RioLinked {
+		packet[3] |= 0x08
+	}
+	if conditions.RadioLinked {
+		packet[3] |= 0x10
+	}
+	if conditions.RobotLinked {
+		packet[3] |= 0x20
+	}
+	if sim.status.EStop {
+		packet[3] |= 0x80
+	}
+
+	// Team number.
+	packet[4] = byte(sim.config.TeamId >> 8)
+	packet[5] = byte(sim.config.TeamId & 0xff)
+
+	// Battery voltage, stored as volts * 256.
+	if conditions.RobotLinked && conditions.BatteryVoltage > 0 {
+		packet[6] = byte(conditions.BatteryVoltage)
+		packet[7] = byte((conditions.BatteryVoltage - float64(int(conditions.BatteryVoltage))) * 256)
+	}
+	return packet
+}
+
+// Serializes the trip time and missed packet count into a TCP status packet; must be called with the mutex held.
+func (sim *Simulator) encodeTcpStatusPacket() [38]byte {
+	var packet [38]byte
+	packet[0] = 0  // Packet size
+	packet[1] = 36 // Packet size
+	packet[2] = tcpStatusPacketType
+
+	// Average trip time, stored as half-milliseconds, and the count of missed packets, both saturating at one byte.
+	packet[3] = byte(min(sim.conditions.TripTimeMs*2, 255))
+	packet[4] = byte(sim.status.MissedPacketCount & 0xff)
+	return packet
+}
+
+// Loops to periodically send a TCP status packet, which also keeps the connection from timing out.
+func (sim *Simulator) sendStatusPackets() {
+	defer sim.waitGroup.Done()
+	sim.mutex.Lock()
+	done := sim.done
+	sim.mutex.Unlock()
+	ticker := time.NewTicker(sim.config.StatusPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			sim.mutex.Lock()
+			packet := sim.encodeTcpStatusPacket()
+			sim.mutex.Unlock()
+			if _, err := sim.tcpConn.Write(packet[:]); err != nil {
+				log.Printf("Simulated driver station for Team %d failed to send status: %v", sim.config.TeamId, err)
+				return
+			}
+		}
+	}
+}
+
+// Loops to receive TCP packets from the FMS, of which only game data is of interest.
+func (sim *Simulator) listenForTcpPackets() {
+	defer sim.waitGroup.Done()
+	buffer := make([]byte, maxTcpPacketBytes)
+	for {
+		n, err := sim.tcpConn.Read(buffer)
+		if err != nil {
+			sim.mutex.Lock()
+			sim.status.Connected = false
+			sim.mutex.Unlock()
+			return
+		}
+		if n >= 4 && buffer[2] == tcpGameDataPacketType {
+			size := min(int(buffer[3]), n-4)
+			sim.mutex.Lock()
+			sim.status.GameData = string(buffer[4 : 4+size])
+			sim.mutex.Unlock()
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package dssim
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeFms stands in for the arena's driver station listeners.
+type fakeFms struct {
+	tcpListener net.Listener
+	udpListener *net.UDPConn
+	dsUdpPort   int
+}
+
+func newFakeFms(t *testing.T) *fakeFms {
+	tcpListener, err := net.Listen("tcp4", "127.0.0.1:0")
+	assert.Nil(t, err)
+	udpListener, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.ParseIP("127.0.0.1")})
+	assert.Nil(t, err)
+
+	// Find a free port for the simulator to receive control packets on.
+	dsUdpListener, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.ParseIP("127.0.0.1")})
+	assert.Nil(t, err)
+	dsUdpPort := dsUdpListener.LocalAddr().(*net.UDPAddr).Port
+	dsUdpListener.Close()
+
+	t.Cleanup(func() {
+		tcpListener.Close()
+		udpListener.Close()
+	})
+	return &fakeFms{tcpListener, udpListener, dsUdpPort}
+}
+
+func (fms *fakeFms) config(teamId int) Config {
+	return Config{
+		TeamId:       teamId,
+		FmsAddress:   "127.0.0.1",
+		LocalAddress: "127.0.0.1",
+		FmsTcpPort:   fms.tcpListener.Addr().(*net.TCPAddr).Port,
+		FmsUdpPort:   fms.udpListener.LocalAddr().(*net.UDPAddr).Port,
+		DsUdpPort:    fms.dsUdpPort,
+		StatusPeriod: 10 * time.Millisecond,
+	}
+}
+
+// Accepts a connection from the simulator and answers its handshake with the given station assignment.
+func (fms *fakeFms) accept(t *testing.T, teamId int, assignmentPacket [5]byte) net.Conn {
+	tcpConn, err := fms.tcpListener.Accept()
+	assert.Nil(t, err)
+	var teamPacket [5]byte
+	_, err = io.ReadFull(tcpConn, teamPacket[:])
+	assert.Nil(t, err)
+	assert.Equal(t, [5]byte{0, 3, 24, byte(teamId >> 8), byte(teamId & 0xff)}, teamPacket)
+	_, err = tcpConn.Write(assignmentPacket[:])
+	assert.Nil(t, err)
+	t.Cleanup(func() { tcpConn.Close() })
+	return tcpConn
+}
+
+// Sends a control packet with the given status byte to the simulator and returns its UDP reply, if any.
+func (fms *fakeFms) exchangeControlPacket(t *testing.T, status byte) []byte {
+	udpConn, err := net.DialUDP("udp4", nil, &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: fms.dsUdpPort})
+	assert.Nil(t, err)
+	defer udpConn.Close()
+	var controlPacket [22]byte
+	controlPacket[3] = status
+	controlPacket[5] = 4
+	controlPacket[21] = 15
+	_, err = udpConn.Write(controlPacket[:])
+	assert.Nil(t, err)
+
+	var data [50]byte
+	fms.udpListener.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+	n, err := fms.udpListener.Read(data[:])
+	if err != nil {
+		return nil
+	}
+	return data[:n]
+}
+
+func TestSimulatorExchangesPackets(t *testing.T) {
+	fms := newFakeFms(t)
+	simChan := make(chan *Simulator)
+	go func() {
+		simChan <- StartTestSimulator(t, fms.config(1503))
+	}()
+	tcpConn := fms.accept(t, 1503, [5]byte{0, 3, 25, 4, 0})
+	sim := <-simChan
+	status := sim.Status()
+	assert.True(t, status.Connected)
+	assert.Equal(t, "B2", status.AllianceStation)
+	assert.False(t, status.WrongStation)
+
+	// Check that a control packet is decoded and answered with the robot's status.
+	reply := fms.exchangeControlPacket(t, 0x04|0x02)
+	assert.Equal(t, []byte{0, 0, 0, 0x3e, 5, 223, 12, 128}, reply)
+	status = sim.Status()
+	assert.True(t, status.Auto)
+	assert.True(t, status.Enabled)
+	assert.False(t, status.EStop)
+	assert.Equal(t, 15, status.MatchSecondsRemaining)
+	assert.Equal(t, 1, status.ControlPacketCount)
+
+	// Check that changed conditions are reflected in the replies.
+	conditions := sim.Conditions()
+	conditions.RobotLinked = false
+	conditions.TripTimeMs = 40
+	sim.SetConditions(conditions)
+	reply = fms.exchangeControlPacket(t, 0x80)
+	assert.Equal(t, []byte{0, 1, 0, 0x98, 5, 223, 0, 0}, reply)
+	assert.True(t, sim.Status().EStop)
+
+	// Check that dropped packets go unanswered and are counted as missed.
+	conditions.PacketLossPercent = 100
+	sim.SetConditions(conditions)
+	assert.Nil(t, fms.exchangeControlPacket(t, 0))
+	assert.Equal(t, 1, sim.Status().MissedPacketCount)
+
+	// Check that the TCP status packets carry the trip time and missed packet count.
+	var statusPacket [38]byte
+	tcpConn.SetReadDeadline(time.Now().Add(time.Second))
+	_, err := io.ReadFull(tcpConn, statusPacket[:])
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0, 36, 22, 80, 1}, statusPacket[:5])
+
+	// Check that game data from the FMS is picked up.
+	_, err = tcpConn.Write([]byte{0, 5, 28, 3, 'L', 'R', 'L'})
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool { return sim.Status().GameData == "LRL" }, time.Second, 5*time.Millisecond)
+
+	sim.Stop()
+	assert.False(t, sim.Status().Connected)
+}
+
+func TestSimulatorRejected(t *testing.T) {
+	fms := newFakeFms(t)
+	go func() {
+		tcpConn, err := fms.tcpListener.Accept()
+		if err == nil {
+			tcpConn.Close()
+		}
+	}()
+	sim := NewSimulator(fms.config(254))
+	err := sim.Start()
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "FMS did not accept Team 254")
+	}
+	sim.Stop()
+}
@@ -0,0 +1,20 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Helper methods for use in tests in this package and others.
+
+package dssim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// StartTestSimulator starts a simulator with the given configuration, failing the test if it can't connect, and stops
+// it when the test finishes.
+func StartTestSimulator(t *testing.T, config Config) *Simulator {
+	sim := NewSimulator(config)
+	assert.Nil(t, sim.Start())
+	t.Cleanup(sim.Stop)
+	return sim
+}
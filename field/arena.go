@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
@@ -21,6 +22,7 @@ import (
 	"github.com/Team254/cheesy-arena/partner"
 	"github.com/Team254/cheesy-arena/playoff"
 	"github.com/Team254/cheesy-arena/plc"
+	"github.com/Team254/cheesy-arena/tournament"
 )
 
 const (
@@ -35,7 +37,12 @@ const (
 	scheduledBreakDelaySec   = 5
 	remoteStopTimeout        = 5 * time.Second
 	earlyLateThresholdMin    = 2.5
+	fieldBreakDescription    = "Field Break"
 	MaxMatchGapMin           = 20
+
+	// Number of stations per alliance that are wired up to the field hardware (PLC, access point and team signs). Any
+	// further stations are unmanaged, and rely on their teams bringing their own connection to the field network.
+	HardwareStationsPerAlliance = 3
 )
 
 // Progression of match states.
@@ -63,6 +70,10 @@ type StationRpiStatus struct {
 type Arena struct {
 	Database         *model.Database
 	EventSettings    *model.EventSettings
+	FieldNumber      int
+	fieldGroup       *fieldGroup
+	hardwareSettings *model.EventSettings
+	dsListenAddress  string
 	accessPoint      network.AccessPoint
 	networkSwitch    *network.Switch
 	redSCC           *network.SCCSwitch
@@ -107,11 +118,23 @@ type Arena struct {
 	ShowLowerThird                    bool
 	MuteMatchSounds                   bool
 	matchAborted                      bool
+	fieldFaultPauseStartTime          time.Time
 	soundsPlayed                      map[*game.MatchSound]struct{}
 	breakDescription                  string
+	playoffTurnaroundAllianceId       int
+	playoffTurnaroundReadyTime        time.Time
 	preloadedTeams                    *[6]*model.Team
 	pendingSwitchRebootCancel         context.CancelFunc
 	NetworkConfiguring                bool
+	FieldEvents                       []model.FieldEvent
+	stationEventStates                map[string]stationEventState
+	pendingFieldEvents                []model.FieldEvent
+	unsavedFieldEvents                []model.FieldEvent
+	unsavedFieldEventsMutex           sync.Mutex
+	saveFieldEventsMutex              sync.Mutex
+	arenaHooks                        []model.ArenaHook
+	inspectionPassedTeams             map[int]bool
+	InspectionOverride                bool
 }
 
 type AllianceStation struct {
@@ -126,32 +149,60 @@ type AllianceStation struct {
 	RemoteEStop      bool
 	RemoteAStop      bool
 	RemoteLastUpdate time.Time
+	Unmanaged        bool
+	eStopSource      string
+	aStopSource      string
+	MiniMatch        *StationMiniMatch
 }
 
 // Creates the arena and sets it to its initial state.
 func NewArena(dbPath string) (*Arena, error) {
+	database, err := model.OpenDatabase(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	return newFieldArena(database, 1)
+}
+
+// Creates an arena for each of the fields configured in the event settings, all sharing the database at the given path.
+// Each arena drives its own field hardware and displays, while the schedule, results and rankings are shared.
+func NewArenas(dbPath string) ([]*Arena, error) {
+	arena, err := NewArena(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	arenas := []*Arena{arena}
+	for fieldNumber := 2; fieldNumber <= arena.EventSettings.NumFields; fieldNumber++ {
+		fieldArena, err := newFieldArena(arena.Database, fieldNumber)
+		if err != nil {
+			return nil, err
+		}
+		arenas = append(arenas, fieldArena)
+	}
+	newFieldGroup(arenas...)
+	return arenas, nil
+}
+
+// Creates the arena for the given field number using the given database and sets it to its initial state.
+func newFieldArena(database *model.Database, fieldNumber int) (*Arena, error) {
 	arena := new(Arena)
+	arena.Database = database
+	arena.FieldNumber = fieldNumber
+	newFieldGroup(arena)
 	arena.configureNotifiers()
 	arena.Plc = new(plc.ModbusPlc)
 
+	// Create every station that could be in use; those beyond the configured number of teams per alliance stay empty.
 	arena.AllianceStations = make(map[string]*AllianceStation)
-	arena.AllianceStations["R1"] = new(AllianceStation)
-	arena.AllianceStations["R2"] = new(AllianceStation)
-	arena.AllianceStations["R3"] = new(AllianceStation)
-	arena.AllianceStations["B1"] = new(AllianceStation)
-	arena.AllianceStations["B2"] = new(AllianceStation)
-	arena.AllianceStations["B3"] = new(AllianceStation)
+	for _, station := range model.AllianceStationNames(model.MaxTeamsPerAlliance) {
+		arena.AllianceStations[station] = &AllianceStation{Unmanaged: !isHardwareStation(station)}
+	}
 
 	arena.Displays = make(map[string]*Display)
 
 	arena.TeamSigns = NewTeamSigns()
 
-	var err error
-	arena.Database, err = model.OpenDatabase(dbPath)
-	if err != nil {
-		return nil, err
-	}
-	err = arena.LoadSettings()
+	err := arena.LoadSettings()
 	if err != nil {
 		return nil, err
 	}
@@ -184,17 +235,33 @@ func (arena *Arena) LoadSettings() error {
 		return err
 	}
 	arena.EventSettings = settings
-	network.ConfigureFieldNetworkAdapter(settings.FieldNetworkAdapter)
+
+	// Additional fields use their own hardware in place of that given in the event settings.
+	if arena.FieldNumber > 1 {
+		fieldSettings, err := arena.Database.GetFieldSettingsById(arena.FieldNumber)
+		if err != nil {
+			return err
+		}
+		if fieldSettings == nil {
+			fieldSettings = &model.FieldSettings{Id: arena.FieldNumber}
+		}
+		arena.hardwareSettings = fieldSettings.ApplyTo(settings)
+		arena.dsListenAddress = fieldSettings.DriverStationAddress
+	} else {
+		arena.hardwareSettings = settings
+		network.ConfigureFieldNetworkAdapter(settings.FieldNetworkAdapter)
+	}
 
 	// Initialize the components that depend on settings.
-	arena.TeamSigns.Red1.SetId(settings.TeamSignRed1Id)
-	arena.TeamSigns.Red2.SetId(settings.TeamSignRed2Id)
-	arena.TeamSigns.Red3.SetId(settings.TeamSignRed3Id)
-	arena.TeamSigns.RedTimer.SetId(settings.TeamSignRedTimerId)
-	arena.TeamSigns.Blue1.SetId(settings.TeamSignBlue1Id)
-	arena.TeamSigns.Blue2.SetId(settings.TeamSignBlue2Id)
-	arena.TeamSigns.Blue3.SetId(settings.TeamSignBlue3Id)
-	arena.TeamSigns.BlueTimer.SetId(settings.TeamSignBlueTimerId)
+	hardware := arena.hardwareSettings
+	arena.TeamSigns.Red1.SetId(hardware.TeamSignRed1Id)
+	arena.TeamSigns.Red2.SetId(hardware.TeamSignRed2Id)
+	arena.TeamSigns.Red3.SetId(hardware.TeamSignRed3Id)
+	arena.TeamSigns.RedTimer.SetId(hardware.TeamSignRedTimerId)
+	arena.TeamSigns.Blue1.SetId(hardware.TeamSignBlue1Id)
+	arena.TeamSigns.Blue2.SetId(hardware.TeamSignBlue2Id)
+	arena.TeamSigns.Blue3.SetId(hardware.TeamSignBlue3Id)
+	arena.TeamSigns.BlueTimer.SetId(hardware.TeamSignBlueTimerId)
 	accessPointWifiStatuses := [6]*network.TeamWifiStatus{
 		&arena.AllianceStations["R1"].WifiStatus,
 		&arena.AllianceStations["R2"].WifiStatus,
@@ -204,23 +271,23 @@ func (arena *Arena) LoadSettings() error {
 		&arena.AllianceStations["B3"].WifiStatus,
 	}
 	arena.accessPoint.SetSettings(
-		settings.ApAddress,
-		settings.ApPassword,
-		settings.ApChannel,
-		settings.NetworkSecurityEnabled,
+		hardware.ApAddress,
+		hardware.ApPassword,
+		hardware.ApChannel,
+		hardware.NetworkSecurityEnabled,
 		accessPointWifiStatuses,
 	)
-	arena.networkSwitch = network.NewSwitch(settings.SwitchAddress, settings.SwitchPassword)
-	sccUpCommands := strings.Split(settings.SCCUpCommands, "\n")
-	sccDownCommands := strings.Split(settings.SCCDownCommands, "\n")
-	arena.redSCC = network.NewSCCSwitch(settings.RedSCCAddress, settings.SCCUsername, settings.SCCPassword, sccUpCommands, sccDownCommands)
-	arena.blueSCC = network.NewSCCSwitch(settings.BlueSCCAddress, settings.SCCUsername, settings.SCCPassword, sccUpCommands, sccDownCommands)
-	arena.coreSwitch = network.NewNetgearPlusSwitch("Core Switch", settings.CoreSwitchAddress, settings.CoreSwitchPassword)
-	arena.redTeamSwitch = network.NewNetgearPlusSwitch("Red Team Switch", settings.RedTeamSwitchAddress, settings.RedTeamSwitchPassword)
-	arena.redFMSSwitch = network.NewNetgearPlusSwitch("Red FMS Switch", settings.RedFMSSwitchAddress, settings.RedFMSSwitchPassword)
-	arena.blueTeamSwitch = network.NewNetgearPlusSwitch("Blue Team Switch", settings.BlueTeamSwitchAddress, settings.BlueTeamSwitchPassword)
-	arena.blueFMSSwitch = network.NewNetgearPlusSwitch("Blue FMS Switch", settings.BlueFMSSwitchAddress, settings.BlueFMSSwitchPassword)
-	arena.Plc.SetAddress(settings.PlcAddress)
+	arena.networkSwitch = network.NewSwitch(hardware.SwitchAddress, hardware.SwitchPassword)
+	sccUpCommands := strings.Split(hardware.SCCUpCommands, "\n")
+	sccDownCommands := strings.Split(hardware.SCCDownCommands, "\n")
+	arena.redSCC = network.NewSCCSwitch(hardware.RedSCCAddress, hardware.SCCUsername, hardware.SCCPassword, sccUpCommands, sccDownCommands)
+	arena.blueSCC = network.NewSCCSwitch(hardware.BlueSCCAddress, hardware.SCCUsername, hardware.SCCPassword, sccUpCommands, sccDownCommands)
+	arena.coreSwitch = network.NewNetgearPlusSwitch("Core Switch", hardware.CoreSwitchAddress, hardware.CoreSwitchPassword)
+	arena.redTeamSwitch = network.NewNetgearPlusSwitch("Red Team Switch", hardware.RedTeamSwitchAddress, hardware.RedTeamSwitchPassword)
+	arena.redFMSSwitch = network.NewNetgearPlusSwitch("Red FMS Switch", hardware.RedFMSSwitchAddress, hardware.RedFMSSwitchPassword)
+	arena.blueTeamSwitch = network.NewNetgearPlusSwitch("Blue Team Switch", hardware.BlueTeamSwitchAddress, hardware.BlueTeamSwitchPassword)
+	arena.blueFMSSwitch = network.NewNetgearPlusSwitch("Blue FMS Switch", hardware.BlueFMSSwitchAddress, hardware.BlueFMSSwitchPassword)
+	arena.Plc.SetAddress(hardware.PlcAddress)
 	arena.TbaClient = partner.NewTbaClient(settings.TbaEventCode, settings.TbaSecretId, settings.TbaSecret)
 	arena.NexusClient = partner.NewNexusClient(settings.TbaEventCode)
 	arena.BlackmagicClient = partner.NewBlackmagicClient(settings.BlackmagicAddresses)
@@ -232,6 +299,14 @@ func (arena *Arena) LoadSettings() error {
 	game.MatchTiming.WarningRemainingDurationSec = settings.WarningRemainingDurationSec
 	game.UpdateMatchSounds()
 	arena.MatchTimingNotifier.Notify()
+	arena.applyPlayoffTiebreakers()
+
+	if err = arena.LoadArenaHooks(); err != nil {
+		return err
+	}
+	if err = arena.LoadInspections(); err != nil {
+		return err
+	}
 
 	// Reconstruct the playoff tournament in memory.
 	if err = arena.CreatePlayoffTournament(); err != nil {
@@ -288,13 +363,53 @@ func (arena *Arena) UpdatePlayoffTournament() error {
 	return nil
 }
 
+// Recalculates the projected start times of the remaining playoff matches and notifies the displays that show them.
+func (arena *Arena) UpdatePlayoffProjectedTimes() error {
+	if err := arena.PlayoffTournament.UpdateProjectedTimes(
+		arena.Database, time.Now(), arena.EventSettings.PlayoffMinTurnaroundSec,
+	); err != nil {
+		return err
+	}
+	arena.MatchLoadNotifier.Notify()
+	return nil
+}
+
+// Marks the given committed qualification match as requiring a replay for the given reason and inserts the replay into
+// the schedule at the given order.
+func (arena *Arena) ScheduleQualificationReplay(matchId int, reason string, order int) (*model.Match, error) {
+	replay, err := tournament.ScheduleQualificationReplay(arena.Database, matchId, reason, order)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pick up the renumbering of the loaded match in case it was shifted to make room for the replay, so that it isn't
+	// reverted when the match is committed.
+	if arena.CurrentMatch.Type == model.Qualification {
+		currentMatch, err := arena.Database.GetMatchById(arena.CurrentMatch.Id)
+		if err != nil {
+			return nil, err
+		}
+		if currentMatch != nil {
+			arena.CurrentMatch.TypeOrder = currentMatch.TypeOrder
+		}
+	}
+	arena.QueueStatusNotifier.Notify()
+	return replay, nil
+}
+
 // Sets up the arena for the given match.
 func (arena *Arena) LoadMatch(match *model.Match) error {
 	if arena.MatchState != PreMatch && arena.MatchState != TimeoutActive {
 		return fmt.Errorf("Cannot load match while there is a match still in progress or with results pending")
 	}
 
+	if fieldNumber := arena.fieldGroup.claimMatch(arena.FieldNumber, match); fieldNumber != 0 {
+		return fmt.Errorf("Cannot load match %s since it is already loaded on Field %d", match.ShortName, fieldNumber)
+	}
+
+	arena.stopAllStationMiniMatches("ended on match load")
 	arena.CurrentMatch = match
+	arena.InspectionOverride = false
 
 	loadedByNexus := false
 	if match.ShouldAllowNexusSubstitution() && arena.EventSettings.NexusEnabled {
@@ -303,7 +418,7 @@ func (arena *Arena) LoadMatch(match *model.Match) error {
 		if err != nil {
 			log.Printf("Failed to load lineup from Nexus: %s", err.Error())
 		} else {
-			err = arena.SubstituteTeams(lineup[0], lineup[1], lineup[2], lineup[3], lineup[4], lineup[5])
+			err = arena.SubstituteTeams(lineup[:3], lineup[3:])
 			if err != nil {
 				log.Printf("Failed to substitute teams using Nexus lineup; loading match normally: %s", err.Error())
 			} else {
@@ -316,50 +431,31 @@ func (arena *Arena) LoadMatch(match *model.Match) error {
 	}
 
 	if !loadedByNexus {
-		err := arena.assignTeam(match.Red1, "R1")
-		if err != nil {
-			return err
-		}
-		err = arena.assignTeam(match.Red2, "R2")
-		if err != nil {
-			return err
-		}
-		err = arena.assignTeam(match.Red3, "R3")
-		if err != nil {
-			return err
-		}
-		err = arena.assignTeam(match.Blue1, "B1")
-		if err != nil {
-			return err
-		}
-		err = arena.assignTeam(match.Blue2, "B2")
-		if err != nil {
-			return err
-		}
-		err = arena.assignTeam(match.Blue3, "B3")
-		if err != nil {
-			return err
+		for _, station := range model.AllianceStationNames(model.MaxTeamsPerAlliance) {
+			teamId := 0
+			if arena.isStationInUse(station) {
+				teamId = match.TeamIdForStation(station)
+			}
+			if err := arena.assignTeam(teamId, station); err != nil {
+				return err
+			}
 		}
+		arena.setupNetwork(arena.hardwareStationTeams(), false)
+	}
 
-		arena.setupNetwork(
-			[6]*model.Team{
-				arena.AllianceStations["R1"].Team,
-				arena.AllianceStations["R2"].Team,
-				arena.AllianceStations["R3"].Team,
-				arena.AllianceStations["B1"].Team,
-				arena.AllianceStations["B2"].Team,
-				arena.AllianceStations["B3"].Team,
-			},
-			false,
-		)
+	if err := arena.updatePlayoffTurnaround(); err != nil {
+		return err
 	}
 
 	// Reset the arena state and realtime scores.
 	arena.soundsPlayed = make(map[*game.MatchSound]struct{})
 	arena.RedRealtimeScore = NewRealtimeScore()
 	arena.BlueRealtimeScore = NewRealtimeScore()
+	arena.RedRealtimeScore.CurrentScore.NumRobots = arena.EventSettings.TeamsPerAlliance
+	arena.BlueRealtimeScore.CurrentScore.NumRobots = arena.EventSettings.TeamsPerAlliance
 	arena.ScoringPanelRegistry.resetScoreCommitted()
 	arena.Plc.ResetMatch()
+	arena.resetFieldEvents()
 
 	// Notify any listeners about the new match.
 	arena.MatchLoadNotifier.Notify()
@@ -367,6 +463,9 @@ func (arena *Arena) LoadMatch(match *model.Match) error {
 	arena.AllianceStationDisplayMode = "match"
 	arena.AllianceStationDisplayModeNotifier.Notify()
 	arena.ScoringStatusNotifier.Notify()
+	if err := arena.callQueueTeams(); err != nil {
+		log.Printf("Failed to call teams to queue: %s", err.Error())
+	}
 
 	return nil
 }
@@ -383,23 +482,56 @@ func (arena *Arena) LoadNextMatch(startScheduledBreak bool) error {
 		return err
 	}
 	if nextMatch == nil {
+		practiceQueueActive, err := arena.practiceQueueActive()
+		if err != nil {
+			return err
+		}
+		if practiceQueueActive {
+			// Fill the next practice match from the open queue if there is anyone waiting in it.
+			queue, err := arena.Database.GetAllPracticeQueueEntries()
+			if err != nil {
+				return err
+			}
+			if len(queue) > 0 {
+				return arena.LoadNextQueuedPracticeMatch()
+			}
+		}
 		return arena.LoadTestMatch()
 	}
 	err = arena.LoadMatch(nextMatch)
 	if err != nil {
+		if arena.fieldGroup.isMatchLoadedOnOtherField(arena.FieldNumber, nextMatch.Id) {
+			// Another field claimed the match after it was picked; move on to the one after it.
+			return arena.LoadNextMatch(startScheduledBreak)
+		}
 		return err
 	}
 
-	// Start the timeout timer if there is a scheduled break before this match.
+	// Start the timeout timer if there is a scheduled break before this match, unless it is only a label without a
+	// duration (such as the end of a day).
 	if startScheduledBreak {
 		scheduledBreak, err := arena.Database.GetScheduledBreakByMatchTypeOrder(nextMatch.Type, nextMatch.TypeOrder)
 		if err != nil {
 			return err
 		}
+		scheduledBreakDelay := time.Second * scheduledBreakDelaySec
 		if scheduledBreak != nil {
+			if scheduledBreak.DurationSec > 0 {
+				go func() {
+					time.Sleep(scheduledBreakDelay)
+					_ = arena.StartTimeout(scheduledBreak.Description, scheduledBreak.DurationSec)
+				}()
+			}
+		} else if remaining := time.Until(arena.playoffTurnaroundReadyTime); remaining > scheduledBreakDelay {
+			// Give the alliances a visible countdown to when they need to be back on the field, unless the FTA has
+			// loaded a different match in the meantime.
+			remainingSec := int(math.Ceil((remaining - scheduledBreakDelay).Seconds()))
+			matchId := nextMatch.Id
 			go func() {
-				time.Sleep(time.Second * scheduledBreakDelaySec)
-				_ = arena.StartTimeout(scheduledBreak.Description, scheduledBreak.DurationSec)
+				time.Sleep(scheduledBreakDelay)
+				if arena.CurrentMatch.Id == matchId {
+					_ = arena.StartTimeout(fieldBreakDescription, remainingSec)
+				}
 			}()
 		}
 	}
@@ -407,51 +539,70 @@ func (arena *Arena) LoadNextMatch(startScheduledBreak bool) error {
 	return nil
 }
 
-// Assigns the given team to the given station, also substituting it into the match record.
-func (arena *Arena) SubstituteTeams(red1, red2, red3, blue1, blue2, blue3 int) error {
-	if !arena.CurrentMatch.ShouldAllowSubstitution() {
-		return fmt.Errorf("Can't substitute teams for qualification matches.")
+// Determines the earliest time at which the current playoff match may start without cutting short the minimum
+// turnaround time owed to either of its alliances since their previous match.
+func (arena *Arena) updatePlayoffTurnaround() error {
+	arena.playoffTurnaroundAllianceId = 0
+	arena.playoffTurnaroundReadyTime = time.Time{}
+	match := arena.CurrentMatch
+	if match.Type != model.Playoff || arena.EventSettings.PlayoffMinTurnaroundSec <= 0 {
+		return nil
 	}
 
-	if err := arena.validateTeams(red1, red2, red3, blue1, blue2, blue3); err != nil {
-		return err
-	}
-	if err := arena.assignTeam(red1, "R1"); err != nil {
-		return err
-	}
-	if err := arena.assignTeam(red2, "R2"); err != nil {
-		return err
-	}
-	if err := arena.assignTeam(red3, "R3"); err != nil {
-		return err
+	for _, allianceId := range []int{match.PlayoffRedAlliance, match.PlayoffBlueAlliance} {
+		readyTime, err := playoff.AllianceReadyTime(
+			arena.Database, allianceId, match.Id, arena.EventSettings.PlayoffMinTurnaroundSec,
+		)
+		if err != nil {
+			return err
+		}
+		if readyTime.After(arena.playoffTurnaroundReadyTime) {
+			arena.playoffTurnaroundAllianceId = allianceId
+			arena.playoffTurnaroundReadyTime = readyTime
+		}
 	}
-	if err := arena.assignTeam(blue1, "B1"); err != nil {
-		return err
+	return nil
+}
+
+// Assigns the given teams to the alliance stations in order, also substituting them into the match record. Stations
+// beyond the end of each list are left empty.
+func (arena *Arena) SubstituteTeams(redTeamIds, blueTeamIds []int) error {
+	if !arena.CurrentMatch.ShouldAllowSubstitution() {
+		return fmt.Errorf("Can't substitute teams for qualification matches.")
 	}
-	if err := arena.assignTeam(blue2, "B2"); err != nil {
-		return err
+
+	teamIds := make(map[string]int)
+	for _, alliance := range []struct {
+		prefix  string
+		teamIds []int
+	}{{"R", redTeamIds}, {"B", blueTeamIds}} {
+		for i, teamId := range alliance.teamIds {
+			if i >= arena.EventSettings.TeamsPerAlliance {
+				if teamId != 0 {
+					return fmt.Errorf(
+						"Can't assign Team %d since there are only %d teams per alliance.",
+						teamId,
+						arena.EventSettings.TeamsPerAlliance,
+					)
+				}
+				continue
+			}
+			if err := arena.validateTeams(teamId); err != nil {
+				return err
+			}
+			teamIds[fmt.Sprintf("%s%d", alliance.prefix, i+1)] = teamId
+		}
 	}
-	if err := arena.assignTeam(blue3, "B3"); err != nil {
-		return err
+	for _, station := range model.AllianceStationNames(model.MaxTeamsPerAlliance) {
+		if err := arena.assignTeam(teamIds[station], station); err != nil {
+			return err
+		}
 	}
 
-	arena.CurrentMatch.Red1 = red1
-	arena.CurrentMatch.Red2 = red2
-	arena.CurrentMatch.Red3 = red3
-	arena.CurrentMatch.Blue1 = blue1
-	arena.CurrentMatch.Blue2 = blue2
-	arena.CurrentMatch.Blue3 = blue3
-	arena.setupNetwork(
-		[6]*model.Team{
-			arena.AllianceStations["R1"].Team,
-			arena.AllianceStations["R2"].Team,
-			arena.AllianceStations["R3"].Team,
-			arena.AllianceStations["B1"].Team,
-			arena.AllianceStations["B2"].Team,
-			arena.AllianceStations["B3"].Team,
-		},
-		false,
-	)
+	for _, station := range model.AllianceStationNames(model.MaxTeamsPerAlliance) {
+		arena.CurrentMatch.SetTeamForStation(station, teamIds[station], false)
+	}
+	arena.setupNetwork(arena.hardwareStationTeams(), false)
 	arena.MatchLoadNotifier.Notify()
 
 	if arena.CurrentMatch.Type != model.Test {
@@ -464,12 +615,18 @@ func (arena *Arena) SubstituteTeams(red1, red2, red3, blue1, blue2, blue3 int) e
 func (arena *Arena) StartMatch() error {
 	err := arena.checkCanStartMatch()
 	if err == nil {
+		arena.stopAllStationMiniMatches("ended on match start")
+
 		// Save the match start time to the database for posterity.
 		arena.CurrentMatch.StartedAt = time.Now()
+		arena.CurrentMatch.FieldFaultPauses = nil
 		if arena.CurrentMatch.Type != model.Test {
 			arena.Database.UpdateMatch(arena.CurrentMatch)
 		}
 		arena.updateCycleTime(arena.CurrentMatch.StartedAt)
+		if err := arena.markQueueTeamsAtField(); err != nil {
+			log.Printf("Failed to mark teams as at the field: %s", err.Error())
+		}
 
 		// Save the missed packet count to subtract it from the running count.
 		for _, allianceStation := range arena.AllianceStations {
@@ -489,7 +646,7 @@ func (arena *Arena) StartMatch() error {
 		}
 
 		// Propagate which teams were bypassed to the tracked score.
-		for i := 0; i < 3; i++ {
+		for i := 0; i < arena.EventSettings.TeamsPerAlliance; i++ {
 			stationNumber := strconv.Itoa(i + 1)
 			arena.RedRealtimeScore.CurrentScore.RobotsBypassed[i] = arena.AllianceStations["R"+stationNumber].Bypass
 			arena.BlueRealtimeScore.CurrentScore.RobotsBypassed[i] = arena.AllianceStations["B"+stationNumber].Bypass
@@ -512,6 +669,10 @@ func (arena *Arena) AbortMatch() error {
 		return nil
 	}
 
+	if arena.IsFieldFaultPaused() {
+		// Close out the pause record so that the aborted match still accounts for it.
+		arena.endFieldFaultPause()
+	}
 	if arena.MatchState != WarmupPeriod {
 		arena.PlaySound("abort")
 	}
@@ -523,6 +684,88 @@ func (arena *Arena) AbortMatch() error {
 	return nil
 }
 
+// Stops the match in progress for a field fault, disabling all robots and freezing the match clock and sounds until
+// it is resumed.
+func (arena *Arena) PauseMatch(reason string) error {
+	if arena.MatchState != WarmupPeriod && arena.MatchState != AutoPeriod && arena.MatchState != PausePeriod &&
+		arena.MatchState != TeleopPeriod {
+		return fmt.Errorf("cannot pause match when it is not in progress")
+	}
+	if arena.IsFieldFaultPaused() {
+		return fmt.Errorf("match is already paused")
+	}
+
+	matchTimeSec := arena.MatchTimeSec()
+	arena.fieldFaultPauseStartTime = time.Now()
+	arena.CurrentMatch.FieldFaultPauses = append(
+		arena.CurrentMatch.FieldFaultPauses,
+		model.FieldFaultPause{StartedAt: arena.fieldFaultPauseStartTime, MatchTimeSec: matchTimeSec, Reason: reason},
+	)
+	arena.saveCurrentMatch()
+	description := "Field fault pause"
+	if reason != "" {
+		description += fmt.Sprintf(" (%s)", reason)
+	}
+	arena.addFieldEvent(FieldEventFieldFault, "", 0, description)
+	arena.fireHooks(
+		arena.newHookPayload(
+			HookTriggerFieldFaultPause, arena.CurrentMatch, arena.RedScoreSummary(), arena.BlueScoreSummary(),
+		),
+	)
+
+	// Force a driver station packet on the next loop iteration to disable the robots right away.
+	arena.lastDsPacketTime = time.Time{}
+	arena.MatchTimeNotifier.Notify()
+	return nil
+}
+
+// Resumes the match after a field fault pause, picking up in the same period with the time that was remaining.
+func (arena *Arena) ResumeMatch() error {
+	if !arena.IsFieldFaultPaused() {
+		return fmt.Errorf("cannot resume match when it is not paused")
+	}
+
+	pauseDurationSec := arena.endFieldFaultPause()
+	arena.addFieldEvent(FieldEventFieldFault, "", 0, fmt.Sprintf("Resumed after %.1f sec", pauseDurationSec))
+	arena.fireHooks(
+		arena.newHookPayload(
+			HookTriggerFieldFaultResume, arena.CurrentMatch, arena.RedScoreSummary(), arena.BlueScoreSummary(),
+		),
+	)
+
+	// Force a driver station packet on the next loop iteration to re-enable the robots right away.
+	arena.lastDsPacketTime = time.Time{}
+	arena.MatchTimeNotifier.Notify()
+	return nil
+}
+
+// Returns true if the match in progress is stopped for a field fault.
+func (arena *Arena) IsFieldFaultPaused() bool {
+	return !arena.fieldFaultPauseStartTime.IsZero()
+}
+
+// Ends the current field fault pause and records its duration, shifting the match start time by the same amount so
+// that the clock picks up where it left off. Returns the duration of the pause in seconds.
+func (arena *Arena) endFieldFaultPause() float64 {
+	pauseDuration := time.Since(arena.fieldFaultPauseStartTime)
+	arena.MatchStartTime = arena.MatchStartTime.Add(pauseDuration)
+	arena.fieldFaultPauseStartTime = time.Time{}
+	if numPauses := len(arena.CurrentMatch.FieldFaultPauses); numPauses > 0 {
+		arena.CurrentMatch.FieldFaultPauses[numPauses-1].DurationSec = pauseDuration.Seconds()
+		arena.saveCurrentMatch()
+	}
+	return pauseDuration.Seconds()
+}
+
+// Persists the current match record to the database, unless it is a test match.
+func (arena *Arena) saveCurrentMatch() {
+	if arena.CurrentMatch.Type != model.Test {
+		if err := arena.Database.UpdateMatch(arena.CurrentMatch); err != nil {
+			log.Printf("Failed to save match %s: %v", arena.CurrentMatch.ShortName, err)
+		}
+	}
+}
+
 // Clears out the match and resets the arena state unless there is a match underway.
 func (arena *Arena) ResetMatch() error {
 	if arena.MatchState != PostMatch && arena.MatchState != PreMatch && arena.MatchState != TimeoutActive {
@@ -532,12 +775,9 @@ func (arena *Arena) ResetMatch() error {
 		arena.MatchState = PreMatch
 	}
 	arena.matchAborted = false
-	arena.AllianceStations["R1"].Bypass = false
-	arena.AllianceStations["R2"].Bypass = false
-	arena.AllianceStations["R3"].Bypass = false
-	arena.AllianceStations["B1"].Bypass = false
-	arena.AllianceStations["B2"].Bypass = false
-	arena.AllianceStations["B3"].Bypass = false
+	for _, allianceStation := range arena.AllianceStations {
+		allianceStation.Bypass = false
+	}
 	arena.MuteMatchSounds = false
 	return nil
 }
@@ -586,6 +826,9 @@ func (arena *Arena) SetAllianceStationDisplayMode(mode string) {
 func (arena *Arena) MatchTimeSec() float64 {
 	if arena.MatchState == PreMatch || arena.MatchState == StartMatch || arena.MatchState == PostMatch {
 		return 0
+	} else if arena.IsFieldFaultPaused() {
+		// The clock is frozen at the point where the match was paused.
+		return arena.fieldFaultPauseStartTime.Sub(arena.MatchStartTime).Seconds()
 	} else {
 		return time.Since(arena.MatchStartTime).Seconds()
 	}
@@ -604,6 +847,10 @@ func (arena *Arena) Update() {
 		auto = true
 		enabled = false
 	case StartMatch:
+		// The arena passes through this state within a single iteration, so it wouldn't be seen as a transition at the
+		// end of the loop; log it and fire its hooks here instead.
+		arena.addFieldEvent(FieldEventMatchState, "", 0, matchStateNames[StartMatch])
+		arena.fireMatchStateHooksFor(StartMatch)
 		arena.MatchStartTime = time.Now()
 		arena.LastMatchTimeSec = -1
 		auto = true
@@ -697,11 +944,21 @@ func (arena *Arena) Update() {
 		}
 	}
 
+	if arena.IsFieldFaultPaused() {
+		// Keep all robots disabled for the duration of a field fault pause.
+		enabled = false
+	}
+
 	// Send a match tick notification if passing an integer second threshold or if the match state changed.
 	if int(matchTimeSec) != int(arena.LastMatchTimeSec) || arena.MatchState != arena.lastMatchState {
 		arena.MatchTimeNotifier.Notify()
 	}
 
+	// End any single-station test mini-matches whose time is up.
+	if arena.updateStationMiniMatches() {
+		sendDsPacket = true
+	}
+
 	// Send a packet if at a period transition point or if it's been long enough since the last one.
 	msSinceLastDsPacket := int(time.Since(arena.lastDsPacketTime).Seconds() * 1000)
 	if sendDsPacket || msSinceLastDsPacket >= dsPacketPeriodMs {
@@ -720,6 +977,13 @@ func (arena *Arena) Update() {
 	// Handle the team number / timer displays.
 	arena.TeamSigns.Update(arena)
 
+	// Log any connection, stop or state transitions since the last iteration.
+	arena.recordFieldEvents()
+	arena.flushFieldEvents()
+
+	// Notify any external automation of a match state transition.
+	arena.fireMatchStateHooks()
+
 	arena.LastMatchTimeSec = matchTimeSec
 	arena.lastMatchState = arena.MatchState
 }
@@ -731,11 +995,11 @@ func (arena *Arena) Run() {
 	go arena.listenForDsUdpPackets()
 	go arena.accessPoint.Run()
 	go arena.Plc.Run()
-	go arena.coreSwitch.Run(arena.EventSettings.CoreSwitchManagementEnabled)
-	go arena.redTeamSwitch.Run(arena.EventSettings.RedTeamSwitchManagementEnabled)
-	go arena.redFMSSwitch.Run(arena.EventSettings.RedFMSSwitchManagementEnabled)
-	go arena.blueTeamSwitch.Run(arena.EventSettings.BlueTeamSwitchManagementEnabled)
-	go arena.blueFMSSwitch.Run(arena.EventSettings.BlueFMSSwitchManagementEnabled)
+	go arena.coreSwitch.Run(arena.hardwareSettings.CoreSwitchManagementEnabled)
+	go arena.redTeamSwitch.Run(arena.hardwareSettings.RedTeamSwitchManagementEnabled)
+	go arena.redFMSSwitch.Run(arena.hardwareSettings.RedFMSSwitchManagementEnabled)
+	go arena.blueTeamSwitch.Run(arena.hardwareSettings.BlueTeamSwitchManagementEnabled)
+	go arena.blueFMSSwitch.Run(arena.hardwareSettings.BlueFMSSwitchManagementEnabled)
 	// go arena.blueTPLinkSwitch.Run(arena.EventSettings.BlueSwitchManagementEnabled)
 
 	for {
@@ -831,7 +1095,11 @@ func (arena *Arena) getNextMatch(excludeCurrent bool) (*model.Match, error) {
 		return nil, err
 	}
 	for _, match := range matches {
-		if !match.IsComplete() && !(excludeCurrent && match.Id == arena.CurrentMatch.Id) {
+		if match.IsComplete() || excludeCurrent && match.Id == arena.CurrentMatch.Id {
+			continue
+		}
+		if match.IsForField(arena.FieldNumber) &&
+			!arena.fieldGroup.isMatchLoadedOnOtherField(arena.FieldNumber, match.Id) {
 			return &match, nil
 		}
 	}
@@ -840,6 +1108,16 @@ func (arena *Arena) getNextMatch(excludeCurrent bool) (*model.Match, error) {
 	return nil, nil
 }
 
+// Runs the given function for the arena of every field sharing the event, such as to reload the shared settings.
+func (arena *Arena) ForEachField(function func(fieldArena *Arena) error) error {
+	for _, fieldArena := range arena.fieldGroup.arenas {
+		if err := function(fieldArena); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Configures the field network for the next match in advance of the current match being scored and committed.
 func (arena *Arena) preLoadNextMatch() {
 	if arena.MatchState != PostMatch {
@@ -855,6 +1133,7 @@ func (arena *Arena) preLoadNextMatch() {
 		return
 	}
 
+	// Only the stations with field hardware are pre-configured.
 	teamIds := [6]int{nextMatch.Red1, nextMatch.Red2, nextMatch.Red3, nextMatch.Blue1, nextMatch.Blue2, nextMatch.Blue3}
 	if nextMatch.ShouldAllowNexusSubstitution() && arena.EventSettings.NexusEnabled {
 		// Attempt to get the match lineup from Nexus for FRC.
@@ -881,7 +1160,7 @@ func (arena *Arena) preLoadNextMatch() {
 
 // Enable or disable the team ethernet ports on both SCCs
 func (arena *Arena) setSCCEthernetEnabled(enabled bool) {
-	if arena.EventSettings.SCCManagementEnabled {
+	if arena.hardwareSettings.SCCManagementEnabled {
 		var wg sync.WaitGroup
 		wg.Add(2)
 
@@ -971,16 +1250,16 @@ func (arena *Arena) setupNetwork(teams [6]*model.Team, isPreload bool) {
 						time.Sleep(settleAfterActive)
 					}
 
-					if arena.EventSettings.RedTeamSwitchManagementEnabled {
+					if arena.hardwareSettings.RedTeamSwitchManagementEnabled {
 						log.Printf("Rebooting RED team switch…")
 						go arena.redTeamSwitch.Reboot()
 					}
-					if arena.EventSettings.BlueTeamSwitchManagementEnabled {
+					if arena.hardwareSettings.BlueTeamSwitchManagementEnabled {
 						log.Printf("Rebooting BLUE team switch…")
 						go arena.blueTeamSwitch.Reboot()
 					}
-					if !arena.EventSettings.RedTeamSwitchManagementEnabled &&
-						!arena.EventSettings.BlueTeamSwitchManagementEnabled {
+					if !arena.hardwareSettings.RedTeamSwitchManagementEnabled &&
+						!arena.hardwareSettings.BlueTeamSwitchManagementEnabled {
 						arena.NetworkConfiguring = false
 						return
 					}
@@ -999,9 +1278,9 @@ func (arena *Arena) setupNetwork(teams [6]*model.Team, isPreload bool) {
 							arena.NetworkConfiguring = false
 							return
 						case <-switchTicker.C:
-							redOK := !arena.EventSettings.RedTeamSwitchManagementEnabled ||
+							redOK := !arena.hardwareSettings.RedTeamSwitchManagementEnabled ||
 								strings.EqualFold(arena.redTeamSwitch.Status, "ACTIVE")
-							blueOK := !arena.EventSettings.BlueTeamSwitchManagementEnabled ||
+							blueOK := !arena.hardwareSettings.BlueTeamSwitchManagementEnabled ||
 								strings.EqualFold(arena.blueTeamSwitch.Status, "ACTIVE")
 							if redOK && blueOK {
 								arena.NetworkConfiguring = false
@@ -1021,11 +1300,27 @@ func (arena *Arena) checkCanStartMatch() error {
 		return fmt.Errorf("cannot start match while there is a match still in progress or with results pending")
 	}
 
-	err := arena.checkAllianceStationsReady("R1", "R2", "R3", "B1", "B2", "B3")
+	err := arena.checkAllianceStationsReady(arena.AllianceStationNames()...)
 	if err != nil {
 		return err
 	}
 
+	if teamIds := arena.UninspectedTeamIds(); len(teamIds) > 0 && !arena.InspectionOverride {
+		return fmt.Errorf("cannot start match until team %d has passed inspection or the FTA overrides", teamIds[0])
+	}
+
+	if err = arena.checkVersionCompliance(); err != nil {
+		return err
+	}
+
+	if remaining := time.Until(arena.playoffTurnaroundReadyTime); remaining > 0 {
+		return fmt.Errorf(
+			"cannot start match until alliance %d has had its minimum turnaround time (%s remaining)",
+			arena.playoffTurnaroundAllianceId,
+			remaining.Round(time.Second),
+		)
+	}
+
 	if arena.Plc.IsEnabled() {
 		if !arena.Plc.IsHealthy() {
 			return fmt.Errorf("cannot start match while PLC is not healthy")
@@ -1082,8 +1377,13 @@ func (arena *Arena) sendDsPacket(auto bool, enabled bool) {
 	for _, allianceStation := range arena.AllianceStations {
 		dsConn := allianceStation.DsConn
 		if dsConn != nil {
-			dsConn.Auto = auto
-			dsConn.Enabled = enabled && !allianceStation.EStop && !(auto && allianceStation.AStop) &&
+			stationAuto, stationEnabled := auto, enabled
+			if allianceStation.MiniMatch != nil {
+				// The station is running a mini-match of its own, independently of the other stations.
+				stationAuto, stationEnabled = allianceStation.MiniMatch.Auto, true
+			}
+			dsConn.Auto = stationAuto
+			dsConn.Enabled = stationEnabled && !allianceStation.EStop && !(stationAuto && allianceStation.AStop) &&
 				!allianceStation.Bypass
 			dsConn.EStop = allianceStation.EStop
 			dsConn.AStop = allianceStation.AStop
@@ -1096,6 +1396,37 @@ func (arena *Arena) sendDsPacket(auto bool, enabled bool) {
 	arena.lastDsPacketTime = time.Now()
 }
 
+// Returns the names of the alliance stations in use for the configured number of teams per alliance, red first.
+func (arena *Arena) AllianceStationNames() []string {
+	return model.AllianceStationNames(arena.EventSettings.TeamsPerAlliance)
+}
+
+// Returns true if the given alliance station is in use for the configured number of teams per alliance.
+func (arena *Arena) isStationInUse(station string) bool {
+	for _, stationInUse := range arena.AllianceStationNames() {
+		if station == stationInUse {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the teams in the stations that are wired up to the field hardware, in the order expected by the network
+// configuration.
+func (arena *Arena) hardwareStationTeams() [6]*model.Team {
+	var teams [6]*model.Team
+	for i, station := range model.AllianceStationNames(HardwareStationsPerAlliance) {
+		teams[i] = arena.AllianceStations[station].Team
+	}
+	return teams
+}
+
+// Returns true if the given alliance station is one of those wired up to the field hardware.
+func isHardwareStation(station string) bool {
+	stationNumber, _ := strconv.Atoi(station[1:])
+	return stationNumber <= HardwareStationsPerAlliance
+}
+
 // Returns the alliance station identifier for the given team, or the empty string if the team is not present
 // in the current match.
 func (arena *Arena) getAssignedAllianceStation(teamId int) string {
@@ -1112,12 +1443,9 @@ func (arena *Arena) getAssignedAllianceStation(teamId int) string {
 func (arena *Arena) handlePlcInputOutput() {
 	if !arena.Plc.IsEnabled() {
 		// PLC is disabled; still apply remote-only stops so station RPis can control E-stops/A-stops.
-		arena.applyStationStops("R1", false, false)
-		arena.applyStationStops("R2", false, false)
-		arena.applyStationStops("R3", false, false)
-		arena.applyStationStops("B1", false, false)
-		arena.applyStationStops("B2", false, false)
-		arena.applyStationStops("B3", false, false)
+		for _, station := range model.AllianceStationNames(model.MaxTeamsPerAlliance) {
+			arena.applyStationStops(station, false, false)
+		}
 		return
 	}
 
@@ -1125,6 +1453,9 @@ func (arena *Arena) handlePlcInputOutput() {
 	if arena.Plc.GetFieldEStop() && !arena.matchAborted {
 		arena.AbortMatch()
 	}
+	if arena.Plc.GetFieldEStop() {
+		arena.stopAllStationMiniMatches("ended by field E-stop")
+	}
 	redEStops, blueEStops := arena.Plc.GetTeamEStops()
 	redAStops, blueAStops := arena.Plc.GetTeamAStops()
 	arena.applyStationStops("R1", redEStops[0], redAStops[0])
@@ -1140,6 +1471,12 @@ func (arena *Arena) handlePlcInputOutput() {
 	arena.AllianceStations["B1"].Ethernet = blueEthernets[0]
 	arena.AllianceStations["B2"].Ethernet = blueEthernets[1]
 	arena.AllianceStations["B3"].Ethernet = blueEthernets[2]
+	for station, allianceStation := range arena.AllianceStations {
+		if allianceStation.Unmanaged {
+			// The PLC has no inputs for stations beyond the hardware, so only the remote stops apply.
+			arena.applyStationStops(station, false, false)
+		}
+	}
 
 	// Handle in-match PLC functions.
 	redScore := &arena.RedRealtimeScore.CurrentScore
@@ -1151,8 +1488,9 @@ func (arena *Arena) handlePlcInputOutput() {
 	teleopGracePeriod := matchStartTime.Add(game.GetDurationToTeleopEnd() + game.TeleopGracePeriodSec*time.Second)
 	inGracePeriod := arena.MatchState == PostMatch && currentTime.Before(teleopGracePeriod) && !arena.matchAborted
 
-	redAllianceReady := arena.checkAllianceStationsReady("R1", "R2", "R3") == nil
-	blueAllianceReady := arena.checkAllianceStationsReady("B1", "B2", "B3") == nil
+	stations := arena.AllianceStationNames()
+	redAllianceReady := arena.checkAllianceStationsReady(stations[:len(stations)/2]...) == nil
+	blueAllianceReady := arena.checkAllianceStationsReady(stations[len(stations)/2:]...) == nil
 
 	// Handle the evergreen PLC functions: stack lights, stack buzzer, and field reset light.
 	switch arena.MatchState {
@@ -1268,6 +1606,12 @@ func (arena *Arena) applyStationStops(station string, plcEStop, plcAStop bool) {
 		remoteAStop = false
 	}
 
+	if plcEStop || remoteEStop {
+		allianceStation.eStopSource = stopSource(plcEStop, remoteEStop)
+	}
+	if plcAStop || remoteAStop {
+		allianceStation.aStopSource = stopSource(plcAStop, remoteAStop)
+	}
 	arena.handleTeamStop(station, plcEStop || remoteEStop, plcAStop || remoteAStop)
 }
 
@@ -1318,27 +1662,27 @@ func (arena *Arena) StationRpiStatuses() map[string]StationRpiStatus {
 func (arena *Arena) RebootSwitch(target string) error {
 	switch target {
 	case "core":
-		if !arena.EventSettings.CoreSwitchManagementEnabled {
+		if !arena.hardwareSettings.CoreSwitchManagementEnabled {
 			return fmt.Errorf("core switch management is disabled")
 		}
 		go arena.coreSwitch.Reboot()
 	case "redTeam":
-		if !arena.EventSettings.RedTeamSwitchManagementEnabled {
+		if !arena.hardwareSettings.RedTeamSwitchManagementEnabled {
 			return fmt.Errorf("red team switch management is disabled")
 		}
 		go arena.redTeamSwitch.Reboot()
 	case "redFms":
-		if !arena.EventSettings.RedFMSSwitchManagementEnabled {
+		if !arena.hardwareSettings.RedFMSSwitchManagementEnabled {
 			return fmt.Errorf("red FMS switch management is disabled")
 		}
 		go arena.redFMSSwitch.Reboot()
 	case "blueTeam":
-		if !arena.EventSettings.BlueTeamSwitchManagementEnabled {
+		if !arena.hardwareSettings.BlueTeamSwitchManagementEnabled {
 			return fmt.Errorf("blue team switch management is disabled")
 		}
 		go arena.blueTeamSwitch.Reboot()
 	case "blueFms":
-		if !arena.EventSettings.BlueFMSSwitchManagementEnabled {
+		if !arena.hardwareSettings.BlueFMSSwitchManagementEnabled {
 			return fmt.Errorf("blue FMS switch management is disabled")
 		}
 		go arena.blueFMSSwitch.Reboot()
@@ -1383,6 +1727,8 @@ func (arena *Arena) positionPostMatchScoreReady(position string) bool {
 func (arena *Arena) runPeriodicTasks() {
 	arena.updateEarlyLateMessage()
 	arena.purgeDisconnectedDisplays()
+	arena.recordTeamVersions()
+	arena.saveFieldEvents()
 }
 
 // trussLightWarningSequence generates the sequence of truss light states during the "sonar ping" warning sound. It
@@ -1,6 +1,10 @@
 package field
 
-import "github.com/Team254/cheesy-arena/game"
+import (
+	"log"
+
+	"github.com/Team254/cheesy-arena/game"
+)
 
 // LoadGameConfig parses the saved game configuration and applies it to runtime scoring.
 func (arena *Arena) LoadGameConfig() error {
@@ -8,5 +12,26 @@ func (arena *Arena) LoadGameConfig() error {
 	if err != nil {
 		return err
 	}
-	return game.SetActiveGameConfig(config.Payload)
+	if err = game.SetActiveGameConfig(config.Payload); err != nil {
+		return err
+	}
+	arena.applyPlayoffTiebreakers()
+	return nil
+}
+
+// Applies the playoff tiebreaker order from the event settings if one is given, or otherwise from the game
+// configuration, falling back to the game manual default.
+func (arena *Arena) applyPlayoffTiebreakers() {
+	tiebreakers, err := game.ParsePlayoffTiebreakers(arena.EventSettings.PlayoffTiebreakers)
+	if err != nil {
+		log.Printf("Ignoring playoff tiebreakers from event settings: %v", err)
+		tiebreakers = nil
+	}
+	if len(tiebreakers) == 0 && game.ActiveGameConfig != nil {
+		tiebreakers = game.ActiveGameConfig.Rules.Tiebreakers
+	}
+	if err = game.SetPlayoffTiebreakers(tiebreakers); err != nil {
+		log.Printf("Ignoring playoff tiebreakers from game configuration: %v", err)
+		_ = game.SetPlayoffTiebreakers(nil)
+	}
 }
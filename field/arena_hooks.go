@@ -0,0 +1,292 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Hooks that notify external automation (e.g. AV and lighting cues) of match state transitions and score commits.
+
+package field
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// Triggers on which an arena hook can fire.
+const (
+	HookTriggerAny              = "any"
+	HookTriggerPreMatch         = "preMatch"
+	HookTriggerStartMatch       = "startMatch"
+	HookTriggerWarmupStart      = "warmupStart"
+	HookTriggerAutoStart        = "autoStart"
+	HookTriggerPauseStart       = "pauseStart"
+	HookTriggerTeleopStart      = "teleopStart"
+	HookTriggerMatchEnd         = "matchEnd"
+	HookTriggerTimeoutStart     = "timeoutStart"
+	HookTriggerTimeoutEnd       = "timeoutEnd"
+	HookTriggerFieldFaultPause  = "fieldFaultPause"
+	HookTriggerFieldFaultResume = "fieldFaultResume"
+	HookTriggerScoreCommitted   = "scoreCommitted"
+	HookTriggerTest             = "test"
+)
+
+// Maximum time that a hook is allowed to take to deliver its payload before it is abandoned.
+const hookTimeoutSec = 10
+
+// Description of a trigger for display on the hook configuration page.
+type HookTrigger struct {
+	Name        string
+	Description string
+}
+
+// All triggers that can be selected for a hook, in the order in which they occur during a match.
+var HookTriggers = []HookTrigger{
+	{HookTriggerAny, "Any transition or score commit"},
+	{HookTriggerPreMatch, "Pre-match (field reset)"},
+	{HookTriggerStartMatch, "Match start"},
+	{HookTriggerWarmupStart, "Warmup start"},
+	{HookTriggerAutoStart, "Autonomous start"},
+	{HookTriggerPauseStart, "Pause start"},
+	{HookTriggerTeleopStart, "Teleop start"},
+	{HookTriggerMatchEnd, "Match end"},
+	{HookTriggerTimeoutStart, "Timeout start"},
+	{HookTriggerTimeoutEnd, "Timeout end"},
+	{HookTriggerFieldFaultPause, "Field fault pause"},
+	{HookTriggerFieldFaultResume, "Field fault resume"},
+	{HookTriggerScoreCommitted, "Score committed"},
+}
+
+var matchStateHookTriggers = map[MatchState]string{
+	PreMatch:      HookTriggerPreMatch,
+	StartMatch:    HookTriggerStartMatch,
+	WarmupPeriod:  HookTriggerWarmupStart,
+	AutoPeriod:    HookTriggerAutoStart,
+	PausePeriod:   HookTriggerPauseStart,
+	TeleopPeriod:  HookTriggerTeleopStart,
+	PostMatch:     HookTriggerMatchEnd,
+	TimeoutActive: HookTriggerTimeoutStart,
+	PostTimeout:   HookTriggerTimeoutEnd,
+}
+
+// The data that is delivered to each hook when it fires.
+type HookPayload struct {
+	Trigger     string
+	Time        time.Time
+	FieldNumber int
+	MatchState  string
+	Match       HookMatchSummary
+	RedScore    *game.ScoreSummary
+	BlueScore   *game.ScoreSummary
+}
+
+// Summary of the match that a hook payload pertains to.
+type HookMatchSummary struct {
+	Id        int
+	Type      string
+	ShortName string
+	LongName  string
+	RedTeams  []int
+	BlueTeams []int
+}
+
+// Reloads the configured hooks from the database.
+func (arena *Arena) LoadArenaHooks() error {
+	arenaHooks, err := arena.Database.GetAllArenaHooks()
+	if err != nil {
+		return err
+	}
+	arena.arenaHooks = arenaHooks
+	return nil
+}
+
+// Fires the hooks configured for the given transition if the match state has changed since the last loop iteration.
+func (arena *Arena) fireMatchStateHooks() {
+	if arena.MatchState == arena.lastMatchState || arena.lastMatchState < 0 {
+		return
+	}
+	arena.fireMatchStateHooksFor(arena.MatchState)
+}
+
+// Fires the hooks configured for the arena having entered the given match state.
+func (arena *Arena) fireMatchStateHooksFor(matchState MatchState) {
+	trigger, ok := matchStateHookTriggers[matchState]
+	if !ok {
+		return
+	}
+	arena.fireHooks(
+		arena.newHookPayload(trigger, arena.CurrentMatch, arena.RedScoreSummary(), arena.BlueScoreSummary()),
+	)
+}
+
+// Fires the hooks configured for score commits, given the match and the result that has just been committed for it.
+func (arena *Arena) FireScoreCommittedHooks(match *model.Match, matchResult *model.MatchResult) {
+	arena.fireHooks(
+		arena.newHookPayload(
+			HookTriggerScoreCommitted, match, matchResult.RedScoreSummary(), matchResult.BlueScoreSummary(),
+		),
+	)
+}
+
+// Delivers a sample payload to the given hook regardless of its trigger, so that its configuration can be checked.
+func (arena *Arena) TestArenaHook(arenaHook model.ArenaHook) error {
+	payload := arena.newHookPayload(
+		HookTriggerTest, arena.CurrentMatch, arena.RedScoreSummary(), arena.BlueScoreSummary(),
+	)
+	return runArenaHook(arenaHook, payload)
+}
+
+// Starts delivery of the given payload to each enabled hook that matches its trigger. Delivery happens in the
+// background so that a slow or unreachable hook never holds up the arena loop.
+func (arena *Arena) fireHooks(payload HookPayload) {
+	for _, arenaHook := range arena.arenaHooks {
+		if !arenaHook.Enabled || (arenaHook.Trigger != payload.Trigger && arenaHook.Trigger != HookTriggerAny) {
+			continue
+		}
+		go func(arenaHook model.ArenaHook) {
+			if err := runArenaHook(arenaHook, payload); err != nil {
+				log.Printf("Failed to run hook '%s' for %s: %v", arenaHook.Name, payload.Trigger, err)
+			}
+		}(arenaHook)
+	}
+}
+
+func (arena *Arena) newHookPayload(
+	trigger string, match *model.Match, redScoreSummary, blueScoreSummary *game.ScoreSummary,
+) HookPayload {
+	return HookPayload{
+		Trigger:     trigger,
+		Time:        time.Now(),
+		FieldNumber: arena.FieldNumber,
+		MatchState:  matchStateNames[arena.MatchState],
+		Match: HookMatchSummary{
+			Id:        match.Id,
+			Type:      match.Type.String(),
+			ShortName: match.ShortName,
+			LongName:  match.LongName,
+			RedTeams:  match.RedTeamIds(),
+			BlueTeams: match.BlueTeamIds(),
+		},
+		RedScore:  redScoreSummary,
+		BlueScore: blueScoreSummary,
+	}
+}
+
+// Delivers the given payload to the given hook, blocking until it has completed or timed out.
+func runArenaHook(arenaHook model.ArenaHook, payload HookPayload) error {
+	if strings.TrimSpace(arenaHook.Target) == "" {
+		return fmt.Errorf("no target is configured")
+	}
+	switch arenaHook.Type {
+	case model.ArenaHookCommand:
+		return runCommandHook(arenaHook.Target, payload)
+	case model.ArenaHookHttp:
+		return runHttpHook(arenaHook.Target, payload)
+	case model.ArenaHookOsc:
+		return runOscHook(arenaHook.Target, arenaHook.OscAddress, payload)
+	default:
+		return fmt.Errorf("invalid hook type '%s'", arenaHook.Type)
+	}
+}
+
+// Runs the given local command, passing it the JSON payload on standard input and the trigger and match in the
+// environment. The command is split on whitespace and run directly rather than through a shell.
+func runCommandHook(command string, payload HookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	args := strings.Fields(command)
+	ctx, cancel := context.WithTimeout(context.Background(), hookTimeoutSec*time.Second)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Env = append(
+		os.Environ(),
+		"CHEESY_ARENA_TRIGGER="+payload.Trigger,
+		"CHEESY_ARENA_MATCH="+payload.Match.ShortName,
+		fmt.Sprintf("CHEESY_ARENA_FIELD=%d", payload.FieldNumber),
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%v: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// POSTs the JSON payload to the given URL.
+func runHttpHook(url string, payload HookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{Timeout: hookTimeoutSec * time.Second}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("got status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sends an OSC message over UDP to the given host:port. The message carries the trigger, the match name and the red
+// and blue scores, and is sent to the given OSC address or to one derived from the trigger if none is configured.
+func runOscHook(target, oscAddress string, payload HookPayload) error {
+	if oscAddress == "" {
+		oscAddress = "/cheesy-arena/" + payload.Trigger
+	}
+	var redScore, blueScore int32
+	if payload.RedScore != nil {
+		redScore = int32(payload.RedScore.Score)
+	}
+	if payload.BlueScore != nil {
+		blueScore = int32(payload.BlueScore.Score)
+	}
+	message := encodeOscMessage(oscAddress, payload.Trigger, payload.Match.ShortName, redScore, blueScore)
+
+	conn, err := net.DialTimeout("udp", target, hookTimeoutSec*time.Second)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Write(message)
+	return err
+}
+
+// Encodes an OSC 1.0 message having the given address and string or int32 arguments.
+func encodeOscMessage(address string, args ...any) []byte {
+	var message bytes.Buffer
+	writeOscString(&message, address)
+	typeTags := ","
+	var argData bytes.Buffer
+	for _, arg := range args {
+		switch value := arg.(type) {
+		case string:
+			typeTags += "s"
+			writeOscString(&argData, value)
+		case int32:
+			typeTags += "i"
+			_ = binary.Write(&argData, binary.BigEndian, value)
+		}
+	}
+	writeOscString(&message, typeTags)
+	message.Write(argData.Bytes())
+	return message.Bytes()
+}
+
+// Writes the given string null-terminated and padded with nulls to a multiple of four bytes, as OSC requires.
+func writeOscString(buffer *bytes.Buffer, value string) {
+	buffer.WriteString(value)
+	buffer.Write(make([]byte, 4-len(value)%4))
+}
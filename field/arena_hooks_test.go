@@ -0,0 +1,176 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package field
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArenaHooksMatchState(t *testing.T) {
+	arena := setupTestArena(t)
+	payloads := make(chan HookPayload, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload HookPayload
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		payloads <- payload
+	}))
+	defer server.Close()
+
+	assert.Nil(t, arena.Database.CreateArenaHook(
+		&model.ArenaHook{Trigger: HookTriggerAutoStart, Type: model.ArenaHookHttp, Target: server.URL, Enabled: true},
+	))
+	assert.Nil(t, arena.Database.CreateArenaHook(
+		&model.ArenaHook{Trigger: HookTriggerMatchEnd, Type: model.ArenaHookHttp, Target: server.URL},
+	))
+	assert.Nil(t, arena.LoadArenaHooks())
+	match := model.Match{Type: model.Qualification, ShortName: "Q12", Red1: 254, Blue3: 1114}
+	assert.Nil(t, arena.Database.CreateMatch(&match))
+	assert.Nil(t, arena.LoadMatch(&match))
+
+	// Check that only a transition having an enabled hook fires it, and only once.
+	arena.lastMatchState = WarmupPeriod
+	arena.MatchState = AutoPeriod
+	arena.fireMatchStateHooks()
+	arena.lastMatchState = AutoPeriod
+	arena.fireMatchStateHooks()
+	arena.MatchState = PostMatch
+	arena.fireMatchStateHooks()
+	select {
+	case payload := <-payloads:
+		assert.Equal(t, HookTriggerAutoStart, payload.Trigger)
+		assert.Equal(t, "Autonomous", payload.MatchState)
+		assert.Equal(t, "Q12", payload.Match.ShortName)
+		assert.Equal(t, []int{254, 0, 0, 0}, payload.Match.RedTeams)
+		assert.Equal(t, []int{0, 0, 1114, 0}, payload.Match.BlueTeams)
+		assert.NotNil(t, payload.RedScore)
+	case <-time.After(time.Second):
+		assert.Fail(t, "Hook was not fired.")
+	}
+	select {
+	case payload := <-payloads:
+		assert.Fail(t, "Unexpected hook fired.", payload.Trigger)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Check that a hook on any trigger also fires on a score commit.
+	assert.Nil(t, arena.Database.CreateArenaHook(
+		&model.ArenaHook{Trigger: HookTriggerAny, Type: model.ArenaHookHttp, Target: server.URL, Enabled: true},
+	))
+	assert.Nil(t, arena.LoadArenaHooks())
+	matchResult := model.NewMatchResult()
+	matchResult.RedScore.LeaveStatuses[0] = true
+	arena.FireScoreCommittedHooks(&match, matchResult)
+	select {
+	case payload := <-payloads:
+		assert.Equal(t, HookTriggerScoreCommitted, payload.Trigger)
+		assert.Equal(t, matchResult.RedScoreSummary().Score, payload.RedScore.Score)
+	case <-time.After(time.Second):
+		assert.Fail(t, "Hook was not fired.")
+	}
+}
+
+func TestArenaHooksStartMatch(t *testing.T) {
+	arena := setupTestArena(t)
+	payloads := make(chan HookPayload, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload HookPayload
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		payloads <- payload
+	}))
+	defer server.Close()
+
+	assert.Nil(t, arena.Database.CreateArenaHook(
+		&model.ArenaHook{Trigger: HookTriggerStartMatch, Type: model.ArenaHookHttp, Target: server.URL, Enabled: true},
+	))
+	assert.Nil(t, arena.LoadArenaHooks())
+	arena.Update()
+	for _, allianceStation := range arena.AllianceStations {
+		allianceStation.Bypass = true
+	}
+
+	// Check that starting the match fires the hook and logs the transition even though the arena moves straight on.
+	assert.Nil(t, arena.StartMatch())
+	arena.Update()
+	assert.NotEqual(t, StartMatch, arena.MatchState)
+	select {
+	case payload := <-payloads:
+		assert.Equal(t, HookTriggerStartMatch, payload.Trigger)
+		assert.Equal(t, "Start Match", payload.MatchState)
+	case <-time.After(time.Second):
+		assert.Fail(t, "Hook was not fired.")
+	}
+	var matchStateEvents []string
+	for _, fieldEvent := range arena.FieldEvents {
+		if fieldEvent.Type == FieldEventMatchState {
+			matchStateEvents = append(matchStateEvents, fieldEvent.Description)
+		}
+	}
+	assert.Equal(t, []string{"Start Match", matchStateNames[arena.MatchState]}, matchStateEvents)
+}
+
+func TestArenaHookErrors(t *testing.T) {
+	arena := setupTestArena(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(500)
+	}))
+	defer server.Close()
+
+	err := arena.TestArenaHook(model.ArenaHook{Type: model.ArenaHookHttp, Target: server.URL})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "got status code 500", err.Error())
+	}
+	err = arena.TestArenaHook(model.ArenaHook{Type: model.ArenaHookHttp, Target: " "})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "no target is configured", err.Error())
+	}
+	err = arena.TestArenaHook(model.ArenaHook{Type: "email", Target: "pat@example.com"})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "invalid hook type 'email'", err.Error())
+	}
+}
+
+func TestArenaHookCommand(t *testing.T) {
+	arena := setupTestArena(t)
+
+	assert.Nil(t, arena.TestArenaHook(model.ArenaHook{Type: model.ArenaHookCommand, Target: "true"}))
+	err := arena.TestArenaHook(model.ArenaHook{Type: model.ArenaHookCommand, Target: "false"})
+	assert.NotNil(t, err)
+}
+
+func TestArenaHookOsc(t *testing.T) {
+	arena := setupTestArena(t)
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer conn.Close()
+
+	arena.CurrentMatch.ShortName = "Q1"
+	assert.Nil(
+		t,
+		arena.TestArenaHook(
+			model.ArenaHook{Type: model.ArenaHookOsc, Target: conn.LocalAddr().String(), OscAddress: "/lights"},
+		),
+	)
+	buffer := make([]byte, 1024)
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	n, _, err := conn.ReadFrom(buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, encodeOscMessage("/lights", "test", "Q1", int32(0), int32(0)), buffer[:n])
+}
+
+func TestEncodeOscMessage(t *testing.T) {
+	expected := []byte{
+		'/', 'a', 'b', 'c', 0, 0, 0, 0,
+		',', 's', 'i', 0,
+		'Q', '1', 0, 0,
+		0, 0, 0x01, 0x02,
+	}
+	assert.Equal(t, expected, encodeOscMessage("/abc", "Q1", int32(258)))
+}
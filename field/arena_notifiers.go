@@ -6,6 +6,7 @@
 package field
 
 import (
+	"log"
 	"strconv"
 
 	"github.com/Team254/cheesy-arena/game"
@@ -21,11 +22,14 @@ type ArenaNotifiers struct {
 	AudienceDisplayModeNotifier        *websocket.Notifier
 	DisplayConfigurationNotifier       *websocket.Notifier
 	EventStatusNotifier                *websocket.Notifier
+	FieldEventsNotifier                *websocket.Notifier
 	LowerThirdNotifier                 *websocket.Notifier
 	MatchLoadNotifier                  *websocket.Notifier
 	MatchTimeNotifier                  *websocket.Notifier
 	MatchTimingNotifier                *websocket.Notifier
 	PlaySoundNotifier                  *websocket.Notifier
+	PracticeQueueNotifier              *websocket.Notifier
+	QueueStatusNotifier                *websocket.Notifier
 	RealtimeScoreNotifier              *websocket.Notifier
 	ReloadDisplaysNotifier             *websocket.Notifier
 	ScorePostedNotifier                *websocket.Notifier
@@ -34,7 +38,8 @@ type ArenaNotifiers struct {
 
 type MatchTimeMessage struct {
 	MatchState
-	MatchTimeSec int
+	MatchTimeSec     int
+	FieldFaultPaused bool
 }
 
 type audienceAllianceScoreFields struct {
@@ -56,11 +61,14 @@ func (arena *Arena) configureNotifiers() {
 		"displayConfiguration", arena.generateDisplayConfigurationMessage,
 	)
 	arena.EventStatusNotifier = websocket.NewNotifier("eventStatus", arena.generateEventStatusMessage)
+	arena.FieldEventsNotifier = websocket.NewNotifier("fieldEvents", arena.generateFieldEventsMessage)
 	arena.LowerThirdNotifier = websocket.NewNotifier("lowerThird", arena.generateLowerThirdMessage)
 	arena.MatchLoadNotifier = websocket.NewNotifier("matchLoad", arena.GenerateMatchLoadMessage)
 	arena.MatchTimeNotifier = websocket.NewNotifier("matchTime", arena.generateMatchTimeMessage)
 	arena.MatchTimingNotifier = websocket.NewNotifier("matchTiming", arena.generateMatchTimingMessage)
 	arena.PlaySoundNotifier = websocket.NewNotifier("playSound", nil)
+	arena.PracticeQueueNotifier = websocket.NewNotifier("practiceQueue", arena.generatePracticeQueueMessage)
+	arena.QueueStatusNotifier = websocket.NewNotifier("queueStatus", arena.generateQueueStatusMessage)
 	arena.RealtimeScoreNotifier = websocket.NewNotifier("realtimeScore", arena.generateRealtimeScoreMessage)
 	arena.ReloadDisplaysNotifier = websocket.NewNotifier("reload", nil)
 	arena.ScorePostedNotifier = websocket.NewNotifier("scorePosted", arena.GenerateScorePostedMessage)
@@ -105,6 +113,9 @@ func (arena *Arena) generateArenaStatusMessage() any {
 		PlcArmorBlockStatuses map[string]bool
 		StationRpiStatuses    map[string]StationRpiStatus
 		NetworkConfiguring    bool
+		UninspectedTeamIds    []int
+		InspectionOverride    bool
+		VersionIssues         map[string][]string
 	}{
 		arena.CurrentMatch.Id,
 		arena.AllianceStations,
@@ -124,6 +135,9 @@ func (arena *Arena) generateArenaStatusMessage() any {
 		arena.Plc.GetArmorBlockStatuses(),
 		arena.StationRpiStatuses(),
 		arena.NetworkConfiguring,
+		arena.UninspectedTeamIds(),
+		arena.InspectionOverride,
+		arena.StationVersionIssues(),
 	}
 }
 
@@ -146,6 +160,21 @@ func (arena *Arena) generateEventStatusMessage() any {
 	return arena.EventStatus
 }
 
+func (arena *Arena) generateFieldEventsMessage() any {
+	return arena.generateFieldEventsMessageFor(arena.FieldEvents, false)
+}
+
+// Returns a message carrying the given events for the current match; if incremental is true, they are to be appended to
+// those already sent rather than replacing them.
+func (arena *Arena) generateFieldEventsMessageFor(fieldEvents []model.FieldEvent, incremental bool) any {
+	return &struct {
+		MatchId     int
+		MatchName   string
+		Events      []model.FieldEvent
+		Incremental bool
+	}{arena.CurrentMatch.Id, arena.CurrentMatch.ShortName, fieldEvents, incremental}
+}
+
 func (arena *Arena) generateLowerThirdMessage() any {
 	return &struct {
 		LowerThird     *model.LowerThird
@@ -198,6 +227,7 @@ func (arena *Arena) GenerateMatchLoadMessage() any {
 		AllowSubstitution bool
 		IsReplay          bool
 		Teams             map[string]*model.Team
+		TeamsPerAlliance  int
 		Rankings          map[string]int
 		Matchup           *playoff.Matchup
 		RedOffFieldTeams  []*model.Team
@@ -208,6 +238,7 @@ func (arena *Arena) GenerateMatchLoadMessage() any {
 		arena.CurrentMatch.ShouldAllowSubstitution(),
 		isReplay,
 		teams,
+		arena.EventSettings.TeamsPerAlliance,
 		rankings,
 		matchup,
 		redOffFieldTeams,
@@ -217,13 +248,35 @@ func (arena *Arena) GenerateMatchLoadMessage() any {
 }
 
 func (arena *Arena) generateMatchTimeMessage() any {
-	return MatchTimeMessage{arena.MatchState, int(arena.MatchTimeSec())}
+	return MatchTimeMessage{arena.MatchState, int(arena.MatchTimeSec()), arena.IsFieldFaultPaused()}
 }
 
 func (arena *Arena) generateMatchTimingMessage() any {
 	return &game.MatchTiming
 }
 
+func (arena *Arena) generatePracticeQueueMessage() any {
+	queue, err := arena.GetPracticeQueue()
+	if err != nil {
+		log.Printf("Failed to get practice queue: %s", err.Error())
+	}
+	return &struct {
+		Enabled bool
+		Queue   []PracticeQueueTeam
+	}{arena.EventSettings.PracticeOpenQueueEnabled, queue}
+}
+
+func (arena *Arena) generateQueueStatusMessage() any {
+	queueMatches, err := arena.GetQueueMatches()
+	if err != nil {
+		log.Printf("Failed to get queue matches: %s", err.Error())
+	}
+	return &struct {
+		Enabled bool
+		Matches []QueueMatch
+	}{arena.EventSettings.QueueCallLeadMatches > 0, queueMatches}
+}
+
 func (arena *Arena) generateRealtimeScoreMessage() any {
 	fields := struct {
 		Red       *audienceAllianceScoreFields
@@ -272,11 +325,17 @@ func (arena *Arena) GenerateScorePostedMessage() any {
 		redOffFieldTeamIds, blueOffFieldTeamIds, _ = arena.Database.GetOffFieldTeamIds(arena.SavedMatch)
 	}
 
-	redRankings := map[int]*game.Ranking{
-		arena.SavedMatch.Red1: nil, arena.SavedMatch.Red2: nil, arena.SavedMatch.Red3: nil,
+	redRankings := map[int]*game.Ranking{}
+	for _, teamId := range arena.SavedMatch.RedTeamIds() {
+		if teamId > 0 {
+			redRankings[teamId] = nil
+		}
 	}
-	blueRankings := map[int]*game.Ranking{
-		arena.SavedMatch.Blue1: nil, arena.SavedMatch.Blue2: nil, arena.SavedMatch.Blue3: nil,
+	blueRankings := map[int]*game.Ranking{}
+	for _, teamId := range arena.SavedMatch.BlueTeamIds() {
+		if teamId > 0 {
+			blueRankings[teamId] = nil
+		}
 	}
 	for index, ranking := range arena.SavedRankings {
 		if _, ok := redRankings[ranking.TeamId]; ok {
@@ -15,6 +15,7 @@ import (
 	"net/http/httptest"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -54,7 +55,7 @@ func TestAssignTeam(t *testing.T) {
 	assert.Nil(t, arena.AllianceStations["R2"].DsConn)
 
 	// Check assigning to a non-existent station.
-	err = arena.assignTeam(254, "R4")
+	err = arena.assignTeam(254, "R5")
 	if assert.NotNil(t, err) {
 		assert.Contains(t, err.Error(), "Invalid alliance station")
 	}
@@ -90,6 +91,44 @@ func TestArenaCheckCanStartMatch(t *testing.T) {
 	assert.Nil(t, arena.checkCanStartMatch())
 }
 
+func TestArenaCheckCanStartMatchPlayoffTurnaround(t *testing.T) {
+	arena := setupTestArena(t)
+	arena.EventSettings.PlayoffMinTurnaroundSec = 300
+	for _, station := range arena.AllianceStations {
+		station.Bypass = true
+	}
+
+	previousMatch := model.Match{Type: model.Playoff, TypeOrder: 1, PlayoffRedAlliance: 2, PlayoffBlueAlliance: 7}
+	previousMatch.StartedAt = time.Now().Add(-game.GetDurationToTeleopEnd() - 100*time.Second)
+	assert.Nil(t, arena.Database.CreateMatch(&previousMatch))
+	match := model.Match{Type: model.Playoff, TypeOrder: 2, PlayoffRedAlliance: 3, PlayoffBlueAlliance: 2}
+	assert.Nil(t, arena.Database.CreateMatch(&match))
+	assert.Nil(t, arena.LoadMatch(&match))
+	err := arena.checkCanStartMatch()
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "cannot start match until alliance 2 has had its minimum turnaround time")
+		assert.Contains(t, err.Error(), "remaining")
+	}
+
+	// Check that the constraint no longer applies once the turnaround time has elapsed.
+	previousMatch.StartedAt = time.Now().Add(-game.GetDurationToTeleopEnd() - 301*time.Second)
+	assert.Nil(t, arena.Database.UpdateMatch(&previousMatch))
+	assert.Nil(t, arena.LoadMatch(&match))
+	assert.Nil(t, arena.checkCanStartMatch())
+
+	// Check that the constraint can be disabled.
+	previousMatch.StartedAt = time.Now()
+	assert.Nil(t, arena.Database.UpdateMatch(&previousMatch))
+	arena.EventSettings.PlayoffMinTurnaroundSec = 0
+	assert.Nil(t, arena.LoadMatch(&match))
+	assert.Nil(t, arena.checkCanStartMatch())
+
+	// Check that the constraint doesn't apply to non-playoff matches.
+	arena.EventSettings.PlayoffMinTurnaroundSec = 300
+	assert.Nil(t, arena.LoadTestMatch())
+	assert.Nil(t, arena.checkCanStartMatch())
+}
+
 func TestArenaMatchFlow(t *testing.T) {
 	arena := setupTestArena(t)
 
@@ -336,8 +375,8 @@ func TestMatchStartRobotLinkEnforcement(t *testing.T) {
 	arena.AllianceStations["B1"].DsConn = &DriverStationConnection{TeamId: 104}
 	arena.AllianceStations["B2"].DsConn = &DriverStationConnection{TeamId: 105}
 	arena.AllianceStations["B3"].DsConn = &DriverStationConnection{TeamId: 106}
-	for _, station := range arena.AllianceStations {
-		station.DsConn.RobotLinked = true
+	for _, station := range arena.AllianceStationNames() {
+		arena.AllianceStations[station].DsConn.RobotLinked = true
 	}
 	err = arena.StartMatch()
 	assert.Nil(t, err)
@@ -419,7 +458,7 @@ func TestLoadNextMatch(t *testing.T) {
 
 	// Test match should be followed by another, empty test match.
 	assert.Equal(t, 0, arena.CurrentMatch.Id)
-	err := arena.SubstituteTeams(1114, 0, 0, 0, 0, 0)
+	err := arena.SubstituteTeams([]int{1114, 0, 0}, []int{0, 0, 0})
 	assert.Nil(t, err)
 	arena.CurrentMatch.Status = game.TieMatch
 	err = arena.LoadNextMatch(false)
@@ -453,6 +492,130 @@ func TestLoadNextMatch(t *testing.T) {
 	assert.Equal(t, qualificationMatch2.Id, arena.CurrentMatch.Id)
 }
 
+func TestLoadNextMatchMultipleFields(t *testing.T) {
+	arena1 := setupTestArena(t)
+	arena1.EventSettings.NumFields = 2
+	assert.Nil(t, arena1.Database.UpdateEventSettings(arena1.EventSettings))
+	assert.Nil(
+		t,
+		arena1.Database.CreateFieldSettings(&model.FieldSettings{Id: 2, PlcAddress: "10.0.200.10", TeamSignRed1Id: 21}),
+	)
+	arena2, err := newFieldArena(arena1.Database, 2)
+	assert.Nil(t, err)
+	newFieldGroup(arena1, arena2)
+
+	// Each field should use its own hardware settings while sharing the rest of the event settings.
+	assert.Equal(t, 2, arena2.EventSettings.NumFields)
+	assert.Equal(t, "", arena1.hardwareSettings.PlcAddress)
+	assert.Equal(t, "10.0.200.10", arena2.hardwareSettings.PlcAddress)
+	assert.Equal(t, byte(21), arena2.TeamSigns.Red1.address)
+
+	qualificationMatch1 := model.Match{Type: model.Qualification, TypeOrder: 1, FieldNumber: 1}
+	qualificationMatch2 := model.Match{Type: model.Qualification, TypeOrder: 2, FieldNumber: 2}
+	qualificationMatch3 := model.Match{Type: model.Qualification, TypeOrder: 3, FieldNumber: 1}
+	qualificationMatch4 := model.Match{Type: model.Qualification, TypeOrder: 4}
+	qualificationMatch5 := model.Match{Type: model.Qualification, TypeOrder: 5}
+	for _, match := range []*model.Match{
+		&qualificationMatch1, &qualificationMatch2, &qualificationMatch3, &qualificationMatch4, &qualificationMatch5,
+	} {
+		assert.Nil(t, arena1.Database.CreateMatch(match))
+	}
+
+	// A match that is loaded on one field can't also be loaded on the other.
+	assert.Nil(t, arena1.LoadMatch(&qualificationMatch1))
+	assert.EqualError(
+		t, arena2.LoadMatch(&qualificationMatch1), "Cannot load match  since it is already loaded on Field 1",
+	)
+	assert.Equal(t, model.Test, arena2.CurrentMatch.Type)
+
+	// Each field should only load the matches assigned to it.
+	assert.Nil(t, arena2.LoadMatch(&qualificationMatch2))
+	assert.Nil(t, arena2.LoadNextMatch(false))
+	assert.Equal(t, qualificationMatch2.Id, arena2.CurrentMatch.Id)
+	qualificationMatch1.Status = game.RedWonMatch
+	assert.Nil(t, arena1.Database.UpdateMatch(&qualificationMatch1))
+	assert.Nil(t, arena1.LoadNextMatch(false))
+	assert.Equal(t, qualificationMatch3.Id, arena1.CurrentMatch.Id)
+
+	// Matches not assigned to a field should go to whichever field is free, skipping any loaded on the other field.
+	qualificationMatch2.Status = game.BlueWonMatch
+	assert.Nil(t, arena1.Database.UpdateMatch(&qualificationMatch2))
+	assert.Nil(t, arena2.LoadNextMatch(false))
+	assert.Equal(t, qualificationMatch4.Id, arena2.CurrentMatch.Id)
+	qualificationMatch3.Status = game.TieMatch
+	assert.Nil(t, arena1.Database.UpdateMatch(&qualificationMatch3))
+	assert.Nil(t, arena1.LoadNextMatch(false))
+	assert.Equal(t, qualificationMatch5.Id, arena1.CurrentMatch.Id)
+
+	// Loading a test match should release the field's claim on its previous match.
+	assert.Nil(t, arena1.LoadTestMatch())
+	assert.Nil(t, arena2.LoadMatch(&qualificationMatch5))
+}
+
+func TestLoadNextMatchMultipleFieldsConcurrently(t *testing.T) {
+	arena1 := setupTestArena(t)
+	arena2, err := newFieldArena(arena1.Database, 2)
+	assert.Nil(t, err)
+	newFieldGroup(arena1, arena2)
+	qualificationMatch1 := model.Match{Type: model.Qualification, TypeOrder: 1}
+	qualificationMatch2 := model.Match{Type: model.Qualification, TypeOrder: 2}
+	qualificationMatch3 := model.Match{Type: model.Qualification, TypeOrder: 3}
+	for _, match := range []*model.Match{&qualificationMatch1, &qualificationMatch2, &qualificationMatch3} {
+		assert.Nil(t, arena1.Database.CreateMatch(match))
+	}
+	assert.Nil(t, arena1.LoadMatch(&qualificationMatch1))
+	assert.Nil(t, arena2.LoadMatch(&qualificationMatch2))
+	qualificationMatch1.Status = game.RedWonMatch
+	assert.Nil(t, arena1.Database.UpdateMatch(&qualificationMatch1))
+	qualificationMatch2.Status = game.BlueWonMatch
+	assert.Nil(t, arena1.Database.UpdateMatch(&qualificationMatch2))
+
+	// Both fields advancing at the same time should never end up with the same match.
+	var wg sync.WaitGroup
+	for _, arena := range []*Arena{arena1, arena2} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, arena.LoadNextMatch(false))
+		}()
+	}
+	wg.Wait()
+	assert.ElementsMatch(
+		t, []int{qualificationMatch3.Id, 0}, []int{arena1.CurrentMatch.Id, arena2.CurrentMatch.Id},
+	)
+}
+
+func TestUnloadMatchesFromAllFields(t *testing.T) {
+	arena1 := setupTestArena(t)
+	arena2, err := newFieldArena(arena1.Database, 2)
+	assert.Nil(t, err)
+	newFieldGroup(arena1, arena2)
+	qualificationMatch1 := model.Match{Type: model.Qualification, TypeOrder: 1, ShortName: "Q1"}
+	qualificationMatch2 := model.Match{Type: model.Qualification, TypeOrder: 2, ShortName: "Q2"}
+	for _, match := range []*model.Match{&qualificationMatch1, &qualificationMatch2} {
+		assert.Nil(t, arena1.Database.CreateMatch(match))
+	}
+	assert.Nil(t, arena1.LoadMatch(&qualificationMatch1))
+	assert.Nil(t, arena2.LoadMatch(&qualificationMatch2))
+
+	// Check that nothing is unloaded if any of the matches is in progress on another field.
+	arena2.MatchState = AutoPeriod
+	assert.EqualError(
+		t,
+		arena1.UnloadMatchesFromAllFields([]int{qualificationMatch1.Id, qualificationMatch2.Id}),
+		"match Q2 is in progress on Field 2",
+	)
+	assert.Equal(t, qualificationMatch1.Id, arena1.CurrentMatch.Id)
+	assert.Equal(t, qualificationMatch2.Id, arena2.CurrentMatch.Id)
+	arena2.MatchState = PreMatch
+
+	// Check that only the given matches are unloaded, and that their claims are released.
+	assert.Nil(t, arena1.UnloadMatchesFromAllFields([]int{qualificationMatch2.Id}))
+	assert.Equal(t, qualificationMatch1.Id, arena1.CurrentMatch.Id)
+	assert.Equal(t, model.Test, arena2.CurrentMatch.Type)
+	assert.False(t, arena1.fieldGroup.isMatchLoadedOnOtherField(1, qualificationMatch2.Id))
+}
+
 func TestSubstituteTeam(t *testing.T) {
 	arena := setupTestArena(t)
 	tournament.CreateTestAlliances(arena.Database, 2)
@@ -469,11 +632,11 @@ func TestSubstituteTeam(t *testing.T) {
 	arena.Database.CreateTeam(&model.Team{Id: 107})
 
 	// Substitute teams into test match.
-	err := arena.SubstituteTeams(0, 0, 0, 101, 0, 0)
+	err := arena.SubstituteTeams([]int{0, 0, 0}, []int{101, 0, 0})
 	assert.Nil(t, err)
 	assert.Equal(t, 101, arena.CurrentMatch.Blue1)
 	assert.Equal(t, 101, arena.AllianceStations["B1"].Team.Id)
-	err = arena.assignTeam(104, "R4")
+	err = arena.assignTeam(104, "R5")
 	if assert.NotNil(t, err) {
 		assert.Contains(t, err.Error(), "Invalid alliance station")
 	}
@@ -482,7 +645,7 @@ func TestSubstituteTeam(t *testing.T) {
 	match := model.Match{Type: model.Practice, Red1: 101, Red2: 102, Red3: 103, Blue1: 104, Blue2: 105, Blue3: 106}
 	arena.Database.CreateMatch(&match)
 	arena.LoadMatch(&match)
-	err = arena.SubstituteTeams(107, 102, 103, 104, 105, 106)
+	err = arena.SubstituteTeams([]int{107, 102, 103}, []int{104, 105, 106})
 	assert.Nil(t, err)
 	assert.Equal(t, 107, arena.CurrentMatch.Red1)
 	assert.Equal(t, 107, arena.AllianceStations["R1"].Team.Id)
@@ -493,22 +656,82 @@ func TestSubstituteTeam(t *testing.T) {
 	match = model.Match{Type: model.Qualification, Red1: 101, Red2: 102, Red3: 103, Blue1: 104, Blue2: 105, Blue3: 106}
 	arena.Database.CreateMatch(&match)
 	arena.LoadMatch(&match)
-	err = arena.SubstituteTeams(107, 102, 103, 104, 105, 106)
+	err = arena.SubstituteTeams([]int{107, 102, 103}, []int{104, 105, 106})
 	if assert.NotNil(t, err) {
 		assert.Contains(t, err.Error(), "Can't substitute teams for qualification matches.")
 	}
 	match = model.Match{Type: model.Playoff, Red1: 101, Red2: 102, Red3: 103, Blue1: 104, Blue2: 105, Blue3: 106}
 	arena.Database.CreateMatch(&match)
 	arena.LoadMatch(&match)
-	assert.Nil(t, arena.SubstituteTeams(107, 102, 103, 104, 105, 106))
+	assert.Nil(t, arena.SubstituteTeams([]int{107, 102, 103}, []int{104, 105, 106}))
 
 	// Check that loading a nonexistent team fails.
-	err = arena.SubstituteTeams(101, 102, 103, 104, 105, 108)
+	err = arena.SubstituteTeams([]int{101, 102, 103}, []int{104, 105, 108})
 	if assert.NotNil(t, err) {
 		assert.Equal(t, err.Error(), "Team 108 is not present at the event.")
 	}
 }
 
+func TestArenaTeamsPerAlliance(t *testing.T) {
+	arena := setupTestArena(t)
+	for i := 101; i <= 108; i++ {
+		arena.Database.CreateTeam(&model.Team{Id: i})
+	}
+	assert.True(t, arena.AllianceStations["R4"].Unmanaged)
+	assert.True(t, arena.AllianceStations["B4"].Unmanaged)
+	assert.False(t, arena.AllianceStations["B3"].Unmanaged)
+
+	// Check that the fourth stations are ignored with the default of three teams per alliance.
+	match := model.Match{
+		Type: model.Practice, Red1: 101, Red2: 102, Red3: 103, Red4: 104, Blue1: 105, Blue2: 106, Blue3: 107, Blue4: 108,
+	}
+	arena.Database.CreateMatch(&match)
+	assert.Nil(t, arena.LoadMatch(&match))
+	assert.Nil(t, arena.AllianceStations["R4"].Team)
+	assert.Nil(t, arena.AllianceStations["B4"].Team)
+	assert.Equal(t, 3, arena.RedRealtimeScore.CurrentScore.RobotCount())
+	err := arena.SubstituteTeams([]int{101, 102, 103, 104}, []int{105, 106, 107, 0})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "only 3 teams per alliance")
+	}
+
+	// Check four teams per alliance.
+	arena.EventSettings.TeamsPerAlliance = 4
+	assert.Nil(t, arena.LoadMatch(&match))
+	assert.Equal(t, 104, arena.AllianceStations["R4"].Team.Id)
+	assert.Equal(t, 108, arena.AllianceStations["B4"].Team.Id)
+	assert.Equal(t, 4, arena.BlueRealtimeScore.CurrentScore.RobotCount())
+	assert.Equal(t, []string{"R1", "R2", "R3", "R4", "B1", "B2", "B3", "B4"}, arena.AllianceStationNames())
+	for _, station := range []string{"R1", "R2", "R3", "B1", "B2", "B3"} {
+		arena.AllianceStations[station].Bypass = true
+	}
+	err = arena.checkCanStartMatch()
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "cannot start match until all robots are connected or bypassed")
+	}
+	arena.AllianceStations["R4"].Bypass = true
+	arena.AllianceStations["B4"].Bypass = true
+	assert.Nil(t, arena.checkCanStartMatch())
+	assert.Nil(t, arena.SubstituteTeams([]int{101, 102, 103, 0}, []int{105, 106, 107, 104}))
+	assert.Nil(t, arena.AllianceStations["R4"].Team)
+	assert.Equal(t, 104, arena.CurrentMatch.Blue4)
+
+	// Check two teams per alliance.
+	arena.EventSettings.TeamsPerAlliance = 2
+	assert.Nil(t, arena.LoadMatch(&match))
+	assert.Equal(t, 102, arena.AllianceStations["R2"].Team.Id)
+	assert.Nil(t, arena.AllianceStations["R3"].Team)
+	assert.Nil(t, arena.AllianceStations["B3"].Team)
+	assert.Equal(t, 2, arena.RedRealtimeScore.CurrentScore.RobotCount())
+	for _, station := range arena.AllianceStations {
+		station.Bypass = false
+	}
+	for _, station := range []string{"R1", "R2", "B1", "B2"} {
+		arena.AllianceStations[station].Bypass = true
+	}
+	assert.Nil(t, arena.checkCanStartMatch())
+}
+
 func TestLoadTeamsFromNexus(t *testing.T) {
 	arena := setupTestArena(t)
 
@@ -649,6 +872,79 @@ func TestArenaTimeout(t *testing.T) {
 	assert.Equal(t, match, *arena.CurrentMatch)
 }
 
+func TestArenaFieldFaultPause(t *testing.T) {
+	arena := setupTestArena(t)
+	match := model.Match{Type: model.Qualification, ShortName: "Q1"}
+	assert.Nil(t, arena.Database.CreateMatch(&match))
+	assert.Nil(t, arena.LoadMatch(&match))
+	for _, allianceStation := range arena.AllianceStations {
+		allianceStation.Bypass = true
+	}
+	dummyDs := &DriverStationConnection{TeamId: 254, RobotLinked: true}
+	arena.AllianceStations["B3"].DsConn = dummyDs
+	arena.AllianceStations["B3"].Bypass = false
+
+	// Check that a match can only be paused while it is underway.
+	assert.EqualError(t, arena.PauseMatch(""), "cannot pause match when it is not in progress")
+	assert.EqualError(t, arena.ResumeMatch(), "cannot resume match when it is not paused")
+	assert.Nil(t, arena.StartMatch())
+	arena.Update()
+	arena.Update()
+	arena.MatchStartTime = time.Now().Add(-time.Duration(game.MatchTiming.WarmupDurationSec+5) * time.Second)
+	arena.Update()
+	assert.Equal(t, AutoPeriod, arena.MatchState)
+	assert.Equal(t, true, dummyDs.Enabled)
+
+	// Check that pausing disables the robots and freezes the clock.
+	assert.Nil(t, arena.PauseMatch("Field element fell over"))
+	assert.True(t, arena.IsFieldFaultPaused())
+	assert.EqualError(t, arena.PauseMatch(""), "match is already paused")
+	pausedMatchTimeSec := arena.MatchTimeSec()
+	arena.Update()
+	assert.Equal(t, AutoPeriod, arena.MatchState)
+	assert.Equal(t, true, dummyDs.Auto)
+	assert.Equal(t, false, dummyDs.Enabled)
+	arena.fieldFaultPauseStartTime = arena.fieldFaultPauseStartTime.Add(-time.Minute)
+	arena.MatchStartTime = arena.MatchStartTime.Add(-time.Minute)
+	arena.Update()
+	assert.Equal(t, AutoPeriod, arena.MatchState)
+	assert.Equal(t, false, dummyDs.Enabled)
+	assert.InDelta(t, pausedMatchTimeSec, arena.MatchTimeSec(), 0.01)
+
+	// Check that resuming picks up in the same period with the same time remaining.
+	assert.Nil(t, arena.ResumeMatch())
+	assert.False(t, arena.IsFieldFaultPaused())
+	assert.InDelta(t, pausedMatchTimeSec, arena.MatchTimeSec(), 0.05)
+	arena.Update()
+	assert.Equal(t, AutoPeriod, arena.MatchState)
+	assert.Equal(t, true, dummyDs.Enabled)
+
+	// Check that the pause was recorded in the match record and the field event log.
+	dbMatch, _ := arena.Database.GetMatchById(match.Id)
+	if assert.Equal(t, 1, len(dbMatch.FieldFaultPauses)) {
+		assert.Equal(t, "Field element fell over", dbMatch.FieldFaultPauses[0].Reason)
+		assert.InDelta(t, pausedMatchTimeSec, dbMatch.FieldFaultPauses[0].MatchTimeSec, 0.01)
+		assert.InDelta(t, 60, dbMatch.FieldFaultPauses[0].DurationSec, 0.5)
+	}
+	var descriptions []string
+	for _, fieldEvent := range arena.FieldEvents {
+		if fieldEvent.Type == FieldEventFieldFault {
+			descriptions = append(descriptions, fieldEvent.Description)
+		}
+	}
+	if assert.Equal(t, 2, len(descriptions)) {
+		assert.Equal(t, "Field fault pause (Field element fell over)", descriptions[0])
+		assert.Equal(t, "Resumed after 60.0 sec", descriptions[1])
+	}
+
+	// Check that aborting a paused match closes out the pause.
+	assert.Nil(t, arena.PauseMatch(""))
+	assert.Nil(t, arena.AbortMatch())
+	assert.False(t, arena.IsFieldFaultPaused())
+	assert.Equal(t, PostMatch, arena.MatchState)
+	assert.Equal(t, 2, len(arena.CurrentMatch.FieldFaultPauses))
+}
+
 func TestSaveTeamHasConnected(t *testing.T) {
 	arena := setupTestArena(t)
 
@@ -1438,3 +1734,28 @@ func TestPlcMatchCycleGameSpecificWithCoopDisabled(t *testing.T) {
 		)
 	}
 }
+
+func TestArenaScheduleQualificationReplay(t *testing.T) {
+	arena := setupTestArena(t)
+	for i := 1; i <= 3; i++ {
+		match := model.Match{Type: model.Qualification, TypeOrder: i, ShortName: "Q" + strconv.Itoa(i)}
+		if i == 1 {
+			match.Status = game.RedWonMatch
+		}
+		assert.Nil(t, arena.Database.CreateMatch(&match))
+	}
+	match, _ := arena.Database.GetMatchById(2)
+	assert.Nil(t, arena.LoadMatch(match))
+
+	_, err := arena.ScheduleQualificationReplay(1, "", 2)
+	assert.NotNil(t, err)
+	replay, err := arena.ScheduleQualificationReplay(1, "Field fault", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, replay.TypeOrder)
+
+	// The loaded match should be renumbered along with the saved one.
+	assert.Equal(t, 3, arena.CurrentMatch.TypeOrder)
+	nextMatch, err := arena.getNextMatch(true)
+	assert.Nil(t, err)
+	assert.Equal(t, "Q1R", nextMatch.ShortName)
+}
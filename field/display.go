@@ -41,6 +41,7 @@ const (
 	TwitchStreamDisplay
 	WallDisplay
 	WebpageDisplay
+	JudgingQueueDisplay
 )
 
 var DisplayTypeNames = map[DisplayType]string{
@@ -50,6 +51,7 @@ var DisplayTypeNames = map[DisplayType]string{
 	AudienceDisplay:        "Audience",
 	BracketDisplay:         "Bracket",
 	FieldMonitorDisplay:    "Field Monitor",
+	JudgingQueueDisplay:    "Judging Queue",
 	LogoDisplay:            "Logo",
 	QueueingDisplay:        "Queueing",
 	RankingsDisplay:        "Rankings",
@@ -65,6 +67,7 @@ var displayTypePaths = map[DisplayType]string{
 	AudienceDisplay:        "/displays/audience",
 	BracketDisplay:         "/displays/bracket",
 	FieldMonitorDisplay:    "/displays/field_monitor",
+	JudgingQueueDisplay:    "/displays/judging_queue",
 	LogoDisplay:            "/displays/logo",
 	QueueingDisplay:        "/displays/queueing",
 	RankingsDisplay:        "/displays/rankings",
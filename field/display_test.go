@@ -36,6 +36,8 @@ func TestDisplayFromUrl(t *testing.T) {
 	assert.Equal(t, FieldMonitorDisplay, display.Type)
 	display, _ = DisplayFromUrl("/displays/rankings/websocket", query)
 	assert.Equal(t, RankingsDisplay, display.Type)
+	display, _ = DisplayFromUrl("/displays/judging_queue/websocket", query)
+	assert.Equal(t, JudgingQueueDisplay, display.Type)
 
 	// Test the nickname and arbitrary parameters.
 	query["nickname"] = []string{"Test Nickname"}
@@ -11,6 +11,7 @@ import (
 	"net"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Team254/cheesy-arena/game"
@@ -30,6 +31,13 @@ const (
 	maxTcpPacketBytes              = 4096
 )
 
+// Tags of the TCP packets in which the DS reports the versions of the software on it and on the robot.
+const (
+	dsTcpTagWpilibVersion = 0
+	dsTcpTagRioVersion    = 1
+	dsTcpTagDsVersion     = 2
+)
+
 type DriverStationConnection struct {
 	TeamId                    int
 	AllianceStation           string
@@ -45,6 +53,9 @@ type DriverStationConnection struct {
 	DsRobotTripTimeMs         int
 	MissedPacketCount         int
 	SecondsSinceLastRobotLink float64
+	DsVersion                 string
+	RioVersion                string
+	WpilibVersion             string
 	lastPacketTime            time.Time
 	lastRobotLinkedTime       time.Time
 	packetCount               int
@@ -59,7 +70,10 @@ type DriverStationConnection struct {
 	WrongStation string
 }
 
-var allianceStationPositionMap = map[string]byte{"R1": 0, "R2": 1, "R3": 2, "B1": 3, "B2": 4, "B3": 5}
+// The driver station protocol only knows of three stations per alliance, so any further stations report as the third.
+var allianceStationPositionMap = map[string]byte{
+	"R1": 0, "R2": 1, "R3": 2, "R4": 2, "B1": 3, "B2": 4, "B3": 5, "B4": 5,
+}
 
 // Opens a UDP connection for communicating to the driver station.
 func newDriverStationConnection(
@@ -94,8 +108,20 @@ func newDriverStationConnection(
 // Loops indefinitely to read packets and update connection status.
 func (arena *Arena) listenForDsUdpPackets() {
 	listenAddress := fmt.Sprintf(":%d", driverStationUdpReceivePort)
-	if ip := network.FieldAdapterIP(); ip != nil {
+	if arena.FieldNumber > 1 {
+		if arena.dsListenAddress == "" {
+			log.Printf(
+				"No driver station address is configured for Field %d; not listening for UDP packets.", arena.FieldNumber,
+			)
+			return
+		}
+		listenAddress = fmt.Sprintf("%s:%d", arena.dsListenAddress, driverStationUdpReceivePort)
+	} else if ip := network.FieldAdapterIP(); ip != nil {
 		listenAddress = fmt.Sprintf("%s:%d", ip.String(), driverStationUdpReceivePort)
+	} else if arena.EventSettings.NumFields > 1 {
+		// Listening on all interfaces would take the port away from the other fields.
+		log.Printf("No field network adapter is configured for Field 1; not listening for UDP packets.")
+		return
 	}
 	udpAddress, _ := net.ResolveUDPAddr("udp4", listenAddress)
 	listener, err := net.ListenUDP("udp4", udpAddress)
@@ -240,18 +266,22 @@ func (dsConn *DriverStationConnection) encodeControlPacket(arena *Arena) [22]byt
 
 	// Remaining number of seconds in match.
 	var matchSecondsRemaining int
-	switch arena.MatchState {
-	case PreMatch, TimeoutActive, PostTimeout:
-		matchSecondsRemaining = game.MatchTiming.AutoDurationSec
-	case StartMatch, AutoPeriod:
-		matchSecondsRemaining = game.MatchTiming.AutoDurationSec - int(arena.MatchTimeSec())
-	case PausePeriod:
-		matchSecondsRemaining = game.MatchTiming.TeleopDurationSec
-	case TeleopPeriod:
-		matchSecondsRemaining = game.MatchTiming.AutoDurationSec + game.MatchTiming.TeleopDurationSec +
-			game.MatchTiming.PauseDurationSec - int(arena.MatchTimeSec())
-	default:
-		matchSecondsRemaining = 0
+	if miniMatch := arena.stationMiniMatch(dsConn.AllianceStation); miniMatch != nil {
+		matchSecondsRemaining = miniMatch.RemainingSec
+	} else {
+		switch arena.MatchState {
+		case PreMatch, TimeoutActive, PostTimeout:
+			matchSecondsRemaining = game.MatchTiming.AutoDurationSec
+		case StartMatch, AutoPeriod:
+			matchSecondsRemaining = game.MatchTiming.AutoDurationSec - int(arena.MatchTimeSec())
+		case PausePeriod:
+			matchSecondsRemaining = game.MatchTiming.TeleopDurationSec
+		case TeleopPeriod:
+			matchSecondsRemaining = game.MatchTiming.AutoDurationSec + game.MatchTiming.TeleopDurationSec +
+				game.MatchTiming.PauseDurationSec - int(arena.MatchTimeSec())
+		default:
+			matchSecondsRemaining = 0
+		}
 	}
 	packet[20] = byte(matchSecondsRemaining >> 8 & 0xff)
 	packet[21] = byte(matchSecondsRemaining & 0xff)
@@ -284,12 +314,48 @@ func (dsConn *DriverStationConnection) decodeStatusPacket(data [36]byte) {
 	dsConn.MissedPacketCount = int(data[2]) - dsConn.missedPacketOffset
 }
 
+// Records the software version reported by the DS in the given TCP packet, which is the text following the tag.
+func (dsConn *DriverStationConnection) decodeVersionPacket(packet []byte) {
+	size := int(packet[0])<<8 + int(packet[1])
+	if size < 1 || size+2 > len(packet) {
+		return
+	}
+	version := strings.TrimSpace(strings.Map(
+		func(r rune) rune {
+			// Drop any non-printable characters, such as a leading status byte, from around the version string.
+			if r < ' ' || r > '~' {
+				return -1
+			}
+			return r
+		},
+		string(packet[3:size+2]),
+	))
+	switch int(packet[2]) {
+	case dsTcpTagWpilibVersion:
+		dsConn.WpilibVersion = version
+	case dsTcpTagRioVersion:
+		dsConn.RioVersion = version
+	case dsTcpTagDsVersion:
+		dsConn.DsVersion = version
+	}
+}
+
 // Listens for TCP connection requests to Cheesy Arena from driver stations.
 func (arena *Arena) listenForDriverStations() {
-	l, err := net.Listen("tcp", fmt.Sprintf("%s:%d", network.ServerIpAddress, driverStationTcpListenPort))
+	serverIpAddress := network.ServerIpAddress
+	if arena.FieldNumber > 1 {
+		if arena.dsListenAddress == "" {
+			log.Printf(
+				"No driver station address is configured for Field %d; not listening for driver stations.", arena.FieldNumber,
+			)
+			return
+		}
+		serverIpAddress = arena.dsListenAddress
+	}
+	l, err := net.Listen("tcp", fmt.Sprintf("%s:%d", serverIpAddress, driverStationTcpListenPort))
 	if err != nil {
 		log.Printf("Error opening driver station TCP socket: %v", err.Error())
-		log.Printf("Change IP address to %s and restart Cheesy Arena to fix.", network.ServerIpAddress)
+		log.Printf("Change IP address to %s and restart Cheesy Arena to fix.", serverIpAddress)
 		return
 	}
 	defer l.Close()
@@ -394,14 +460,19 @@ func (dsConn *DriverStationConnection) handleTcpConnection(arena *Arena) {
 		case 29:
 			// DS keepalive packet; do nothing.
 			continue
+		case dsTcpTagWpilibVersion, dsTcpTagRioVersion, dsTcpTagDsVersion:
+			dsConn.decodeVersionPacket(buffer)
 		case 22:
 			// Robot status packet.
 			var statusPacket [36]byte
 			copy(statusPacket[:], buffer[2:38])
 			dsConn.decodeStatusPacket(statusPacket)
 
-			// Create a log entry if the match is in progress.
+			// Create a log entry if the match or the station's own mini-match is in progress.
 			matchTimeSec := arena.MatchTimeSec()
+			if miniMatch := arena.stationMiniMatch(dsConn.AllianceStation); miniMatch != nil {
+				matchTimeSec = miniMatch.elapsedSec()
+			}
 			if matchTimeSec > 0 && dsConn.log != nil {
 				dsConn.log.LogDsPacket(matchTimeSec, packetType, dsConn)
 			}
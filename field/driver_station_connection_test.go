@@ -162,6 +162,21 @@ func TestDecodeStatusPacket(t *testing.T) {
 	assert.Equal(t, 14, dsConn.DsRobotTripTimeMs)
 }
 
+func TestDecodeVersionPacket(t *testing.T) {
+	dsConn := &DriverStationConnection{TeamId: 254}
+
+	dsConn.decodeVersionPacket(append([]byte{0, 6, dsTcpTagDsVersion, 0}, "25.0"...))
+	assert.Equal(t, "25.0", dsConn.DsVersion)
+	dsConn.decodeVersionPacket(append([]byte{0, 23, dsTcpTagRioVersion}, "FRC_roboRIO2_2025_v2.0"...))
+	assert.Equal(t, "FRC_roboRIO2_2025_v2.0", dsConn.RioVersion)
+	dsConn.decodeVersionPacket(append([]byte{0, 11, dsTcpTagWpilibVersion, 0}, "2025.3.2\x00"...))
+	assert.Equal(t, "2025.3.2", dsConn.WpilibVersion)
+
+	// Check that a packet whose size doesn't match its contents is ignored.
+	dsConn.decodeVersionPacket(append([]byte{0, 50, dsTcpTagDsVersion}, "26.0"...))
+	assert.Equal(t, "25.0", dsConn.DsVersion)
+}
+
 func TestListenForDriverStations(t *testing.T) {
 	arena := setupTestArena(t)
 
@@ -220,6 +235,11 @@ func TestListenForDriverStations(t *testing.T) {
 			time.Sleep(time.Millisecond * 10)
 			assert.Equal(t, 103, dsConn.MissedPacketCount)
 			assert.Equal(t, 14, dsConn.DsRobotTripTimeMs)
+
+			// Check that a version packet gets decoded.
+			tcpConn.Write(append([]byte{0, 6, dsTcpTagDsVersion, 0}, "25.0"...))
+			time.Sleep(time.Millisecond * 10)
+			assert.Equal(t, "25.0", dsConn.DsVersion)
 		}
 	}
 }
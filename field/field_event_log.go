@@ -0,0 +1,247 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Recording of a timeline of the connection, stop and state transitions on the field during each match.
+
+package field
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// Types of events that are recorded in the field event log.
+const (
+	FieldEventDsLink           = "dsLink"
+	FieldEventRadioLink        = "radioLink"
+	FieldEventRioLink          = "rioLink"
+	FieldEventRobotLink        = "robotLink"
+	FieldEventEStop            = "eStop"
+	FieldEventAStop            = "aStop"
+	FieldEventWrongStation     = "wrongStation"
+	FieldEventBypass           = "bypass"
+	FieldEventMatchState       = "matchState"
+	FieldEventFieldFault       = "fieldFault"
+	FieldEventStationMiniMatch = "stationMiniMatch"
+	FieldEventInspection       = "inspection"
+)
+
+var matchStateNames = map[MatchState]string{
+	PreMatch:      "Pre-Match",
+	StartMatch:    "Start Match",
+	WarmupPeriod:  "Warmup",
+	AutoPeriod:    "Autonomous",
+	PausePeriod:   "Pause",
+	TeleopPeriod:  "Teleoperated",
+	PostMatch:     "Post-Match",
+	TimeoutActive: "Timeout",
+	PostTimeout:   "Post-Timeout",
+}
+
+// stationEventState is a snapshot of the state of an alliance station that is watched for transitions.
+type stationEventState struct {
+	teamId       int
+	dsLinked     bool
+	radioLinked  bool
+	rioLinked    bool
+	robotLinked  bool
+	eStop        bool
+	aStop        bool
+	bypass       bool
+	wrongStation string
+}
+
+// Clears the field event log for the newly loaded match, picking up any events already recorded for it in an earlier
+// attempt at playing it. The current station states are taken as the starting point without being recorded.
+func (arena *Arena) resetFieldEvents() {
+	arena.FieldEvents = []model.FieldEvent{}
+	arena.pendingFieldEvents = nil
+	if arena.CurrentMatch.Type != model.Test {
+		// Hold the save lock so that events in the middle of being saved are neither missed nor counted twice.
+		arena.saveFieldEventsMutex.Lock()
+		fieldEvents, err := arena.Database.GetFieldEventsByMatchId(arena.CurrentMatch.Id)
+		if err != nil {
+			log.Printf("Failed to load field events for match %s: %v", arena.CurrentMatch.ShortName, err)
+		} else if fieldEvents != nil {
+			arena.FieldEvents = fieldEvents
+		}
+
+		// Include any events for the match that haven't been saved yet.
+		arena.unsavedFieldEventsMutex.Lock()
+		for _, fieldEvent := range arena.unsavedFieldEvents {
+			if fieldEvent.MatchId == arena.CurrentMatch.Id {
+				arena.FieldEvents = append(arena.FieldEvents, fieldEvent)
+			}
+		}
+		arena.unsavedFieldEventsMutex.Unlock()
+		arena.saveFieldEventsMutex.Unlock()
+	}
+	arena.stationEventStates = make(map[string]stationEventState)
+	for station, allianceStation := range arena.AllianceStations {
+		arena.stationEventStates[station] = allianceStation.eventState()
+	}
+	arena.FieldEventsNotifier.Notify()
+}
+
+// Compares the current state of the field against the one last seen and records an event for each transition.
+func (arena *Arena) recordFieldEvents() {
+	if arena.MatchState != arena.lastMatchState && arena.lastMatchState >= 0 {
+		arena.addFieldEvent(FieldEventMatchState, "", 0, matchStateNames[arena.MatchState])
+		if arena.MatchState == PostMatch {
+			// Save the match's events as soon as it is over rather than waiting for the next periodic save.
+			go arena.saveFieldEvents()
+		}
+	}
+
+	for _, station := range arena.AllianceStationNames() {
+		allianceStation := arena.AllianceStations[station]
+		current := allianceStation.eventState()
+		previous, ok := arena.stationEventStates[station]
+		arena.stationEventStates[station] = current
+		if !ok || current.teamId != previous.teamId {
+			// A change of team is not a transition in itself; start watching the new team from its current state.
+			continue
+		}
+
+		addLinkEvent := func(eventType, name string, wasLinked, isLinked bool) {
+			if isLinked && !wasLinked {
+				arena.addFieldEvent(eventType, station, current.teamId, name+" linked")
+			} else if !isLinked && wasLinked {
+				arena.addFieldEvent(eventType, station, current.teamId, name+" link lost")
+			}
+		}
+		addLinkEvent(FieldEventDsLink, "DS", previous.dsLinked, current.dsLinked)
+		addLinkEvent(FieldEventRadioLink, "Radio", previous.radioLinked, current.radioLinked)
+		addLinkEvent(FieldEventRioLink, "roboRIO", previous.rioLinked, current.rioLinked)
+		addLinkEvent(FieldEventRobotLink, "Robot", previous.robotLinked, current.robotLinked)
+
+		if current.eStop && !previous.eStop {
+			arena.addFieldEvent(
+				FieldEventEStop, station, current.teamId, stopDescription("E-stop", allianceStation.eStopSource),
+			)
+		} else if !current.eStop && previous.eStop {
+			arena.addFieldEvent(FieldEventEStop, station, current.teamId, "E-stop cleared")
+		}
+		if current.aStop && !previous.aStop {
+			arena.addFieldEvent(
+				FieldEventAStop, station, current.teamId, stopDescription("A-stop", allianceStation.aStopSource),
+			)
+		} else if !current.aStop && previous.aStop {
+			arena.addFieldEvent(FieldEventAStop, station, current.teamId, "A-stop cleared")
+		}
+
+		if current.wrongStation != previous.wrongStation {
+			if current.wrongStation != "" {
+				arena.addFieldEvent(
+					FieldEventWrongStation,
+					station,
+					current.teamId,
+					fmt.Sprintf("Driver station plugged into wrong station %s", current.wrongStation),
+				)
+			} else {
+				arena.addFieldEvent(FieldEventWrongStation, station, current.teamId, "Wrong station cleared")
+			}
+		}
+
+		if current.bypass && !previous.bypass {
+			arena.addFieldEvent(FieldEventBypass, station, current.teamId, "Bypassed")
+		} else if !current.bypass && previous.bypass {
+			arena.addFieldEvent(FieldEventBypass, station, current.teamId, "Bypass removed")
+		}
+	}
+}
+
+// Adds an event to the log for the current match. It is sent to the websocket clients at the end of the arena loop
+// iteration so that a burst of transitions results in a single message, and is saved to the database later from
+// outside the loop so that the loop isn't held up by disk writes.
+func (arena *Arena) addFieldEvent(eventType, station string, teamId int, description string) {
+	fieldEvent := model.FieldEvent{
+		MatchId:      arena.CurrentMatch.Id,
+		Time:         time.Now(),
+		MatchTimeSec: arena.MatchTimeSec(),
+		Type:         eventType,
+		Station:      station,
+		TeamId:       teamId,
+		Description:  description,
+	}
+	arena.FieldEvents = append(arena.FieldEvents, fieldEvent)
+	arena.pendingFieldEvents = append(arena.pendingFieldEvents, fieldEvent)
+	if arena.CurrentMatch.Type != model.Test {
+		arena.unsavedFieldEventsMutex.Lock()
+		arena.unsavedFieldEvents = append(arena.unsavedFieldEvents, fieldEvent)
+		arena.unsavedFieldEventsMutex.Unlock()
+	}
+}
+
+// Sends the events added since the last flush to the websocket clients, which append them to the timeline they already
+// have.
+func (arena *Arena) flushFieldEvents() {
+	if len(arena.pendingFieldEvents) == 0 {
+		return
+	}
+	arena.FieldEventsNotifier.NotifyWithMessage(arena.generateFieldEventsMessageFor(arena.pendingFieldEvents, true))
+	arena.pendingFieldEvents = nil
+}
+
+// Saves the events that haven't yet been saved to the database, with a single write for each match.
+func (arena *Arena) saveFieldEvents() {
+	arena.saveFieldEventsMutex.Lock()
+	defer arena.saveFieldEventsMutex.Unlock()
+
+	arena.unsavedFieldEventsMutex.Lock()
+	unsavedFieldEvents := arena.unsavedFieldEvents
+	arena.unsavedFieldEvents = nil
+	arena.unsavedFieldEventsMutex.Unlock()
+
+	fieldEventsByMatchId := make(map[int][]model.FieldEvent)
+	var matchIds []int
+	for _, fieldEvent := range unsavedFieldEvents {
+		if _, ok := fieldEventsByMatchId[fieldEvent.MatchId]; !ok {
+			matchIds = append(matchIds, fieldEvent.MatchId)
+		}
+		fieldEventsByMatchId[fieldEvent.MatchId] = append(fieldEventsByMatchId[fieldEvent.MatchId], fieldEvent)
+	}
+	for _, matchId := range matchIds {
+		if err := arena.Database.AppendFieldEvents(matchId, fieldEventsByMatchId[matchId]); err != nil {
+			log.Printf("Failed to save field events: %v", err)
+		}
+	}
+}
+
+// Returns a snapshot of the parts of the alliance station's state that are watched for transitions.
+func (allianceStation *AllianceStation) eventState() stationEventState {
+	state := stationEventState{
+		eStop: allianceStation.EStop, aStop: allianceStation.AStop, bypass: allianceStation.Bypass,
+	}
+	if allianceStation.Team != nil {
+		state.teamId = allianceStation.Team.Id
+	}
+	if dsConn := allianceStation.DsConn; dsConn != nil {
+		state.dsLinked = dsConn.DsLinked
+		state.radioLinked = dsConn.RadioLinked
+		state.rioLinked = dsConn.RioLinked
+		state.robotLinked = dsConn.RobotLinked
+		state.wrongStation = dsConn.WrongStation
+	}
+	return state
+}
+
+// Returns a description of which of the given inputs is asserting a stop.
+func stopSource(plcStop, remoteStop bool) string {
+	if plcStop && remoteStop {
+		return "PLC and station RPi"
+	} else if remoteStop {
+		return "station RPi"
+	}
+	return "PLC"
+}
+
+// Returns the description of a stop being pressed, including where it came from if known.
+func stopDescription(name, source string) string {
+	if source == "" {
+		return name + " pressed"
+	}
+	return fmt.Sprintf("%s pressed (%s)", name, source)
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package field
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldEventLog(t *testing.T) {
+	arena := setupTestArena(t)
+	for _, teamId := range []int{101, 102, 103, 104, 105, 106} {
+		assert.Nil(t, arena.Database.CreateTeam(&model.Team{Id: teamId}))
+	}
+	match := model.Match{
+		Type: model.Qualification, ShortName: "Q1", Red1: 101, Red2: 102, Red3: 103, Blue1: 104, Blue2: 105, Blue3: 106,
+	}
+	assert.Nil(t, arena.Database.CreateMatch(&match))
+	assert.Nil(t, arena.LoadMatch(&match))
+	arena.Update()
+	assert.Empty(t, arena.FieldEvents)
+
+	// Check that link, stop and bypass transitions are recorded against the team in the station.
+	dsConn := &DriverStationConnection{TeamId: 101, AllianceStation: "R1", DsLinked: true}
+	arena.AllianceStations["R1"].DsConn = dsConn
+	arena.Update()
+	dsConn.RobotLinked = true
+	arena.AllianceStations["B2"].Bypass = true
+	arena.applyStationStops("B3", false, false)
+	arena.AllianceStations["B3"].RemoteEStop = true
+	arena.EventSettings.UseStationRpiStops = true
+	arena.AllianceStations["B3"].RemoteLastUpdate = time.Now()
+	arena.Update()
+	dsConn.RobotLinked = false
+	dsConn.WrongStation = "R2"
+	arena.Update()
+
+	if assert.Equal(t, 6, len(arena.FieldEvents)) {
+		assertFieldEvent(t, arena.FieldEvents[0], FieldEventDsLink, "R1", 101, "DS linked")
+		assertFieldEvent(t, arena.FieldEvents[1], FieldEventRobotLink, "R1", 101, "Robot linked")
+		assertFieldEvent(t, arena.FieldEvents[2], FieldEventBypass, "B2", 105, "Bypassed")
+		assertFieldEvent(t, arena.FieldEvents[3], FieldEventEStop, "B3", 106, "E-stop pressed (station RPi)")
+		assertFieldEvent(t, arena.FieldEvents[4], FieldEventRobotLink, "R1", 101, "Robot link lost")
+		assertFieldEvent(
+			t, arena.FieldEvents[5], FieldEventWrongStation, "R1", 101, "Driver station plugged into wrong station R2",
+		)
+	}
+
+	// Check that match state changes are recorded and that all the events are saved for the match.
+	arena.AllianceStations["B3"].RemoteEStop = false
+	for _, allianceStation := range arena.AllianceStations {
+		allianceStation.Bypass = true
+	}
+	arena.Update()
+	assertFieldEvent(t, arena.FieldEvents[len(arena.FieldEvents)-2], FieldEventEStop, "B3", 106, "E-stop cleared")
+	assertFieldEvent(t, arena.FieldEvents[len(arena.FieldEvents)-1], FieldEventBypass, "B3", 106, "Bypassed")
+	assert.Nil(t, arena.StartMatch())
+	arena.Update()
+	lastEvent := arena.FieldEvents[len(arena.FieldEvents)-1]
+	assertFieldEvent(t, lastEvent, FieldEventMatchState, "", 0, matchStateNames[arena.MatchState])
+
+	// Check that the events are saved outside the arena loop.
+	fieldEvents, err := arena.Database.GetFieldEventsByMatchId(match.Id)
+	assert.Nil(t, err)
+	assert.Empty(t, fieldEvents)
+	arena.saveFieldEvents()
+	fieldEvents, err = arena.Database.GetFieldEventsByMatchId(match.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, len(arena.FieldEvents), len(fieldEvents))
+
+	// Check that reloading the match picks up the events recorded for it so far, and that a new team in a station
+	// doesn't by itself produce an event.
+	arena.AbortMatch()
+	arena.Update()
+	arena.ResetMatch()
+	arena.Update()
+	numEvents := len(arena.FieldEvents)
+	match.Red1 = 0
+	assert.Nil(t, arena.LoadMatch(&match))
+	assert.Equal(t, numEvents, len(arena.FieldEvents))
+	arena.Update()
+	assert.Equal(t, numEvents, len(arena.FieldEvents))
+
+	// Check that events for a test match are kept in memory but not saved.
+	assert.Nil(t, arena.LoadTestMatch())
+	assert.Empty(t, arena.FieldEvents)
+	arena.AllianceStations["R1"].Bypass = true
+	arena.Update()
+	assert.Equal(t, 1, len(arena.FieldEvents))
+	fieldEvents, _ = arena.Database.GetFieldEventsByMatchId(0)
+	assert.Empty(t, fieldEvents)
+}
+
+func assertFieldEvent(
+	t *testing.T, fieldEvent model.FieldEvent, eventType, station string, teamId int, description string,
+) {
+	assert.Equal(t, eventType, fieldEvent.Type)
+	assert.Equal(t, station, fieldEvent.Station)
+	assert.Equal(t, teamId, fieldEvent.TeamId)
+	assert.Equal(t, description, fieldEvent.Description)
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Coordination between the arenas of several fields sharing the same event.
+
+package field
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// Represents the set of fields sharing an event, and tracks which match each of them has loaded so that the same match
+// is never played on two fields at once.
+type fieldGroup struct {
+	arenas []*Arena
+
+	// Guards loadedMatchIds, since each field's arena loads matches from its own goroutine.
+	mutex          sync.Mutex
+	loadedMatchIds map[int]int
+}
+
+func newFieldGroup(arenas ...*Arena) *fieldGroup {
+	group := &fieldGroup{arenas: arenas, loadedMatchIds: make(map[int]int)}
+	for _, arena := range arenas {
+		arena.fieldGroup = group
+	}
+	return group
+}
+
+// Records the given match as being loaded on the given field, releasing the field's previous match. Returns zero if the
+// match was claimed, or else the number of the other field that already holds it. Test matches aren't tracked since
+// they don't belong to the schedule.
+func (group *fieldGroup) claimMatch(fieldNumber int, match *model.Match) int {
+	group.mutex.Lock()
+	defer group.mutex.Unlock()
+
+	if match.Type == model.Test {
+		delete(group.loadedMatchIds, fieldNumber)
+		return 0
+	}
+	for otherFieldNumber, matchId := range group.loadedMatchIds {
+		if otherFieldNumber != fieldNumber && matchId == match.Id {
+			return otherFieldNumber
+		}
+	}
+	group.loadedMatchIds[fieldNumber] = match.Id
+	return 0
+}
+
+// Returns true if the given match is currently loaded on a field other than the given one.
+func (group *fieldGroup) isMatchLoadedOnOtherField(fieldNumber, matchId int) bool {
+	group.mutex.Lock()
+	defer group.mutex.Unlock()
+
+	for otherFieldNumber, loadedMatchId := range group.loadedMatchIds {
+		if otherFieldNumber != fieldNumber && loadedMatchId == matchId {
+			return true
+		}
+	}
+	return false
+}
+
+// Loads the test match on every field that has one of the given matches loaded, releasing those fields' claims so that
+// the matches can be deleted. Returns an error without unloading anything if any of the matches is in progress or has
+// results pending.
+func (arena *Arena) UnloadMatchesFromAllFields(matchIds []int) error {
+	var fieldArenas []*Arena
+	for _, fieldArena := range arena.fieldGroup.arenas {
+		if fieldArena.CurrentMatch.Type == model.Test || !slices.Contains(matchIds, fieldArena.CurrentMatch.Id) {
+			continue
+		}
+		if fieldArena.MatchState != PreMatch && fieldArena.MatchState != TimeoutActive {
+			return fmt.Errorf(
+				"match %s is in progress on Field %d", fieldArena.CurrentMatch.ShortName, fieldArena.FieldNumber,
+			)
+		}
+		fieldArenas = append(fieldArenas, fieldArena)
+	}
+	for _, fieldArena := range fieldArenas {
+		if err := fieldArena.LoadTestMatch(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Gating of qualification match start on the teams having passed robot inspection.
+
+package field
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// Reloads from the database which teams have passed every item on the configured inspection checklist.
+func (arena *Arena) LoadInspections() error {
+	inspections, err := arena.Database.GetAllTeamInspections()
+	if err != nil {
+		return err
+	}
+	checklistItems := arena.EventSettings.InspectionChecklistItems()
+	arena.inspectionPassedTeams = make(map[int]bool)
+	for _, inspection := range inspections {
+		if inspection.IsPassed(checklistItems) {
+			arena.inspectionPassedTeams[inspection.Id] = true
+		}
+	}
+	return nil
+}
+
+// Returns true if the given team has passed inspection, or if passing inspection is not required at this event. An
+// empty checklist has nothing to pass, so every team is considered to have passed it.
+func (arena *Arena) IsTeamInspectionPassed(teamId int) bool {
+	return !arena.EventSettings.InspectionRequired || len(arena.EventSettings.InspectionChecklistItems()) == 0 ||
+		arena.inspectionPassedTeams[teamId]
+}
+
+// Returns the teams in the current match that are keeping it from starting because they have not passed inspection,
+// in station order. Only qualification matches are gated, and teams in bypassed stations are not playing so are
+// skipped.
+func (arena *Arena) UninspectedTeamIds() []int {
+	var teamIds []int
+	if arena.CurrentMatch.Type != model.Qualification {
+		return teamIds
+	}
+	for _, station := range arena.AllianceStationNames() {
+		allianceStation := arena.AllianceStations[station]
+		if allianceStation.Team == nil || allianceStation.Bypass {
+			continue
+		}
+		if !arena.IsTeamInspectionPassed(allianceStation.Team.Id) {
+			teamIds = append(teamIds, allianceStation.Team.Id)
+		}
+	}
+	return teamIds
+}
+
+// Allows the current match to start even though some of its teams have not passed inspection. The override applies
+// only to the loaded match and is cleared when the next one is loaded.
+func (arena *Arena) OverrideInspection() error {
+	if arena.MatchState != PreMatch {
+		return fmt.Errorf("cannot override inspection while a match is in progress")
+	}
+	teamIds := arena.UninspectedTeamIds()
+	if len(teamIds) == 0 {
+		return fmt.Errorf("all teams in the current match have passed inspection")
+	}
+	if arena.InspectionOverride {
+		return nil
+	}
+	arena.InspectionOverride = true
+	teamNames := make([]string, len(teamIds))
+	for i, teamId := range teamIds {
+		teamNames[i] = fmt.Sprint(teamId)
+	}
+	arena.addFieldEvent(
+		FieldEventInspection, "", 0, "Inspection overridden by FTA for teams "+strings.Join(teamNames, ", "),
+	)
+	arena.ArenaStatusNotifier.Notify()
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package field
+
+import (
+	"testing"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArenaInspectionGating(t *testing.T) {
+	arena := setupTestArena(t)
+	arena.EventSettings.InspectionRequired = true
+	arena.EventSettings.InspectionChecklist = "Weight\nBumpers"
+	assert.Nil(t, arena.Database.CreateTeamInspection(&model.TeamInspection{Id: 254, PassedItems: []string{"Weight"}}))
+	assert.Nil(t, arena.Database.CreateTeamInspection(&model.TeamInspection{Id: 1114, PassedItems: []string{"Weight"}}))
+	assert.Nil(t, arena.LoadInspections())
+
+	match := model.Match{Type: model.Qualification, ShortName: "Q1", Red1: 254, Blue1: 1114}
+	assert.Nil(t, arena.Database.CreateMatch(&match))
+	assert.Nil(t, arena.LoadMatch(&match))
+	for _, allianceStation := range arena.AllianceStations {
+		allianceStation.Bypass = true
+	}
+	arena.AllianceStations["R1"].Bypass = false
+	arena.AllianceStations["R1"].DsConn = &DriverStationConnection{TeamId: 254, RobotLinked: true}
+	arena.AllianceStations["B1"].Bypass = false
+	arena.AllianceStations["B1"].DsConn = &DriverStationConnection{TeamId: 1114, RobotLinked: true}
+
+	// Check that teams that haven't passed every checklist item keep the match from starting.
+	assert.Equal(t, []int{254, 1114}, arena.UninspectedTeamIds())
+	assert.EqualError(
+		t, arena.checkCanStartMatch(), "cannot start match until team 254 has passed inspection or the FTA overrides",
+	)
+
+	// Check that the gating is updated once the teams pass inspection or are bypassed.
+	assert.Nil(t, arena.Database.UpdateTeamInspection(
+		&model.TeamInspection{Id: 254, PassedItems: []string{"Bumpers", "Weight"}},
+	))
+	assert.Nil(t, arena.LoadInspections())
+	assert.Equal(t, []int{1114}, arena.UninspectedTeamIds())
+	arena.AllianceStations["B1"].Bypass = true
+	assert.Empty(t, arena.UninspectedTeamIds())
+	assert.Nil(t, arena.checkCanStartMatch())
+	arena.AllianceStations["B1"].Bypass = false
+
+	// Check that the FTA can override the gating for the current match only.
+	assert.Nil(t, arena.OverrideInspection())
+	assert.True(t, arena.InspectionOverride)
+	assert.Equal(t, []int{1114}, arena.UninspectedTeamIds())
+	assert.Nil(t, arena.checkCanStartMatch())
+	if assert.Equal(t, 1, len(arena.FieldEvents)) {
+		assert.Equal(t, FieldEventInspection, arena.FieldEvents[0].Type)
+		assert.Equal(t, "Inspection overridden by FTA for teams 1114", arena.FieldEvents[0].Description)
+	}
+	assert.Nil(t, arena.LoadMatch(&match))
+	assert.False(t, arena.InspectionOverride)
+
+	// Check that non-qualification matches aren't gated.
+	practiceMatch := model.Match{Type: model.Practice, ShortName: "P1", Red1: 254, Blue1: 1114}
+	assert.Nil(t, arena.LoadMatch(&practiceMatch))
+	assert.Empty(t, arena.UninspectedTeamIds())
+	assert.EqualError(t, arena.OverrideInspection(), "all teams in the current match have passed inspection")
+
+	// Check that nothing is gated when the checklist is empty or inspection isn't required.
+	arena.EventSettings.InspectionChecklist = "\n "
+	assert.Nil(t, arena.LoadMatch(&match))
+	assert.True(t, arena.IsTeamInspectionPassed(1114))
+	assert.True(t, arena.IsTeamInspectionPassed(9999))
+	assert.Empty(t, arena.UninspectedTeamIds())
+	arena.EventSettings.InspectionChecklist = "Weight\nBumpers"
+	arena.EventSettings.InspectionRequired = false
+	assert.Nil(t, arena.LoadMatch(&match))
+	assert.True(t, arena.IsTeamInspectionPassed(1114))
+	assert.Empty(t, arena.UninspectedTeamIds())
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Functions for running practice matches from a first come, first served queue instead of a fixed schedule.
+
+package field
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// PracticeQueueTeam represents a team waiting in the open practice queue.
+type PracticeQueueTeam struct {
+	TeamId        int
+	JoinedAt      time.Time
+	PracticeCount int
+}
+
+// GetPracticeQueue returns the teams in the open practice queue in the order in which they joined it, along with the
+// number of practice matches each has been scheduled into so far.
+func (arena *Arena) GetPracticeQueue() ([]PracticeQueueTeam, error) {
+	entries, err := arena.Database.GetAllPracticeQueueEntries()
+	if err != nil {
+		return nil, err
+	}
+	practiceCounts, err := arena.getPracticeCounts()
+	if err != nil {
+		return nil, err
+	}
+	queue := make([]PracticeQueueTeam, len(entries))
+	for i, entry := range entries {
+		queue[i] = PracticeQueueTeam{entry.TeamId, entry.JoinedAt, practiceCounts[entry.TeamId]}
+	}
+	return queue, nil
+}
+
+// JoinPracticeQueue adds the given team to the back of the open practice queue.
+func (arena *Arena) JoinPracticeQueue(teamId int) error {
+	if !arena.EventSettings.PracticeOpenQueueEnabled {
+		return fmt.Errorf("The open practice queue is not enabled.")
+	}
+	if teamId == 0 {
+		return fmt.Errorf("Must specify a team number to join the practice queue.")
+	}
+	if err := arena.validateTeams(teamId); err != nil {
+		return err
+	}
+	entry, err := arena.Database.GetPracticeQueueEntryByTeamId(teamId)
+	if err != nil {
+		return err
+	}
+	if entry != nil {
+		return fmt.Errorf("Team %d is already in the practice queue.", teamId)
+	}
+	if err = arena.Database.CreatePracticeQueueEntry(
+		&model.PracticeQueueEntry{TeamId: teamId, JoinedAt: time.Now()},
+	); err != nil {
+		return err
+	}
+	arena.PracticeQueueNotifier.Notify()
+	return nil
+}
+
+// LeavePracticeQueue removes the given team from the open practice queue.
+func (arena *Arena) LeavePracticeQueue(teamId int) error {
+	entry, err := arena.Database.GetPracticeQueueEntryByTeamId(teamId)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return fmt.Errorf("Team %d is not in the practice queue.", teamId)
+	}
+	if err = arena.Database.DeletePracticeQueueEntry(entry.Id); err != nil {
+		return err
+	}
+	arena.PracticeQueueNotifier.Notify()
+	return nil
+}
+
+// ClearPracticeQueue removes all teams from the open practice queue.
+func (arena *Arena) ClearPracticeQueue() error {
+	if err := arena.Database.TruncatePracticeQueueEntries(); err != nil {
+		return err
+	}
+	arena.PracticeQueueNotifier.Notify()
+	return nil
+}
+
+// LoadNextQueuedPracticeMatch creates a new practice match from the teams at the front of the open practice queue and
+// loads it. For fairness, teams that have been scheduled into fewer practice matches are taken ahead of those with more,
+// and otherwise teams are taken in the order in which they joined the queue.
+func (arena *Arena) LoadNextQueuedPracticeMatch() error {
+	if !arena.EventSettings.PracticeOpenQueueEnabled {
+		return fmt.Errorf("The open practice queue is not enabled.")
+	}
+	if arena.MatchState != PreMatch && arena.MatchState != TimeoutActive {
+		return fmt.Errorf("Cannot load match while there is a match still in progress or with results pending")
+	}
+	entries, err := arena.Database.GetAllPracticeQueueEntries()
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf("There are no teams in the practice queue.")
+	}
+	practiceCounts, err := arena.getPracticeCounts()
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(
+		entries,
+		func(i, j int) bool {
+			return practiceCounts[entries[i].TeamId] < practiceCounts[entries[j].TeamId]
+		},
+	)
+	stations := arena.AllianceStationNames()
+	entries = entries[:min(len(entries), len(stations))]
+
+	practiceMatches, err := arena.Database.GetMatchesByType(model.Practice, true)
+	if err != nil {
+		return err
+	}
+	typeOrder := 1
+	if len(practiceMatches) > 0 {
+		typeOrder = practiceMatches[len(practiceMatches)-1].TypeOrder + 1
+	}
+	match := model.Match{
+		Type:        model.Practice,
+		TypeOrder:   typeOrder,
+		Time:        time.Now(),
+		LongName:    fmt.Sprintf("Practice %d", typeOrder),
+		ShortName:   fmt.Sprintf("P%d", typeOrder),
+		TbaMatchKey: model.TbaMatchKey{CompLevel: "p", MatchNumber: typeOrder},
+	}
+	for i, entry := range entries {
+		match.SetTeamForStation(stations[i], entry.TeamId, false)
+	}
+	if err = arena.Database.CreateMatch(&match); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err = arena.Database.DeletePracticeQueueEntry(entry.Id); err != nil {
+			return err
+		}
+	}
+	arena.PracticeQueueNotifier.Notify()
+	return arena.LoadMatch(&match)
+}
+
+// Returns true if the next practice match should be filled from the open practice queue, which is the case once the
+// queue is enabled and every practice match that isn't already loaded on another field has been played.
+func (arena *Arena) practiceQueueActive() (bool, error) {
+	if !arena.EventSettings.PracticeOpenQueueEnabled {
+		return false, nil
+	}
+	matches, err := arena.Database.GetMatchesByType(model.Practice, false)
+	if err != nil {
+		return false, err
+	}
+	for _, match := range matches {
+		if !match.IsComplete() && !arena.fieldGroup.isMatchLoadedOnOtherField(arena.FieldNumber, match.Id) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Returns the number of practice matches that each team has been scheduled into.
+func (arena *Arena) getPracticeCounts() (map[int]int, error) {
+	matches, err := arena.Database.GetMatchesByType(model.Practice, false)
+	if err != nil {
+		return nil, err
+	}
+	practiceCounts := make(map[int]int)
+	for _, match := range matches {
+		for _, teamId := range match.TeamIds() {
+			if teamId > 0 {
+				practiceCounts[teamId]++
+			}
+		}
+	}
+	return practiceCounts, nil
+}
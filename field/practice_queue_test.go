@@ -0,0 +1,97 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package field
+
+import (
+	"testing"
+
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPracticeQueue(t *testing.T) {
+	arena := setupTestArena(t)
+	for i := 101; i <= 108; i++ {
+		assert.Nil(t, arena.Database.CreateTeam(&model.Team{Id: i}))
+	}
+
+	err := arena.JoinPracticeQueue(101)
+	assert.EqualError(t, err, "The open practice queue is not enabled.")
+	assert.EqualError(t, arena.LoadNextQueuedPracticeMatch(), "The open practice queue is not enabled.")
+	arena.EventSettings.PracticeOpenQueueEnabled = true
+
+	for _, teamId := range []int{101, 102, 103, 104, 105, 106, 107} {
+		assert.Nil(t, arena.JoinPracticeQueue(teamId))
+	}
+	assert.EqualError(t, arena.JoinPracticeQueue(101), "Team 101 is already in the practice queue.")
+	assert.EqualError(t, arena.JoinPracticeQueue(0), "Must specify a team number to join the practice queue.")
+	assert.EqualError(t, arena.JoinPracticeQueue(254), "Team 254 is not present at the event.")
+	assert.EqualError(t, arena.LeavePracticeQueue(108), "Team 108 is not in the practice queue.")
+	assert.Nil(t, arena.LeavePracticeQueue(103))
+	assert.Nil(t, arena.JoinPracticeQueue(108))
+
+	// Check that the next match is filled from the front of the queue.
+	assert.Nil(t, arena.LoadNextQueuedPracticeMatch())
+	assert.Equal(t, model.Practice, arena.CurrentMatch.Type)
+	assert.Equal(t, "P1", arena.CurrentMatch.ShortName)
+	assert.Equal(t, 101, arena.CurrentMatch.Red1)
+	assert.Equal(t, 102, arena.CurrentMatch.Red2)
+	assert.Equal(t, 104, arena.CurrentMatch.Red3)
+	assert.Equal(t, 105, arena.CurrentMatch.Blue1)
+	assert.Equal(t, 106, arena.CurrentMatch.Blue2)
+	assert.Equal(t, 107, arena.CurrentMatch.Blue3)
+	queue, err := arena.GetPracticeQueue()
+	assert.Nil(t, err)
+	if assert.Equal(t, 1, len(queue)) {
+		assert.Equal(t, 108, queue[0].TeamId)
+		assert.Equal(t, 0, queue[0].PracticeCount)
+	}
+
+	// Check that teams with fewer practice matches are taken ahead of those who joined earlier.
+	for _, teamId := range []int{101, 102, 103} {
+		assert.Nil(t, arena.JoinPracticeQueue(teamId))
+	}
+	queue, _ = arena.GetPracticeQueue()
+	if assert.Equal(t, 4, len(queue)) {
+		assert.Equal(t, 1, queue[1].PracticeCount)
+	}
+	arena.CurrentMatch.Status = game.RedWonMatch
+	assert.Nil(t, arena.Database.UpdateMatch(arena.CurrentMatch))
+	assert.Nil(t, arena.LoadNextMatch(false))
+	assert.Equal(t, "P2", arena.CurrentMatch.ShortName)
+	assert.Equal(t, 108, arena.CurrentMatch.Red1)
+	assert.Equal(t, 103, arena.CurrentMatch.Red2)
+	assert.Equal(t, 101, arena.CurrentMatch.Red3)
+	assert.Equal(t, 102, arena.CurrentMatch.Blue1)
+	assert.Equal(t, 0, arena.CurrentMatch.Blue2)
+
+	queue, _ = arena.GetPracticeQueue()
+	assert.Empty(t, queue)
+	assert.EqualError(t, arena.LoadNextQueuedPracticeMatch(), "There are no teams in the practice queue.")
+
+	// Check that the test match is loaded instead once the queue is turned off.
+	arena.CurrentMatch.Status = game.BlueWonMatch
+	assert.Nil(t, arena.Database.UpdateMatch(arena.CurrentMatch))
+	assert.Nil(t, arena.JoinPracticeQueue(101))
+	arena.EventSettings.PracticeOpenQueueEnabled = false
+	assert.Nil(t, arena.LoadNextMatch(false))
+	assert.Equal(t, model.Test, arena.CurrentMatch.Type)
+
+	// Check that the queue is used again from the test match once it is re-enabled, but only after every scheduled
+	// practice match has been played.
+	arena.EventSettings.PracticeOpenQueueEnabled = true
+	scheduledMatch := model.Match{Type: model.Practice, TypeOrder: 3, ShortName: "P3"}
+	assert.Nil(t, arena.Database.CreateMatch(&scheduledMatch))
+	assert.Nil(t, arena.LoadNextMatch(false))
+	assert.Equal(t, model.Test, arena.CurrentMatch.Type)
+	scheduledMatch.Status = game.TieMatch
+	assert.Nil(t, arena.Database.UpdateMatch(&scheduledMatch))
+	assert.Nil(t, arena.LoadNextMatch(false))
+	assert.Equal(t, model.Practice, arena.CurrentMatch.Type)
+	assert.Equal(t, 101, arena.CurrentMatch.Red1)
+
+	assert.Nil(t, arena.ClearPracticeQueue())
+	queue, _ = arena.GetPracticeQueue()
+	assert.Empty(t, queue)
+}
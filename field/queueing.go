@@ -0,0 +1,181 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Functions for calling teams to queue ahead of their matches and tracking their progress to the field.
+
+package field
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// QueueTeam represents the queueing progress of a single team for an upcoming match.
+type QueueTeam struct {
+	TeamId     int
+	Station    string
+	Status     model.QueueStatus
+	StatusName string
+	IsLate     bool
+}
+
+// QueueMatch represents an upcoming match within the queue call lead, along with the queueing progress of its teams.
+type QueueMatch struct {
+	MatchId     int
+	ShortName   string
+	Time        time.Time
+	MatchesAway int
+	Teams       []QueueTeam
+}
+
+// GetQueueMatches returns the unplayed matches of the current type, from the loaded one up to the last one whose teams
+// should have been called to queue. A team is flagged as late if it hasn't yet arrived at queueing once its match is
+// within the configured late lead. Returns nothing if automatic queue calls are disabled or a test match is loaded.
+func (arena *Arena) GetQueueMatches() ([]QueueMatch, error) {
+	if arena.CurrentMatch.Type == model.Test || arena.EventSettings.QueueCallLeadMatches <= 0 {
+		return nil, nil
+	}
+	matches, err := arena.Database.GetMatchesByType(arena.CurrentMatch.Type, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var queueMatches []QueueMatch
+	for _, match := range matches {
+		if match.IsComplete() || match.TypeOrder < arena.CurrentMatch.TypeOrder {
+			continue
+		}
+		matchesAway := len(queueMatches)
+		if matchesAway > arena.EventSettings.QueueCallLeadMatches {
+			break
+		}
+		queueStatuses, err := arena.Database.GetTeamQueueStatusesByMatchId(match.Id)
+		if err != nil {
+			return nil, err
+		}
+		statusesByTeam := make(map[int]model.QueueStatus)
+		for _, queueStatus := range queueStatuses {
+			statusesByTeam[queueStatus.TeamId] = queueStatus.Status
+		}
+
+		queueMatch := QueueMatch{
+			MatchId: match.Id, ShortName: match.ShortName, Time: match.Time, MatchesAway: matchesAway,
+		}
+		for _, position := range matchTeamPositions(&match) {
+			if position.teamId == 0 {
+				continue
+			}
+			status := statusesByTeam[position.teamId]
+			queueMatch.Teams = append(
+				queueMatch.Teams,
+				QueueTeam{
+					TeamId:     position.teamId,
+					Station:    position.station,
+					Status:     status,
+					StatusName: status.String(),
+					IsLate:     status < model.QueueArrived && matchesAway <= arena.EventSettings.QueueLateLeadMatches,
+				},
+			)
+		}
+		queueMatches = append(queueMatches, queueMatch)
+	}
+	return queueMatches, nil
+}
+
+// SetTeamQueueStatus records that the given team has progressed to the given queue status for the given match.
+func (arena *Arena) SetTeamQueueStatus(matchId, teamId int, status model.QueueStatus) error {
+	if status < model.QueueNotCalled || status > model.QueueAtField {
+		return fmt.Errorf("Invalid queue status: %d", status)
+	}
+	match, err := arena.Database.GetMatchById(matchId)
+	if err != nil {
+		return err
+	}
+	if match == nil {
+		return fmt.Errorf("Match %d does not exist.", matchId)
+	}
+	isInMatch := false
+	for _, position := range matchTeamPositions(match) {
+		if teamId != 0 && position.teamId == teamId {
+			isInMatch = true
+		}
+	}
+	if !isInMatch {
+		return fmt.Errorf("Team %d is not in match %s.", teamId, match.ShortName)
+	}
+
+	if err = arena.setTeamQueueStatus(matchId, teamId, status, time.Now()); err != nil {
+		return err
+	}
+	arena.QueueStatusNotifier.Notify()
+	return nil
+}
+
+// callQueueTeams marks every team in the upcoming matches within the queue call lead as having been called, if it
+// hasn't been already.
+func (arena *Arena) callQueueTeams() error {
+	queueMatches, err := arena.GetQueueMatches()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, queueMatch := range queueMatches {
+		for _, team := range queueMatch.Teams {
+			if team.Status == model.QueueNotCalled {
+				if err = arena.setTeamQueueStatus(queueMatch.MatchId, team.TeamId, model.QueueCalled, now); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	arena.QueueStatusNotifier.Notify()
+	return nil
+}
+
+// markQueueTeamsAtField records that all of the teams in the current match have made it to the field.
+func (arena *Arena) markQueueTeamsAtField() error {
+	if arena.CurrentMatch.Type == model.Test || arena.EventSettings.QueueCallLeadMatches <= 0 {
+		return nil
+	}
+	now := time.Now()
+	for _, position := range matchTeamPositions(arena.CurrentMatch) {
+		if position.teamId != 0 {
+			err := arena.setTeamQueueStatus(arena.CurrentMatch.Id, position.teamId, model.QueueAtField, now)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	arena.QueueStatusNotifier.Notify()
+	return nil
+}
+
+// setTeamQueueStatus creates or updates the queue status record for the given team and match.
+func (arena *Arena) setTeamQueueStatus(matchId, teamId int, status model.QueueStatus, now time.Time) error {
+	queueStatus, err := arena.Database.GetTeamQueueStatus(matchId, teamId)
+	if err != nil {
+		return err
+	}
+	if queueStatus == nil {
+		queueStatus = &model.TeamQueueStatus{MatchId: matchId, TeamId: teamId}
+		queueStatus.SetStatus(status, now)
+		return arena.Database.CreateTeamQueueStatus(queueStatus)
+	}
+	queueStatus.SetStatus(status, now)
+	return arena.Database.UpdateTeamQueueStatus(queueStatus)
+}
+
+type matchTeamPosition struct {
+	teamId  int
+	station string
+}
+
+// matchTeamPositions returns the team assigned to each station in the given match, in station order.
+func matchTeamPositions(match *model.Match) []matchTeamPosition {
+	var positions []matchTeamPosition
+	for _, station := range model.AllianceStationNames(model.MaxTeamsPerAlliance) {
+		positions = append(positions, matchTeamPosition{match.TeamIdForStation(station), station})
+	}
+	return positions
+}
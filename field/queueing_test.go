@@ -0,0 +1,105 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package field
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueCalls(t *testing.T) {
+	arena := setupTestArena(t)
+	arena.EventSettings.QueueCallLeadMatches = 4
+	for i := 101; i <= 112; i++ {
+		assert.Nil(t, arena.Database.CreateTeam(&model.Team{Id: i}))
+	}
+	for i := 1; i <= 7; i++ {
+		offset := 100 + (i-1)%2*6
+		match := model.Match{
+			Type:      model.Qualification,
+			TypeOrder: i,
+			ShortName: "Q" + strconv.Itoa(i),
+			Red1:      offset + 1,
+			Red2:      offset + 2,
+			Red3:      offset + 3,
+			Blue1:     offset + 4,
+			Blue2:     offset + 5,
+			Blue3:     offset + 6,
+		}
+		assert.Nil(t, arena.Database.CreateMatch(&match))
+	}
+
+	// Check that nothing is called while a test match is loaded.
+	queueMatches, err := arena.GetQueueMatches()
+	assert.Nil(t, err)
+	assert.Empty(t, queueMatches)
+
+	// Check that loading a match calls the teams for it and the next few matches.
+	match, _ := arena.Database.GetMatchById(1)
+	assert.Nil(t, arena.LoadMatch(match))
+	queueMatches, err = arena.GetQueueMatches()
+	assert.Nil(t, err)
+	if assert.Equal(t, 5, len(queueMatches)) {
+		assert.Equal(t, "Q1", queueMatches[0].ShortName)
+		assert.Equal(t, "Q5", queueMatches[4].ShortName)
+		assert.Equal(t, 4, queueMatches[4].MatchesAway)
+		if assert.Equal(t, 6, len(queueMatches[0].Teams)) {
+			assert.Equal(t, QueueTeam{101, "R1", model.QueueCalled, "Called", true}, queueMatches[0].Teams[0])
+		}
+		assert.True(t, queueMatches[1].Teams[0].IsLate)
+		assert.Equal(t, QueueTeam{101, "R1", model.QueueCalled, "Called", false}, queueMatches[2].Teams[0])
+	}
+	queueStatuses, _ := arena.Database.GetTeamQueueStatusesByMatchId(6)
+	assert.Empty(t, queueStatuses)
+
+	// Check that a team is no longer late once it arrives.
+	assert.Nil(t, arena.SetTeamQueueStatus(1, 102, model.QueueArrived))
+	queueMatches, _ = arena.GetQueueMatches()
+	assert.Equal(t, model.QueueArrived, queueMatches[0].Teams[1].Status)
+	assert.False(t, queueMatches[0].Teams[1].IsLate)
+	assert.True(t, queueMatches[0].Teams[2].IsLate)
+	queueStatus, _ := arena.Database.GetTeamQueueStatus(1, 102)
+	assert.False(t, queueStatus.CalledAt.IsZero())
+	assert.False(t, queueStatus.ArrivedAt.IsZero())
+
+	assert.EqualError(t, arena.SetTeamQueueStatus(1, 107, model.QueueArrived), "Team 107 is not in match Q1.")
+	assert.EqualError(t, arena.SetTeamQueueStatus(1, 0, model.QueueArrived), "Team 0 is not in match Q1.")
+	assert.EqualError(t, arena.SetTeamQueueStatus(99, 101, model.QueueArrived), "Match 99 does not exist.")
+	assert.EqualError(t, arena.SetTeamQueueStatus(1, 101, 7), "Invalid queue status: 7")
+
+	// Check that starting the match marks its teams as being at the field.
+	arena.AllianceStations["R1"].Bypass = true
+	arena.AllianceStations["R2"].Bypass = true
+	arena.AllianceStations["R3"].Bypass = true
+	arena.AllianceStations["B1"].Bypass = true
+	arena.AllianceStations["B2"].Bypass = true
+	arena.AllianceStations["B3"].Bypass = true
+	assert.Nil(t, arena.StartMatch())
+	queueStatuses, _ = arena.Database.GetTeamQueueStatusesByMatchId(1)
+	if assert.Equal(t, 6, len(queueStatuses)) {
+		for _, queueStatus := range queueStatuses {
+			assert.Equal(t, model.QueueAtField, queueStatus.Status)
+		}
+	}
+
+	// Check that the next match load calls the teams for the match that has come within the lead.
+	arena.MatchState = PreMatch
+	match.Status = game.RedWonMatch
+	assert.Nil(t, arena.Database.UpdateMatch(match))
+	assert.Nil(t, arena.LoadNextMatch(false))
+	queueMatches, _ = arena.GetQueueMatches()
+	if assert.Equal(t, 5, len(queueMatches)) {
+		assert.Equal(t, "Q2", queueMatches[0].ShortName)
+		assert.Equal(t, model.QueueCalled, queueMatches[4].Teams[0].Status)
+	}
+
+	// Check that automatic calls can be disabled.
+	arena.EventSettings.QueueCallLeadMatches = 0
+	queueMatches, err = arena.GetQueueMatches()
+	assert.Nil(t, err)
+	assert.Empty(t, queueMatches)
+}
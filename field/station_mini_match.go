@@ -0,0 +1,143 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Independent timed enabling of a single alliance station during a test match, for robot inspections and practice
+// field checkouts.
+
+package field
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// Longest that a single station may be enabled for in one go.
+const MaxStationMiniMatchDurationSec = 300
+
+// A timed enable of a single alliance station in either autonomous or teleoperated mode.
+type StationMiniMatch struct {
+	Auto         bool
+	DurationSec  int
+	RemainingSec int
+	startTime    time.Time
+}
+
+// Returns the fractional number of seconds since the start of the mini-match.
+func (miniMatch *StationMiniMatch) elapsedSec() float64 {
+	return time.Since(miniMatch.startTime).Seconds()
+}
+
+// Enables the robot in the given station on its own for the given duration, leaving the other stations disabled.
+func (arena *Arena) StartStationMiniMatch(station string, auto bool, durationSec int) error {
+	if arena.CurrentMatch.Type != model.Test {
+		return fmt.Errorf("station mini-matches can only be run when the test match is loaded")
+	}
+	if arena.MatchState != PreMatch {
+		return fmt.Errorf("cannot start a station mini-match while a match is in progress")
+	}
+	if !arena.isStationInUse(station) {
+		return fmt.Errorf("invalid alliance station '%s'", station)
+	}
+	allianceStation := arena.AllianceStations[station]
+	if allianceStation.MiniMatch != nil {
+		return fmt.Errorf("a mini-match is already running in station %s", station)
+	}
+	if allianceStation.DsConn == nil {
+		return fmt.Errorf("no driver station is connected in station %s", station)
+	}
+	if durationSec <= 0 || durationSec > MaxStationMiniMatchDurationSec {
+		return fmt.Errorf("duration must be between 1 and %d seconds", MaxStationMiniMatchDurationSec)
+	}
+
+	mode := "Teleop"
+	if auto {
+		mode = "Auto"
+	}
+	logMatch := model.Match{Type: model.Test, ShortName: fmt.Sprintf("%s-%s", station, mode)}
+	if err := allianceStation.DsConn.signalMatchStart(&logMatch, &allianceStation.WifiStatus); err != nil {
+		log.Printf("Failed to start log for station %s mini-match: %v", station, err)
+	}
+	allianceStation.MiniMatch = &StationMiniMatch{
+		Auto: auto, DurationSec: durationSec, RemainingSec: durationSec, startTime: time.Now(),
+	}
+	arena.addFieldEvent(
+		FieldEventStationMiniMatch,
+		station,
+		allianceStation.DsConn.TeamId,
+		fmt.Sprintf("%s mini-match started for %d sec", mode, durationSec),
+	)
+
+	// Force a driver station packet on the next loop iteration to enable the robot right away.
+	arena.lastDsPacketTime = time.Time{}
+	return nil
+}
+
+// Disables the robot in the given station and ends its mini-match before its time is up.
+func (arena *Arena) StopStationMiniMatch(station string) error {
+	allianceStation, ok := arena.AllianceStations[station]
+	if !ok || allianceStation.MiniMatch == nil {
+		return fmt.Errorf("no mini-match is running in station %s", station)
+	}
+	arena.endStationMiniMatch(station, "stopped")
+	return nil
+}
+
+// Ends the mini-matches whose time is up and updates the remaining time of the others. Returns true if any has ended
+// so that a driver station packet can be sent right away.
+func (arena *Arena) updateStationMiniMatches() bool {
+	anyEnded := false
+	for _, station := range model.AllianceStationNames(model.MaxTeamsPerAlliance) {
+		miniMatch := arena.AllianceStations[station].MiniMatch
+		if miniMatch == nil {
+			continue
+		}
+		if arena.AllianceStations[station].DsConn == nil {
+			arena.endStationMiniMatch(station, "ended on driver station disconnect")
+			anyEnded = true
+			continue
+		}
+		elapsedSec := miniMatch.elapsedSec()
+		if elapsedSec >= float64(miniMatch.DurationSec) {
+			arena.endStationMiniMatch(station, "completed")
+			anyEnded = true
+			continue
+		}
+		miniMatch.RemainingSec = int(math.Ceil(float64(miniMatch.DurationSec) - elapsedSec))
+	}
+	return anyEnded
+}
+
+// Returns the mini-match running in the given station, or nil if there is none.
+func (arena *Arena) stationMiniMatch(station string) *StationMiniMatch {
+	if allianceStation, ok := arena.AllianceStations[station]; ok {
+		return allianceStation.MiniMatch
+	}
+	return nil
+}
+
+// Ends the mini-matches in all stations, such as when a match is loaded or started.
+func (arena *Arena) stopAllStationMiniMatches(reason string) {
+	for _, station := range model.AllianceStationNames(model.MaxTeamsPerAlliance) {
+		if arena.AllianceStations[station].MiniMatch != nil {
+			arena.endStationMiniMatch(station, reason)
+		}
+	}
+}
+
+func (arena *Arena) endStationMiniMatch(station, reason string) {
+	allianceStation := arena.AllianceStations[station]
+	allianceStation.MiniMatch = nil
+	teamId := 0
+	if dsConn := allianceStation.DsConn; dsConn != nil {
+		teamId = dsConn.TeamId
+		if dsConn.log != nil {
+			dsConn.log.Close()
+			dsConn.log = nil
+		}
+	}
+	arena.addFieldEvent(FieldEventStationMiniMatch, station, teamId, "Mini-match "+reason)
+	arena.lastDsPacketTime = time.Time{}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package field
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStationMiniMatch(t *testing.T) {
+	arena := setupTestArena(t)
+	red1Ds := &DriverStationConnection{TeamId: 254, AllianceStation: "R1", RobotLinked: true}
+	blue2Ds := &DriverStationConnection{TeamId: 1114, AllianceStation: "B2", RobotLinked: true}
+
+	// Check the preconditions for starting a mini-match.
+	assert.EqualError(
+		t, arena.StartStationMiniMatch("R1", true, 15), "no driver station is connected in station R1",
+	)
+	arena.AllianceStations["R1"].DsConn = red1Ds
+	arena.AllianceStations["B2"].DsConn = blue2Ds
+	assert.EqualError(t, arena.StartStationMiniMatch("R4", true, 15), "invalid alliance station 'R4'")
+	assert.EqualError(t, arena.StartStationMiniMatch("R1", true, 0), "duration must be between 1 and 300 seconds")
+	assert.EqualError(t, arena.StopStationMiniMatch("R1"), "no mini-match is running in station R1")
+
+	// Check that only the station running a mini-match is enabled, in the requested mode.
+	assert.Nil(t, arena.StartStationMiniMatch("R1", true, 15))
+	assert.EqualError(t, arena.StartStationMiniMatch("R1", false, 15), "a mini-match is already running in station R1")
+	arena.Update()
+	assert.Equal(t, PreMatch, arena.MatchState)
+	assert.Equal(t, true, red1Ds.Auto)
+	assert.Equal(t, true, red1Ds.Enabled)
+	assert.Equal(t, false, blue2Ds.Enabled)
+	assert.Equal(t, 15, arena.AllianceStations["R1"].MiniMatch.RemainingSec)
+	assert.NotNil(t, red1Ds.log)
+	assert.Nil(t, arena.StartStationMiniMatch("B2", false, 30))
+	arena.Update()
+	assert.Equal(t, true, red1Ds.Enabled)
+	assert.Equal(t, false, blue2Ds.Auto)
+	assert.Equal(t, true, blue2Ds.Enabled)
+
+	// Check that each mini-match runs on its own timer.
+	arena.AllianceStations["R1"].MiniMatch.startTime = time.Now().Add(-15 * time.Second)
+	arena.AllianceStations["B2"].MiniMatch.startTime = time.Now().Add(-20 * time.Second)
+	arena.Update()
+	assert.Nil(t, arena.AllianceStations["R1"].MiniMatch)
+	assert.Nil(t, red1Ds.log)
+	assert.Equal(t, false, red1Ds.Enabled)
+	assert.Equal(t, true, blue2Ds.Enabled)
+	assert.Equal(t, 10, arena.AllianceStations["B2"].MiniMatch.RemainingSec)
+
+	// Check that an E-stop still disables a station running a mini-match.
+	arena.AllianceStations["B2"].EStop = true
+	arena.lastDsPacketTime = time.Time{}
+	arena.Update()
+	assert.Equal(t, false, blue2Ds.Enabled)
+	arena.AllianceStations["B2"].EStop = false
+
+	// Check that stopping a mini-match disables the station right away.
+	assert.Nil(t, arena.StopStationMiniMatch("B2"))
+	arena.Update()
+	assert.Equal(t, false, blue2Ds.Enabled)
+	var descriptions []string
+	for _, fieldEvent := range arena.FieldEvents {
+		if fieldEvent.Type == FieldEventStationMiniMatch {
+			descriptions = append(descriptions, fieldEvent.Station+": "+fieldEvent.Description)
+		}
+	}
+	assert.Equal(
+		t,
+		[]string{
+			"R1: Auto mini-match started for 15 sec",
+			"B2: Teleop mini-match started for 30 sec",
+			"R1: Mini-match completed",
+			"B2: Mini-match stopped",
+		},
+		descriptions,
+	)
+
+	// Check that loading a match ends any mini-match and that they can't be run outside of the test match.
+	assert.Nil(t, arena.StartStationMiniMatch("R1", false, 15))
+	match := model.Match{Type: model.Practice, ShortName: "P1"}
+	assert.Nil(t, arena.Database.CreateMatch(&match))
+	assert.Nil(t, arena.LoadMatch(&match))
+	assert.Nil(t, arena.AllianceStations["R1"].MiniMatch)
+	arena.AllianceStations["R1"].DsConn = red1Ds
+	assert.EqualError(
+		t,
+		arena.StartStationMiniMatch("R1", false, 15),
+		"station mini-matches can only be run when the test match is loaded",
+	)
+}
@@ -0,0 +1,274 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Analysis of the driver station logs recorded for each team during each match, for spotting teams whose robots are
+// having connectivity or power problems.
+
+package field
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+)
+
+const (
+	// Battery voltage below which the robot is considered to be at risk of browning out.
+	brownoutVoltage = 7.0
+
+	// Minimum number of packets missed between two consecutive log entries to count as a burst of missed packets.
+	missedPacketBurstSize = 10
+)
+
+// TeamMatchLogAnalysis summarizes the health of a team's robot connection and power over a single match log.
+type TeamMatchLogAnalysis struct {
+	Filename               string
+	TeamId                 int
+	MatchType              string
+	MatchShortName         string
+	StartTime              time.Time
+	NumPackets             int
+	LinkDrops              int
+	DisconnectedEnabledSec float64
+	BrownoutDips           int
+	MinBatteryVoltage      float64
+	TripTimeP50Ms          int
+	TripTimeP90Ms          int
+	TripTimeP99Ms          int
+	TripTimeMaxMs          int
+	MissedPackets          int
+	MissedPacketBursts     int
+}
+
+// TeamHealth aggregates the match log analyses for a single team across the event.
+type TeamHealth struct {
+	TeamId                 int
+	NumMatches             int
+	NumProblemMatches      int
+	LinkDrops              int
+	DisconnectedEnabledSec float64
+	BrownoutDips           int
+	MinBatteryVoltage      float64
+	MaxTripTimeP90Ms       int
+	MissedPackets          int
+	MissedPacketBursts     int
+	Matches                []TeamMatchLogAnalysis
+}
+
+// Returns true if the robot had any connectivity or power problems during the match.
+func (analysis *TeamMatchLogAnalysis) HasProblems() bool {
+	return analysis.LinkDrops > 0 || analysis.DisconnectedEnabledSec > 0 || analysis.BrownoutDips > 0 ||
+		analysis.MissedPacketBursts > 0
+}
+
+// AnalyzeTeamMatchLogs analyzes all the match logs recorded so far (excluding test matches) and returns the health
+// summary for each team, ordered with the teams that have had the most problem matches first.
+func AnalyzeTeamMatchLogs() ([]TeamHealth, error) {
+	analyses, err := analyzeTeamMatchLogsInDir(filepath.Join(model.BaseDir, logsDir))
+	if err != nil {
+		return nil, err
+	}
+	return BuildTeamHealth(analyses), nil
+}
+
+// AnalyzeTeamMatchLog parses the given CSV match log and computes its connectivity and power statistics.
+func AnalyzeTeamMatchLog(reader io.Reader) (*TeamMatchLogAnalysis, error) {
+	csvReader := csv.NewReader(reader)
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[name] = i
+	}
+	for _, name := range []string{
+		"matchTimeSec", "teamId", "robotLinked", "enabled", "batteryVoltage", "missedPacketCount", "dsRobotTripTimeMs",
+	} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("match log is missing column %q", name)
+		}
+	}
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	analysis := TeamMatchLogAnalysis{NumPackets: len(records)}
+	var tripTimes []int
+	wasLinked := false
+	wasBrownedOut := false
+	var previousMatchTimeSec float64
+	var previousDisconnectedEnabled bool
+	previousMissedPacketCount := 0
+	for i, record := range records {
+		matchTimeSec, _ := strconv.ParseFloat(record[columns["matchTimeSec"]], 64)
+		robotLinked, _ := strconv.ParseBool(record[columns["robotLinked"]])
+		enabled, _ := strconv.ParseBool(record[columns["enabled"]])
+		batteryVoltage, _ := strconv.ParseFloat(record[columns["batteryVoltage"]], 64)
+		missedPacketCount, _ := strconv.Atoi(record[columns["missedPacketCount"]])
+		tripTimeMs, _ := strconv.Atoi(record[columns["dsRobotTripTimeMs"]])
+		if i == 0 {
+			analysis.TeamId, _ = strconv.Atoi(record[columns["teamId"]])
+			previousMissedPacketCount = missedPacketCount
+		}
+
+		// Attribute the time since the previous packet to the state reported in that packet.
+		if i > 0 && previousDisconnectedEnabled {
+			analysis.DisconnectedEnabledSec += matchTimeSec - previousMatchTimeSec
+		}
+		previousMatchTimeSec = matchTimeSec
+		previousDisconnectedEnabled = enabled && !robotLinked
+
+		if wasLinked && !robotLinked {
+			analysis.LinkDrops++
+		}
+		wasLinked = robotLinked
+
+		if robotLinked && batteryVoltage > 0 {
+			if analysis.MinBatteryVoltage == 0 || batteryVoltage < analysis.MinBatteryVoltage {
+				analysis.MinBatteryVoltage = batteryVoltage
+			}
+			isBrownedOut := batteryVoltage < brownoutVoltage
+			if isBrownedOut && !wasBrownedOut {
+				analysis.BrownoutDips++
+			}
+			wasBrownedOut = isBrownedOut
+			tripTimes = append(tripTimes, tripTimeMs)
+		}
+
+		// The missed packet count is cumulative over the match, so look at how much it jumps between packets.
+		missedPacketDelta := missedPacketCount - previousMissedPacketCount
+		if missedPacketDelta >= missedPacketBurstSize {
+			analysis.MissedPacketBursts++
+		}
+		if missedPacketDelta > 0 {
+			analysis.MissedPackets += missedPacketDelta
+		}
+		previousMissedPacketCount = missedPacketCount
+	}
+
+	if len(tripTimes) > 0 {
+		sort.Ints(tripTimes)
+		analysis.TripTimeP50Ms = percentile(tripTimes, 50)
+		analysis.TripTimeP90Ms = percentile(tripTimes, 90)
+		analysis.TripTimeP99Ms = percentile(tripTimes, 99)
+		analysis.TripTimeMaxMs = tripTimes[len(tripTimes)-1]
+	}
+	return &analysis, nil
+}
+
+// BuildTeamHealth aggregates the given match log analyses by team, ordered with the teams that have had the most
+// problem matches first and then by team number. Each team's matches are ordered by start time.
+func BuildTeamHealth(analyses []TeamMatchLogAnalysis) []TeamHealth {
+	teamHealthMap := make(map[int]*TeamHealth)
+	for _, analysis := range analyses {
+		teamHealth, ok := teamHealthMap[analysis.TeamId]
+		if !ok {
+			teamHealth = &TeamHealth{TeamId: analysis.TeamId}
+			teamHealthMap[analysis.TeamId] = teamHealth
+		}
+		teamHealth.NumMatches++
+		if analysis.HasProblems() {
+			teamHealth.NumProblemMatches++
+		}
+		teamHealth.LinkDrops += analysis.LinkDrops
+		teamHealth.DisconnectedEnabledSec += analysis.DisconnectedEnabledSec
+		teamHealth.BrownoutDips += analysis.BrownoutDips
+		if analysis.MinBatteryVoltage > 0 &&
+			(teamHealth.MinBatteryVoltage == 0 || analysis.MinBatteryVoltage < teamHealth.MinBatteryVoltage) {
+			teamHealth.MinBatteryVoltage = analysis.MinBatteryVoltage
+		}
+		teamHealth.MaxTripTimeP90Ms = max(teamHealth.MaxTripTimeP90Ms, analysis.TripTimeP90Ms)
+		teamHealth.MissedPackets += analysis.MissedPackets
+		teamHealth.MissedPacketBursts += analysis.MissedPacketBursts
+		teamHealth.Matches = append(teamHealth.Matches, analysis)
+	}
+
+	teamHealths := make([]TeamHealth, 0, len(teamHealthMap))
+	for _, teamHealth := range teamHealthMap {
+		sort.SliceStable(teamHealth.Matches, func(i, j int) bool {
+			return teamHealth.Matches[i].StartTime.Before(teamHealth.Matches[j].StartTime)
+		})
+		teamHealths = append(teamHealths, *teamHealth)
+	}
+	sort.Slice(teamHealths, func(i, j int) bool {
+		if teamHealths[i].NumProblemMatches != teamHealths[j].NumProblemMatches {
+			return teamHealths[i].NumProblemMatches > teamHealths[j].NumProblemMatches
+		}
+		return teamHealths[i].TeamId < teamHealths[j].TeamId
+	})
+	return teamHealths
+}
+
+// analyzeTeamMatchLogsInDir analyzes every match log in the given directory, skipping test matches and any files that
+// can't be parsed.
+func analyzeTeamMatchLogsInDir(dirPath string) ([]TeamMatchLogAnalysis, error) {
+	filenames, err := filepath.Glob(filepath.Join(dirPath, "*.csv"))
+	if err != nil {
+		return nil, err
+	}
+
+	var analyses []TeamMatchLogAnalysis
+	for _, filename := range filenames {
+		startTime, matchType, matchShortName, teamId, ok := parseTeamMatchLogFilename(filepath.Base(filename))
+		if !ok || matchType == model.Test.String() {
+			continue
+		}
+		analysis, err := analyzeTeamMatchLogFile(filename)
+		if err != nil {
+			log.Printf("Failed to analyze match log %s: %v", filename, err)
+			continue
+		}
+		analysis.Filename = filepath.Base(filename)
+		analysis.TeamId = teamId
+		analysis.MatchType = matchType
+		analysis.MatchShortName = matchShortName
+		analysis.StartTime = startTime
+		analyses = append(analyses, *analysis)
+	}
+	return analyses, nil
+}
+
+// analyzeTeamMatchLogFile opens and analyzes the match log with the given path.
+func analyzeTeamMatchLogFile(filename string) (*TeamMatchLogAnalysis, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return AnalyzeTeamMatchLog(file)
+}
+
+// parseTeamMatchLogFilename extracts the start time, match type, match short name and team number from the name of a
+// log file created by NewTeamMatchLog. Returns false if the name is not of the expected form.
+func parseTeamMatchLogFilename(filename string) (time.Time, string, string, int, bool) {
+	parts := strings.Split(strings.TrimSuffix(filename, ".csv"), "_")
+	if len(parts) < 5 || parts[2] != "Match" {
+		return time.Time{}, "", "", 0, false
+	}
+	startTime, err := time.ParseInLocation("20060102150405", parts[0], time.Local)
+	if err != nil {
+		return time.Time{}, "", "", 0, false
+	}
+	teamId, err := strconv.Atoi(parts[len(parts)-1])
+	if err != nil {
+		return time.Time{}, "", "", 0, false
+	}
+	return startTime, parts[1], strings.Join(parts[3:len(parts)-1], "_"), teamId, true
+}
+
+// percentile returns the value at the given percentile of the given sorted values, using the nearest-rank method.
+func percentile(sortedValues []int, percent float64) int {
+	rank := int(math.Ceil(percent / 100 * float64(len(sortedValues))))
+	return sortedValues[max(rank, 1)-1]
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package field
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testMatchLogHeader = "matchTimeSec,packetType,teamId,allianceStation,dsLinked,radioLinked,rioLinked," +
+	"robotLinked,auto,enabled,emergencyStop,autonomousStop,batteryVoltage,missedPacketCount,dsRobotTripTimeMs,rxRate," +
+	"txRate,signalNoiseRatio\n"
+
+func TestAnalyzeTeamMatchLog(t *testing.T) {
+	log := testMatchLogHeader +
+		"0.5,22,254,R1,true,true,true,true,true,true,false,false,12.5,0,4,0,0,0\n" +
+		"1.0,22,254,R1,true,true,true,true,true,true,false,false,12.1,0,6,0,0,0\n" +
+		"1.5,22,254,R1,true,true,true,false,true,true,false,false,0,12,0,0,0,0\n" +
+		"3.0,22,254,R1,true,true,true,true,true,true,false,false,6.5,14,10,0,0,0\n" +
+		"3.5,22,254,R1,true,true,true,true,false,false,false,false,6.8,14,8,0,0,0\n" +
+		"4.0,22,254,R1,true,true,true,true,false,true,false,false,11.9,15,5,0,0,0\n" +
+		"4.5,22,254,R1,true,true,true,true,false,true,false,false,6.9,30,20,0,0,0\n" +
+		"5.0,22,254,R1,true,true,true,false,false,false,false,false,0,30,0,0,0,0\n"
+	analysis, err := AnalyzeTeamMatchLog(strings.NewReader(log))
+	assert.Nil(t, err)
+	assert.Equal(t, 254, analysis.TeamId)
+	assert.Equal(t, 8, analysis.NumPackets)
+	assert.Equal(t, 2, analysis.LinkDrops)
+	assert.Equal(t, 1.5, analysis.DisconnectedEnabledSec)
+	assert.Equal(t, 2, analysis.BrownoutDips)
+	assert.Equal(t, 6.5, analysis.MinBatteryVoltage)
+	assert.Equal(t, 6, analysis.TripTimeP50Ms)
+	assert.Equal(t, 20, analysis.TripTimeP90Ms)
+	assert.Equal(t, 20, analysis.TripTimeP99Ms)
+	assert.Equal(t, 20, analysis.TripTimeMaxMs)
+	assert.Equal(t, 30, analysis.MissedPackets)
+	assert.Equal(t, 2, analysis.MissedPacketBursts)
+	assert.True(t, analysis.HasProblems())
+
+	// Check that a clean log has no problems.
+	log = testMatchLogHeader + "0.5,22,254,R1,true,true,true,true,true,true,false,false,12.5,0,4,0,0,0\n"
+	analysis, err = AnalyzeTeamMatchLog(strings.NewReader(log))
+	assert.Nil(t, err)
+	assert.False(t, analysis.HasProblems())
+
+	_, err = AnalyzeTeamMatchLog(strings.NewReader("matchTimeSec,teamId\n1.0,254\n"))
+	assert.EqualError(t, err, "match log is missing column \"robotLinked\"")
+}
+
+func TestAnalyzeTeamMatchLogsInDir(t *testing.T) {
+	dir := t.TempDir()
+	writeLog := func(filename, rows string) {
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, filename), []byte(testMatchLogHeader+rows), 0644))
+	}
+	cleanRow := "0.5,22,%d,R1,true,true,true,true,true,true,false,false,12.5,0,4,0,0,0\n"
+	droppedRows := "0.5,22,%d,R1,true,true,true,true,true,true,false,false,12.5,0,4,0,0,0\n" +
+		"1.0,22,%d,R1,true,true,true,false,true,true,false,false,0,0,0,0,0,0\n"
+	writeLog("20260301100000_Qualification_Match_Q1_254.csv", strings.ReplaceAll(cleanRow, "%d", "254"))
+	writeLog("20260301110000_Qualification_Match_Q2_254.csv", strings.ReplaceAll(droppedRows, "%d", "254"))
+	writeLog("20260301090000_Practice_Match_P1_1114.csv", strings.ReplaceAll(cleanRow, "%d", "1114"))
+	writeLog("20260301080000_Test_Match_T_1114.csv", strings.ReplaceAll(droppedRows, "%d", "1114"))
+	writeLog("notes.csv", "")
+
+	analyses, err := analyzeTeamMatchLogsInDir(dir)
+	assert.Nil(t, err)
+	teamHealths := BuildTeamHealth(analyses)
+	if assert.Equal(t, 2, len(teamHealths)) {
+		assert.Equal(t, 254, teamHealths[0].TeamId)
+		assert.Equal(t, 2, teamHealths[0].NumMatches)
+		assert.Equal(t, 1, teamHealths[0].NumProblemMatches)
+		assert.Equal(t, 1, teamHealths[0].LinkDrops)
+		assert.Equal(t, 12.5, teamHealths[0].MinBatteryVoltage)
+		if assert.Equal(t, 2, len(teamHealths[0].Matches)) {
+			assert.Equal(t, "Q1", teamHealths[0].Matches[0].MatchShortName)
+			assert.Equal(t, "Qualification", teamHealths[0].Matches[0].MatchType)
+			assert.Equal(t, time.Date(2026, 3, 1, 10, 0, 0, 0, time.Local), teamHealths[0].Matches[0].StartTime)
+			assert.Equal(t, "Q2", teamHealths[0].Matches[1].MatchShortName)
+		}
+
+		// The test match log should have been left out.
+		assert.Equal(t, 1114, teamHealths[1].TeamId)
+		assert.Equal(t, 1, teamHealths[1].NumMatches)
+		assert.Equal(t, 0, teamHealths[1].NumProblemMatches)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Recording of the software versions reported by each team and checking of them against the allowed versions.
+
+package field
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// Returns the software versions currently reported for the team in the given station by its driver station and by the
+// access point, or nil if there is no team connected there.
+func (arena *Arena) stationTeamVersions(station string) *model.TeamVersions {
+	allianceStation := arena.AllianceStations[station]
+	dsConn := allianceStation.DsConn
+	if allianceStation.Team == nil || dsConn == nil {
+		return nil
+	}
+	versions := model.TeamVersions{
+		Id:            allianceStation.Team.Id,
+		DsVersion:     dsConn.DsVersion,
+		RioVersion:    dsConn.RioVersion,
+		WpilibVersion: dsConn.WpilibVersion,
+	}
+	if allianceStation.WifiStatus.TeamId == allianceStation.Team.Id {
+		versions.RadioFirmwareVersion = allianceStation.WifiStatus.RadioFirmwareVersion
+	}
+	return &versions
+}
+
+// Returns the ways in which the software versions of the team in each station in use don't comply with the allowed
+// versions, keyed by station and omitting the stations that comply.
+func (arena *Arena) StationVersionIssues() map[string][]string {
+	stationIssues := make(map[string][]string)
+	for _, station := range arena.AllianceStationNames() {
+		if versions := arena.stationTeamVersions(station); versions != nil {
+			if issues := versions.ComplianceIssues(arena.EventSettings); len(issues) > 0 {
+				stationIssues[station] = issues
+			}
+		}
+	}
+	return stationIssues
+}
+
+// Returns an error if the event is configured to require compliant software versions and a team that is playing in the
+// current match has reported a version that isn't allowed.
+func (arena *Arena) checkVersionCompliance() error {
+	if !arena.EventSettings.VersionComplianceRequired {
+		return nil
+	}
+	stationIssues := arena.StationVersionIssues()
+	for _, station := range arena.AllianceStationNames() {
+		issues, ok := stationIssues[station]
+		if !ok || arena.AllianceStations[station].Bypass {
+			continue
+		}
+		return fmt.Errorf(
+			"cannot start match until team %d fixes its software versions (%s)",
+			arena.AllianceStations[station].Team.Id,
+			strings.Join(issues, "; "),
+		)
+	}
+	return nil
+}
+
+// Saves any software versions that the connected teams have reported since they were last recorded, keeping the
+// previously recorded value of each version that isn't currently being reported.
+func (arena *Arena) recordTeamVersions() {
+	for _, station := range arena.AllianceStationNames() {
+		versions := arena.stationTeamVersions(station)
+		if versions == nil {
+			continue
+		}
+		if err := arena.recordTeamVersionsForTeam(versions); err != nil {
+			log.Printf("Failed to record software versions for Team %d: %v", versions.Id, err)
+		}
+	}
+}
+
+func (arena *Arena) recordTeamVersionsForTeam(versions *model.TeamVersions) error {
+	recordedVersions, err := arena.Database.GetTeamVersionsById(versions.Id)
+	if err != nil {
+		return err
+	}
+	if recordedVersions == nil {
+		if *versions == (model.TeamVersions{Id: versions.Id}) {
+			// Nothing has been reported yet.
+			return nil
+		}
+		versions.UpdatedAt = time.Now()
+		return arena.Database.CreateTeamVersions(versions)
+	}
+
+	updatedVersions := *recordedVersions
+	for _, version := range []struct {
+		reported string
+		recorded *string
+	}{
+		{versions.DsVersion, &updatedVersions.DsVersion},
+		{versions.RioVersion, &updatedVersions.RioVersion},
+		{versions.WpilibVersion, &updatedVersions.WpilibVersion},
+		{versions.RadioFirmwareVersion, &updatedVersions.RadioFirmwareVersion},
+	} {
+		if version.reported != "" {
+			*version.recorded = version.reported
+		}
+	}
+	if updatedVersions == *recordedVersions {
+		return nil
+	}
+	updatedVersions.UpdatedAt = time.Now()
+	return arena.Database.UpdateTeamVersions(&updatedVersions)
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package field
+
+import (
+	"testing"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArenaVersionCompliance(t *testing.T) {
+	arena := setupTestArena(t)
+	arena.EventSettings.AllowedDsVersions = "25.0"
+	arena.EventSettings.AllowedRadioFirmwareVersions = "1.2.0"
+	match := model.Match{Type: model.Qualification, ShortName: "Q1", Red1: 254}
+	assert.Nil(t, arena.Database.CreateMatch(&match))
+	assert.Nil(t, arena.LoadMatch(&match))
+	for _, allianceStation := range arena.AllianceStations {
+		allianceStation.Bypass = true
+	}
+	dsConn := &DriverStationConnection{TeamId: 254, RobotLinked: true, DsVersion: "24.0"}
+	arena.AllianceStations["R1"].Bypass = false
+	arena.AllianceStations["R1"].DsConn = dsConn
+	arena.AllianceStations["R1"].WifiStatus.TeamId = 254
+	arena.AllianceStations["R1"].WifiStatus.RadioFirmwareVersion = "1.1.0"
+
+	// Check that non-compliant versions are flagged but only block the match when so configured.
+	assert.Equal(
+		t,
+		map[string][]string{
+			"R1": {"DS version 24.0 is not allowed", "radio firmware version 1.1.0 is not allowed"},
+		},
+		arena.StationVersionIssues(),
+	)
+	assert.Nil(t, arena.checkCanStartMatch())
+	arena.EventSettings.VersionComplianceRequired = true
+	assert.EqualError(
+		t,
+		arena.checkCanStartMatch(),
+		"cannot start match until team 254 fixes its software versions (DS version 24.0 is not allowed; radio "+
+			"firmware version 1.1.0 is not allowed)",
+	)
+	dsConn.DsVersion = "25.0"
+	arena.AllianceStations["R1"].WifiStatus.RadioFirmwareVersion = "1.2.0"
+	assert.Empty(t, arena.StationVersionIssues())
+	assert.Nil(t, arena.checkCanStartMatch())
+
+	// Check that a radio associated with the wrong team doesn't count towards the team's versions.
+	arena.AllianceStations["R1"].WifiStatus.TeamId = 1114
+	arena.AllianceStations["R1"].WifiStatus.RadioFirmwareVersion = "1.1.0"
+	assert.Empty(t, arena.StationVersionIssues())
+}
+
+func TestArenaRecordTeamVersions(t *testing.T) {
+	arena := setupTestArena(t)
+	match := model.Match{Type: model.Qualification, ShortName: "Q1", Red1: 254, Blue1: 1114}
+	assert.Nil(t, arena.Database.CreateMatch(&match))
+	assert.Nil(t, arena.LoadMatch(&match))
+	dsConn := &DriverStationConnection{TeamId: 254}
+	arena.AllianceStations["R1"].DsConn = dsConn
+	arena.AllianceStations["B1"].DsConn = &DriverStationConnection{TeamId: 1114}
+
+	// Check that nothing is recorded until a version has been reported.
+	arena.recordTeamVersions()
+	allVersions, _ := arena.Database.GetAllTeamVersions()
+	assert.Empty(t, allVersions)
+
+	dsConn.DsVersion = "25.0"
+	dsConn.RioVersion = "FRC_roboRIO2_2025_v2.0"
+	arena.recordTeamVersions()
+	versions, _ := arena.Database.GetTeamVersionsById(254)
+	if assert.NotNil(t, versions) {
+		assert.Equal(t, "25.0", versions.DsVersion)
+		assert.Equal(t, "FRC_roboRIO2_2025_v2.0", versions.RioVersion)
+		assert.False(t, versions.UpdatedAt.IsZero())
+	}
+
+	// Check that a version that is no longer being reported keeps its previously recorded value.
+	dsConn.DsVersion = "25.1"
+	dsConn.RioVersion = ""
+	arena.recordTeamVersions()
+	versions, _ = arena.Database.GetTeamVersionsById(254)
+	if assert.NotNil(t, versions) {
+		assert.Equal(t, "25.1", versions.DsVersion)
+		assert.Equal(t, "FRC_roboRIO2_2025_v2.0", versions.RioVersion)
+	}
+	allVersions, _ = arena.Database.GetAllTeamVersions()
+	assert.Equal(t, 1, len(allVersions))
+}
@@ -39,7 +39,8 @@ type WidgetConfig struct {
 }
 
 type RuleConfig struct {
-	Fouls []FoulRule `json:"fouls"`
+	Fouls       []FoulRule `json:"fouls"`
+	Tiebreakers []string   `json:"tiebreakers"`
 }
 
 type FoulRule struct {
@@ -5,13 +5,20 @@
 
 package game
 
+// Maximum number of robots per alliance for which per-robot scoring is tracked.
+const MaxRobotsPerAlliance = 4
+
+// Number of robots per alliance in a standard match.
+const defaultRobotsPerAlliance = 3
+
 type Score struct {
-	RobotsBypassed  [3]bool
-	LeaveStatuses   [3]bool
+	NumRobots       int
+	RobotsBypassed  [MaxRobotsPerAlliance]bool
+	LeaveStatuses   [MaxRobotsPerAlliance]bool
 	Reef            Reef
 	BargeAlgae      int
 	ProcessorAlgae  int
-	EndgameStatuses [3]EndgameStatus
+	EndgameStatuses [MaxRobotsPerAlliance]EndgameStatus
 	Fouls           []Foul
 	PlayoffDq       bool
 	GenericCounters map[string]int
@@ -105,8 +112,8 @@ func (score *Score) Summarize(opponentScore *Score) *ScoreSummary {
 	// Calculate bonus ranking points.
 	// Autonomous bonus ranking point.
 	allRobotsLeft := true
-	for i, left := range score.LeaveStatuses {
-		if !left && !score.RobotsBypassed[i] {
+	for i := 0; i < score.RobotCount(); i++ {
+		if !score.LeaveStatuses[i] && !score.RobotsBypassed[i] {
 			allRobotsLeft = false
 			break
 		}
@@ -157,9 +164,19 @@ func (score *Score) Summarize(opponentScore *Score) *ScoreSummary {
 	return summary
 }
 
+// RobotCount returns the number of robots on the alliance, treating an unset count (as in scores saved before it was
+// configurable) as the standard number.
+func (score *Score) RobotCount() int {
+	if score.NumRobots <= 0 || score.NumRobots > MaxRobotsPerAlliance {
+		return defaultRobotsPerAlliance
+	}
+	return score.NumRobots
+}
+
 // Equals returns true if and only if all fields of the two scores are equal.
 func (score *Score) Equals(other *Score) bool {
-	if score.RobotsBypassed != other.RobotsBypassed ||
+	if score.NumRobots != other.NumRobots ||
+		score.RobotsBypassed != other.RobotsBypassed ||
 		score.LeaveStatuses != other.LeaveStatuses ||
 		score.Reef != other.Reef ||
 		score.BargeAlgae != other.BargeAlgae ||
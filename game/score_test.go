@@ -70,8 +70,8 @@ func TestScoreSummary(t *testing.T) {
 
 func TestScoreAutoBonusRankingPoint(t *testing.T) {
 	redScore := TestScore1()
-	redScore.RobotsBypassed = [3]bool{false, false, false}
-	redScore.LeaveStatuses = [3]bool{false, false, false}
+	redScore.RobotsBypassed = [4]bool{false, false, false}
+	redScore.LeaveStatuses = [4]bool{false, false, false}
 	blueScore := TestScore2()
 
 	// No robots left; no bonus is awarded.
@@ -79,13 +79,13 @@ func TestScoreAutoBonusRankingPoint(t *testing.T) {
 	assert.Equal(t, false, redSummary.AutoBonusRankingPoint)
 
 	// All robots left; the bonus is awarded.
-	redScore.LeaveStatuses = [3]bool{true, true, true}
+	redScore.LeaveStatuses = [4]bool{true, true, true}
 	redSummary = redScore.Summarize(blueScore)
 	assert.Equal(t, true, redSummary.AutoBonusRankingPoint)
 
 	// One robot failed to leave; no bonus is awarded.
 	for i := 0; i < 3; i++ {
-		redScore.LeaveStatuses = [3]bool{true, true, true}
+		redScore.LeaveStatuses = [4]bool{true, true, true}
 		redScore.LeaveStatuses[i] = false
 		redSummary = redScore.Summarize(blueScore)
 		assert.Equal(t, false, redSummary.AutoBonusRankingPoint)
@@ -93,17 +93,33 @@ func TestScoreAutoBonusRankingPoint(t *testing.T) {
 
 	// One bypassed robot failed to leave; the bonus is awarded.
 	for i := 0; i < 3; i++ {
-		redScore.RobotsBypassed = [3]bool{false, false, false}
+		redScore.RobotsBypassed = [4]bool{false, false, false}
 		redScore.RobotsBypassed[i] = true
-		redScore.LeaveStatuses = [3]bool{true, true, true}
+		redScore.LeaveStatuses = [4]bool{true, true, true}
 		redScore.LeaveStatuses[i] = false
 		redSummary = redScore.Summarize(blueScore)
 		assert.Equal(t, true, redSummary.AutoBonusRankingPoint)
 	}
 
 	// Only one robot left but the other two were bypassed; the bonus is awarded.
-	redScore.RobotsBypassed = [3]bool{false, true, true}
-	redScore.LeaveStatuses = [3]bool{true, false, false}
+	redScore.RobotsBypassed = [4]bool{false, true, true}
+	redScore.LeaveStatuses = [4]bool{true, false, false}
+	redSummary = redScore.Summarize(blueScore)
+	assert.Equal(t, true, redSummary.AutoBonusRankingPoint)
+
+	// With four robots on the alliance, the fourth must also leave.
+	redScore.NumRobots = 4
+	redScore.RobotsBypassed = [4]bool{false, false, false, false}
+	redScore.LeaveStatuses = [4]bool{true, true, true, false}
+	redSummary = redScore.Summarize(blueScore)
+	assert.Equal(t, false, redSummary.AutoBonusRankingPoint)
+	redScore.LeaveStatuses[3] = true
+	redSummary = redScore.Summarize(blueScore)
+	assert.Equal(t, true, redSummary.AutoBonusRankingPoint)
+
+	// With two robots on the alliance, only those two need to leave.
+	redScore.NumRobots = 2
+	redScore.LeaveStatuses = [4]bool{true, true, false, false}
 	redSummary = redScore.Summarize(blueScore)
 	assert.Equal(t, true, redSummary.AutoBonusRankingPoint)
 
@@ -195,14 +211,14 @@ func TestScoreBargeBonusRankingPoint(t *testing.T) {
 	}()
 
 	testCases := []struct {
-		endgameStatuses      [3]EndgameStatus
+		endgameStatuses      [4]EndgameStatus
 		fouls                []Foul
 		threshold            int
 		expectedBonusAwarded bool
 	}{
 		// 0. No endgame points.
 		{
-			endgameStatuses:      [3]EndgameStatus{EndgameNone, EndgameNone, EndgameNone},
+			endgameStatuses:      [4]EndgameStatus{EndgameNone, EndgameNone, EndgameNone},
 			fouls:                []Foul{},
 			threshold:            14,
 			expectedBonusAwarded: false,
@@ -210,7 +226,7 @@ func TestScoreBargeBonusRankingPoint(t *testing.T) {
 
 		// 1. All robots parked.
 		{
-			endgameStatuses:      [3]EndgameStatus{EndgameParked, EndgameParked, EndgameParked},
+			endgameStatuses:      [4]EndgameStatus{EndgameParked, EndgameParked, EndgameParked},
 			fouls:                []Foul{},
 			threshold:            14,
 			expectedBonusAwarded: false,
@@ -218,7 +234,7 @@ func TestScoreBargeBonusRankingPoint(t *testing.T) {
 
 		// 2. Meeting the minimum threshold.
 		{
-			endgameStatuses:      [3]EndgameStatus{EndgameParked, EndgameNone, EndgameDeepCage},
+			endgameStatuses:      [4]EndgameStatus{EndgameParked, EndgameNone, EndgameDeepCage},
 			fouls:                []Foul{},
 			threshold:            14,
 			expectedBonusAwarded: true,
@@ -226,7 +242,7 @@ func TestScoreBargeBonusRankingPoint(t *testing.T) {
 
 		// 3. Same endgame statuses not meeting a higher threshold.
 		{
-			endgameStatuses:      [3]EndgameStatus{EndgameParked, EndgameNone, EndgameDeepCage},
+			endgameStatuses:      [4]EndgameStatus{EndgameParked, EndgameNone, EndgameDeepCage},
 			fouls:                []Foul{},
 			threshold:            16,
 			expectedBonusAwarded: false,
@@ -234,7 +250,7 @@ func TestScoreBargeBonusRankingPoint(t *testing.T) {
 
 		// 4. Meeting the new minimum threshold with a different combination.
 		{
-			endgameStatuses:      [3]EndgameStatus{EndgameDeepCage, EndgameParked, EndgameParked},
+			endgameStatuses:      [4]EndgameStatus{EndgameDeepCage, EndgameParked, EndgameParked},
 			fouls:                []Foul{},
 			threshold:            16,
 			expectedBonusAwarded: true,
@@ -242,7 +258,7 @@ func TestScoreBargeBonusRankingPoint(t *testing.T) {
 
 		// 5. One of each endgame status with higher threshold.
 		{
-			endgameStatuses:      [3]EndgameStatus{EndgameShallowCage, EndgameDeepCage, EndgameParked},
+			endgameStatuses:      [4]EndgameStatus{EndgameShallowCage, EndgameDeepCage, EndgameParked},
 			fouls:                []Foul{},
 			threshold:            21,
 			expectedBonusAwarded: false,
@@ -250,7 +266,7 @@ func TestScoreBargeBonusRankingPoint(t *testing.T) {
 
 		// 6. All deep climbs.
 		{
-			endgameStatuses:      [3]EndgameStatus{EndgameDeepCage, EndgameDeepCage, EndgameDeepCage},
+			endgameStatuses:      [4]EndgameStatus{EndgameDeepCage, EndgameDeepCage, EndgameDeepCage},
 			fouls:                []Foul{},
 			threshold:            36,
 			expectedBonusAwarded: true,
@@ -258,7 +274,7 @@ func TestScoreBargeBonusRankingPoint(t *testing.T) {
 
 		// 7. G206 foul disqualifies the alliance from the Barge bonus.
 		{
-			endgameStatuses:      [3]EndgameStatus{EndgameDeepCage, EndgameDeepCage, EndgameDeepCage},
+			endgameStatuses:      [4]EndgameStatus{EndgameDeepCage, EndgameDeepCage, EndgameDeepCage},
 			fouls:                []Foul{{RuleId: 1}},
 			threshold:            14,
 			expectedBonusAwarded: false,
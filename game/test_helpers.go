@@ -16,8 +16,8 @@ func TestScore1() *Score {
 		{true, 25, 15},
 	}
 	return &Score{
-		RobotsBypassed: [3]bool{false, false, true},
-		LeaveStatuses:  [3]bool{true, true, false},
+		RobotsBypassed: [4]bool{false, false, true},
+		LeaveStatuses:  [4]bool{true, true, false},
 		Reef: Reef{
 			AutoBranches:   [3][12]bool{{true}},
 			Branches:       [3][12]bool{{true, true}, {true, true, true}},
@@ -28,7 +28,7 @@ func TestScore1() *Score {
 		},
 		BargeAlgae:      7,
 		ProcessorAlgae:  2,
-		EndgameStatuses: [3]EndgameStatus{EndgameParked, EndgameNone, EndgameDeepCage},
+		EndgameStatuses: [4]EndgameStatus{EndgameParked, EndgameNone, EndgameDeepCage},
 		Fouls:           fouls,
 		PlayoffDq:       false,
 	}
@@ -36,8 +36,8 @@ func TestScore1() *Score {
 
 func TestScore2() *Score {
 	return &Score{
-		RobotsBypassed: [3]bool{false, false, false},
-		LeaveStatuses:  [3]bool{false, true, false},
+		RobotsBypassed: [4]bool{false, false, false},
+		LeaveStatuses:  [4]bool{false, true, false},
 		Reef: Reef{
 			AutoBranches:   [3][12]bool{{}, {}, {true, true, true, true}},
 			Branches:       [3][12]bool{{true, true, true}, {true, true, true, true, true}, {true, true, true}},
@@ -48,7 +48,7 @@ func TestScore2() *Score {
 		},
 		BargeAlgae:      9,
 		ProcessorAlgae:  1,
-		EndgameStatuses: [3]EndgameStatus{EndgameDeepCage, EndgameShallowCage, EndgameShallowCage},
+		EndgameStatuses: [4]EndgameStatus{EndgameDeepCage, EndgameShallowCage, EndgameShallowCage},
 		Fouls:           []Foul{},
 		PlayoffDq:       false,
 	}
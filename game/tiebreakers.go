@@ -0,0 +1,89 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Configurable ordered criteria used to resolve tied playoff matches.
+
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// playoffTiebreakerCriteria maps the name of each supported tiebreaker to the value that is compared between the two
+// alliances, with the higher value winning.
+var playoffTiebreakerCriteria = map[string]func(*ScoreSummary) int{
+	"opponentMajorFouls": func(summary *ScoreSummary) int { return summary.NumOpponentMajorFouls },
+	"autoPoints":         func(summary *ScoreSummary) int { return summary.AutoPoints },
+	"bargePoints":        func(summary *ScoreSummary) int { return summary.BargePoints },
+	"coralPoints":        func(summary *ScoreSummary) int { return summary.CoralPoints },
+	"algaePoints":        func(summary *ScoreSummary) int { return summary.AlgaePoints },
+	"leavePoints":        func(summary *ScoreSummary) int { return summary.LeavePoints },
+	"matchPoints":        func(summary *ScoreSummary) int { return summary.MatchPoints },
+}
+
+// playoffTiebreakerNames lists the supported tiebreakers in the order in which they are presented to the user.
+var playoffTiebreakerNames = []string{
+	"opponentMajorFouls", "autoPoints", "bargePoints", "coralPoints", "algaePoints", "leavePoints", "matchPoints",
+}
+
+// DefaultPlayoffTiebreakers is the tiebreaker order specified by the game manual.
+var DefaultPlayoffTiebreakers = []string{"opponentMajorFouls", "autoPoints", "bargePoints"}
+
+// PlayoffTiebreakers is the tiebreaker order currently in effect, applied in sequence until one of them is decisive.
+var PlayoffTiebreakers = DefaultPlayoffTiebreakers
+
+// ParsePlayoffTiebreakers converts a comma-separated list of tiebreaker names into a slice, returning an error if any of
+// them are not supported. Returns nil if the list is blank.
+func ParsePlayoffTiebreakers(value string) ([]string, error) {
+	var tiebreakers []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if err := validatePlayoffTiebreaker(name); err != nil {
+			return nil, err
+		}
+		tiebreakers = append(tiebreakers, name)
+	}
+	return tiebreakers, nil
+}
+
+// SetPlayoffTiebreakers validates and applies the given tiebreaker order, falling back to the default if it is empty.
+func SetPlayoffTiebreakers(tiebreakers []string) error {
+	if len(tiebreakers) == 0 {
+		PlayoffTiebreakers = DefaultPlayoffTiebreakers
+		return nil
+	}
+	for _, name := range tiebreakers {
+		if err := validatePlayoffTiebreaker(name); err != nil {
+			return err
+		}
+	}
+	PlayoffTiebreakers = tiebreakers
+	return nil
+}
+
+// ValidPlayoffTiebreakers returns a readable list of the supported tiebreaker names.
+func ValidPlayoffTiebreakers() string {
+	return strings.Join(playoffTiebreakerNames, ", ")
+}
+
+func validatePlayoffTiebreaker(name string) error {
+	if _, ok := playoffTiebreakerCriteria[name]; !ok {
+		return fmt.Errorf("invalid playoff tiebreaker %q; valid options are %s", name, ValidPlayoffTiebreakers())
+	}
+	return nil
+}
+
+// breakPlayoffTie compares the alliances on each configured tiebreaker in turn and returns the first decisive
+// result, or TieMatch if none of them separate the alliances.
+func breakPlayoffTie(redScoreSummary, blueScoreSummary *ScoreSummary) MatchStatus {
+	for _, name := range PlayoffTiebreakers {
+		criterion := playoffTiebreakerCriteria[name]
+		if status := comparePoints(criterion(redScoreSummary), criterion(blueScoreSummary)); status != TieMatch {
+			return status
+		}
+	}
+	return TieMatch
+}
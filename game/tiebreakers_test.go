@@ -0,0 +1,39 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlayoffTiebreakers(t *testing.T) {
+	t.Cleanup(func() { _ = SetPlayoffTiebreakers(nil) })
+
+	tiebreakers, err := ParsePlayoffTiebreakers(" coralPoints, algaePoints ,")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"coralPoints", "algaePoints"}, tiebreakers)
+	tiebreakers, err = ParsePlayoffTiebreakers("")
+	assert.Nil(t, err)
+	assert.Nil(t, tiebreakers)
+	_, err = ParsePlayoffTiebreakers("autoPoints,coinFlip")
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "invalid playoff tiebreaker \"coinFlip\"")
+	}
+	assert.NotNil(t, SetPlayoffTiebreakers([]string{"coinFlip"}))
+
+	redScoreSummary := &ScoreSummary{Score: 10, AutoPoints: 5, CoralPoints: 4, AlgaePoints: 6}
+	blueScoreSummary := &ScoreSummary{Score: 10, AutoPoints: 4, CoralPoints: 4, AlgaePoints: 8}
+	assert.Equal(t, RedWonMatch, DetermineMatchStatus(redScoreSummary, blueScoreSummary, true))
+
+	assert.Nil(t, SetPlayoffTiebreakers([]string{"coralPoints", "algaePoints"}))
+	assert.Equal(t, BlueWonMatch, DetermineMatchStatus(redScoreSummary, blueScoreSummary, true))
+	assert.Equal(t, TieMatch, DetermineMatchStatus(redScoreSummary, blueScoreSummary, false))
+
+	assert.Nil(t, SetPlayoffTiebreakers([]string{"coralPoints"}))
+	assert.Equal(t, TieMatch, DetermineMatchStatus(redScoreSummary, blueScoreSummary, true))
+
+	assert.Nil(t, SetPlayoffTiebreakers(nil))
+	assert.Equal(t, DefaultPlayoffTiebreakers, PlayoffTiebreakers)
+}
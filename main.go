@@ -13,19 +13,27 @@ import (
 )
 
 const eventDbPath = "./event.db"
+
+// Port of the web interface for the first field; any additional fields are served on the ports following it.
 const httpPort = 8080
 
 // Main entry point for the application.
 func main() {
-	arena, err := field.NewArena(eventDbPath)
+	arenas, err := field.NewArenas(eventDbPath)
 	if err != nil {
 		log.Fatalln("Error during startup: ", err)
 	}
 
-	// Start the web server in a separate goroutine.
-	web := web.NewWeb(arena)
-	go web.ServeWebInterface(httpPort)
+	// Start the web server for each field in a separate goroutine.
+	for i, arena := range arenas {
+		web := web.NewWeb(arena)
+		go web.ServeWebInterface(httpPort + i)
+	}
 
-	// Run the arena state machine in the main thread.
-	arena.Run()
+	// Run the arena state machine of each additional field in its own goroutine and that of the first in the main
+	// thread.
+	for _, arena := range arenas[1:] {
+		go arena.Run()
+	}
+	arenas[0].Run()
 }
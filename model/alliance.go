@@ -5,12 +5,16 @@
 
 package model
 
-import "sort"
+import (
+	"slices"
+	"sort"
+)
 
 type Alliance struct {
-	Id      int `db:"id,manual"`
-	TeamIds []int
-	Lineup  [3]int
+	Id           int `db:"id,manual"`
+	TeamIds      []int
+	Lineup       []int
+	DivisionName string
 }
 
 type AllianceSelectionRankedTeam struct {
@@ -19,6 +23,23 @@ type AllianceSelectionRankedTeam struct {
 	Picked bool
 }
 
+// Returns the initial lineup for an alliance made up of the given teams, filling the given number of stations per
+// alliance according to the tournament rules (alliance captain in the middle, first pick on the left, second pick on
+// the right). Any stations beyond those are filled by the further picks in order.
+func InitialAllianceLineup(teamIds []int, teamsPerAlliance int) []int {
+	lineup := make([]int, teamsPerAlliance)
+	for i := range lineup {
+		pickIndex := i
+		if i < 2 {
+			pickIndex = 1 - i
+		}
+		if pickIndex < len(teamIds) {
+			lineup[i] = teamIds[pickIndex]
+		}
+	}
+	return lineup
+}
+
 func (database *Database) CreateAlliance(alliance *Alliance) error {
 	return database.allianceTable.create(alliance)
 }
@@ -53,28 +74,22 @@ func (database *Database) GetAllAlliances() ([]Alliance, error) {
 	return alliances, nil
 }
 
-// Updates the alliance, if necessary, to include whoever played in the match, in case there was a substitute.
-func (database *Database) UpdateAllianceFromMatch(allianceId int, matchTeamIds [3]int) error {
+// Updates the alliance, if necessary, to include whoever played in the match, in case there was a substitute. The given
+// team IDs are those of the alliance's stations in use, in order.
+func (database *Database) UpdateAllianceFromMatch(allianceId int, matchTeamIds []int) error {
 	alliance, err := database.GetAllianceById(allianceId)
 	if err != nil {
 		return err
 	}
 
 	changed := false
-	if matchTeamIds != alliance.Lineup {
-		alliance.Lineup = matchTeamIds
+	if !slices.Equal(matchTeamIds, alliance.Lineup) {
+		alliance.Lineup = append([]int{}, matchTeamIds...)
 		changed = true
 	}
 
 	for _, teamId := range matchTeamIds {
-		found := false
-		for _, allianceTeamId := range alliance.TeamIds {
-			if teamId == allianceTeamId {
-				found = true
-				break
-			}
-		}
-		if !found {
+		if teamId != 0 && !slices.Contains(alliance.TeamIds, teamId) {
 			alliance.TeamIds = append(alliance.TeamIds, teamId)
 			changed = true
 		}
@@ -90,16 +105,12 @@ func (database *Database) UpdateAllianceFromMatch(allianceId int, matchTeamIds [
 // playoff alliance but are not playing in the given match.
 // If the given match isn't a playoff match, empty arrays are returned.
 func (database *Database) GetOffFieldTeamIds(match *Match) ([]int, []int, error) {
-	redOffFieldTeams, err := database.getOffFieldTeamIdsForAlliance(
-		match.PlayoffRedAlliance, match.Red1, match.Red2, match.Red3,
-	)
+	redOffFieldTeams, err := database.getOffFieldTeamIdsForAlliance(match.PlayoffRedAlliance, match.RedTeamIds())
 	if err != nil {
 		return nil, nil, err
 	}
 
-	blueOffFieldTeams, err := database.getOffFieldTeamIdsForAlliance(
-		match.PlayoffBlueAlliance, match.Blue1, match.Blue2, match.Blue3,
-	)
+	blueOffFieldTeams, err := database.getOffFieldTeamIdsForAlliance(match.PlayoffBlueAlliance, match.BlueTeamIds())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -107,7 +118,7 @@ func (database *Database) GetOffFieldTeamIds(match *Match) ([]int, []int, error)
 	return redOffFieldTeams, blueOffFieldTeams, nil
 }
 
-func (database *Database) getOffFieldTeamIdsForAlliance(allianceId int, teamId1, teamId2, teamId3 int) ([]int, error) {
+func (database *Database) getOffFieldTeamIdsForAlliance(allianceId int, matchTeamIds []int) ([]int, error) {
 	if allianceId == 0 {
 		return []int{}, nil
 	}
@@ -118,7 +129,7 @@ func (database *Database) getOffFieldTeamIdsForAlliance(allianceId int, teamId1,
 	}
 	offFieldTeamIds := []int{}
 	for _, allianceTeamId := range alliance.TeamIds {
-		if allianceTeamId != teamId1 && allianceTeamId != teamId2 && allianceTeamId != teamId3 {
+		if !slices.Contains(matchTeamIds, allianceTeamId) {
 			offFieldTeamIds = append(offFieldTeamIds, allianceTeamId)
 		}
 	}
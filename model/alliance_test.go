@@ -21,7 +21,7 @@ func TestAllianceCrud(t *testing.T) {
 	db := setupTestDb(t)
 	defer db.Close()
 
-	alliance := Alliance{Id: 3, TeamIds: []int{254, 1114, 296, 1503}, Lineup: [3]int{1114, 254, 296}}
+	alliance := Alliance{Id: 3, TeamIds: []int{254, 1114, 296, 1503}, Lineup: []int{1114, 254, 296}}
 	assert.Nil(t, db.CreateAlliance(&alliance))
 	alliance2, err := db.GetAllianceById(3)
 	assert.Nil(t, err)
@@ -43,20 +43,49 @@ func TestUpdateAllianceFromMatch(t *testing.T) {
 	db := setupTestDb(t)
 	defer db.Close()
 
-	alliance := Alliance{Id: 3, TeamIds: []int{254, 1114, 296, 1503}, Lineup: [3]int{1114, 254, 296}}
+	alliance := Alliance{Id: 3, TeamIds: []int{254, 1114, 296, 1503}, Lineup: []int{1114, 254, 296}}
 	assert.Nil(t, db.CreateAlliance(&alliance))
-	assert.Nil(t, db.UpdateAllianceFromMatch(3, [3]int{1503, 188, 296}))
+	assert.Nil(t, db.UpdateAllianceFromMatch(3, []int{1503, 188, 296}))
 	alliance2, err := db.GetAllianceById(3)
 	assert.Nil(t, err)
 	assert.Equal(t, []int{254, 1114, 296, 1503, 188}, alliance2.TeamIds)
-	assert.Equal(t, [3]int{1503, 188, 296}, alliance2.Lineup)
+	assert.Equal(t, []int{1503, 188, 296}, alliance2.Lineup)
+}
+
+func TestUpdateAllianceFromMatchTeamsPerAlliance(t *testing.T) {
+	db := setupTestDb(t)
+	defer db.Close()
+
+	alliance := Alliance{Id: 2, TeamIds: []int{254, 1114, 296, 1503}, Lineup: []int{1114, 254, 296, 1503}}
+	assert.Nil(t, db.CreateAlliance(&alliance))
+	assert.Nil(t, db.UpdateAllianceFromMatch(2, []int{1503, 254, 296, 0}))
+	alliance2, _ := db.GetAllianceById(2)
+	assert.Equal(t, []int{254, 1114, 296, 1503}, alliance2.TeamIds)
+	assert.Equal(t, []int{1503, 254, 296, 0}, alliance2.Lineup)
+
+	match := Match{PlayoffRedAlliance: 2, Red1: 1503, Red2: 254, Red3: 296, Red4: 1114}
+	redOffFieldTeamIds, _, err := db.GetOffFieldTeamIds(&match)
+	assert.Nil(t, err)
+	assert.Empty(t, redOffFieldTeamIds)
+	match.Red4 = 0
+	redOffFieldTeamIds, _, err = db.GetOffFieldTeamIds(&match)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1114}, redOffFieldTeamIds)
+}
+
+func TestInitialAllianceLineup(t *testing.T) {
+	teamIds := []int{254, 1114, 296, 1503}
+	assert.Equal(t, []int{1114, 254}, InitialAllianceLineup(teamIds, 2))
+	assert.Equal(t, []int{1114, 254, 296}, InitialAllianceLineup(teamIds, 3))
+	assert.Equal(t, []int{1114, 254, 296, 1503}, InitialAllianceLineup(teamIds, 4))
+	assert.Equal(t, []int{1114, 254, 296, 0}, InitialAllianceLineup(teamIds[:3], 4))
 }
 
 func TestTruncateAllianceTeams(t *testing.T) {
 	db := setupTestDb(t)
 	defer db.Close()
 
-	alliance := Alliance{Id: 1, TeamIds: []int{148, 118, 125}, Lineup: [3]int{118, 148, 125}}
+	alliance := Alliance{Id: 1, TeamIds: []int{148, 118, 125}, Lineup: []int{118, 148, 125}}
 	assert.Nil(t, db.CreateAlliance(&alliance))
 	assert.Nil(t, db.TruncateAlliances())
 	alliance2, err := db.GetAllianceById(1)
@@ -0,0 +1,57 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Model and datastore CRUD methods for the hooks that notify external automation of arena events.
+
+package model
+
+import (
+	"sort"
+)
+
+// Ways in which an arena hook can deliver its payload.
+const (
+	ArenaHookCommand = "command"
+	ArenaHookHttp    = "http"
+	ArenaHookOsc     = "osc"
+)
+
+type ArenaHook struct {
+	Id         int `db:"id"`
+	Name       string
+	Trigger    string
+	Type       string
+	Target     string
+	OscAddress string
+	Enabled    bool
+}
+
+func (database *Database) CreateArenaHook(arenaHook *ArenaHook) error {
+	return database.arenaHookTable.create(arenaHook)
+}
+
+func (database *Database) GetArenaHookById(id int) (*ArenaHook, error) {
+	return database.arenaHookTable.getById(id)
+}
+
+func (database *Database) UpdateArenaHook(arenaHook *ArenaHook) error {
+	return database.arenaHookTable.update(arenaHook)
+}
+
+func (database *Database) DeleteArenaHook(id int) error {
+	return database.arenaHookTable.delete(id)
+}
+
+func (database *Database) TruncateArenaHooks() error {
+	return database.arenaHookTable.truncate()
+}
+
+func (database *Database) GetAllArenaHooks() ([]ArenaHook, error) {
+	arenaHooks, err := database.arenaHookTable.getAll()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(arenaHooks, func(i, j int) bool {
+		return arenaHooks[i].Id < arenaHooks[j].Id
+	})
+	return arenaHooks, nil
+}
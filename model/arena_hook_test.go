@@ -0,0 +1,65 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNonexistentArenaHook(t *testing.T) {
+	db := setupTestDb(t)
+	defer db.Close()
+
+	arenaHook, err := db.GetArenaHookById(1114)
+	assert.Nil(t, err)
+	assert.Nil(t, arenaHook)
+}
+
+func TestArenaHookCrud(t *testing.T) {
+	db := setupTestDb(t)
+	defer db.Close()
+
+	arenaHook := ArenaHook{
+		Name:    "House lights",
+		Trigger: "autoStart",
+		Type:    ArenaHookOsc,
+		Target:  "10.0.100.50:8000",
+		Enabled: true,
+	}
+	assert.Nil(t, db.CreateArenaHook(&arenaHook))
+	arenaHook2, err := db.GetArenaHookById(1)
+	assert.Nil(t, err)
+	assert.Equal(t, arenaHook, *arenaHook2)
+
+	arenaHook.OscAddress = "/lights/dim"
+	arenaHook.Enabled = false
+	assert.Nil(t, db.UpdateArenaHook(&arenaHook))
+	arenaHook2, err = db.GetArenaHookById(1)
+	assert.Nil(t, err)
+	assert.Equal(t, arenaHook, *arenaHook2)
+
+	assert.Nil(t, db.DeleteArenaHook(arenaHook.Id))
+	arenaHook2, err = db.GetArenaHookById(1)
+	assert.Nil(t, err)
+	assert.Nil(t, arenaHook2)
+}
+
+func TestGetAllArenaHooks(t *testing.T) {
+	db := setupTestDb(t)
+	defer db.Close()
+
+	arenaHooks, err := db.GetAllArenaHooks()
+	assert.Nil(t, err)
+	assert.Empty(t, arenaHooks)
+
+	assert.Nil(t, db.CreateArenaHook(&ArenaHook{Name: "Webhook", Type: ArenaHookHttp}))
+	assert.Nil(t, db.CreateArenaHook(&ArenaHook{Name: "Script", Type: ArenaHookCommand}))
+	arenaHooks, err = db.GetAllArenaHooks()
+	assert.Nil(t, err)
+	if assert.Equal(t, 2, len(arenaHooks)) {
+		assert.Equal(t, "Webhook", arenaHooks[0].Name)
+		assert.Equal(t, "Script", arenaHooks[1].Name)
+	}
+}
@@ -24,19 +24,28 @@ type Database struct {
 	Path                string
 	bolt                *bbolt.DB
 	allianceTable       *table[Alliance]
+	arenaHookTable      *table[ArenaHook]
 	awardTable          *table[Award]
 	displayConfigurationTable *table[DisplayConfiguration]
 	eventSettingsTable  *table[EventSettings]
+	fieldSettingsTable  *table[FieldSettings]
 	gameConfigTable     *table[GameConfig]
+	judgingBlackoutTable *table[JudgingBlackout]
+	judgingPanelTable   *table[JudgingPanel]
 	judgingSlotTable    *table[JudgingSlot]
 	lowerThirdTable     *table[LowerThird]
 	matchTable          *table[Match]
+	matchFieldEventsTable *table[MatchFieldEvents]
 	matchResultTable    *table[MatchResult]
+	practiceQueueEntryTable *table[PracticeQueueEntry]
 	rankingTable        *table[game.Ranking]
 	scheduleBlockTable  *table[ScheduleBlock]
 	scheduledBreakTable *table[ScheduledBreak]
 	sponsorSlideTable   *table[SponsorSlide]
 	teamTable           *table[Team]
+	teamInspectionTable *table[TeamInspection]
+	teamQueueStatusTable *table[TeamQueueStatus]
+	teamVersionsTable   *table[TeamVersions]
 	userSessionTable    *table[UserSession]
 }
 
@@ -53,6 +62,9 @@ func OpenDatabase(filename string) (*Database, error) {
 	if database.allianceTable, err = newTable[Alliance](&database); err != nil {
 		return nil, err
 	}
+	if database.arenaHookTable, err = newTable[ArenaHook](&database); err != nil {
+		return nil, err
+	}
 	if database.awardTable, err = newTable[Award](&database); err != nil {
 		return nil, err
 	}
@@ -62,9 +74,18 @@ func OpenDatabase(filename string) (*Database, error) {
 	if database.eventSettingsTable, err = newTable[EventSettings](&database); err != nil {
 		return nil, err
 	}
+	if database.fieldSettingsTable, err = newTable[FieldSettings](&database); err != nil {
+		return nil, err
+	}
 	if database.gameConfigTable, err = newTable[GameConfig](&database); err != nil {
 		return nil, err
 	}
+	if database.judgingBlackoutTable, err = newTable[JudgingBlackout](&database); err != nil {
+		return nil, err
+	}
+	if database.judgingPanelTable, err = newTable[JudgingPanel](&database); err != nil {
+		return nil, err
+	}
 	if database.judgingSlotTable, err = newTable[JudgingSlot](&database); err != nil {
 		return nil, err
 	}
@@ -74,9 +95,15 @@ func OpenDatabase(filename string) (*Database, error) {
 	if database.matchTable, err = newTable[Match](&database); err != nil {
 		return nil, err
 	}
+	if database.matchFieldEventsTable, err = newTable[MatchFieldEvents](&database); err != nil {
+		return nil, err
+	}
 	if database.matchResultTable, err = newTable[MatchResult](&database); err != nil {
 		return nil, err
 	}
+	if database.practiceQueueEntryTable, err = newTable[PracticeQueueEntry](&database); err != nil {
+		return nil, err
+	}
 	if database.rankingTable, err = newTable[game.Ranking](&database); err != nil {
 		return nil, err
 	}
@@ -92,6 +119,15 @@ func OpenDatabase(filename string) (*Database, error) {
 	if database.teamTable, err = newTable[Team](&database); err != nil {
 		return nil, err
 	}
+	if database.teamInspectionTable, err = newTable[TeamInspection](&database); err != nil {
+		return nil, err
+	}
+	if database.teamQueueStatusTable, err = newTable[TeamQueueStatus](&database); err != nil {
+		return nil, err
+	}
+	if database.teamVersionsTable, err = newTable[TeamVersions](&database); err != nil {
+		return nil, err
+	}
 	if database.userSessionTable, err = newTable[UserSession](&database); err != nil {
 		return nil, err
 	}
@@ -36,6 +36,16 @@ var (
 		"exit",
 		"exit",
 	}
+	inspectionDefaultChecklist = []string{
+		"Weight",
+		"Bumpers",
+		"Frame perimeter and height",
+		"Radio firmware",
+		"roboRIO image",
+		"Robot signal light",
+		"Electrical",
+		"Mechanical",
+	}
 )
 
 type EventSettings struct {
@@ -46,6 +56,8 @@ type EventSettings struct {
 	SelectionRound2Order            string
 	SelectionRound3Order            string
 	SelectionShowUnpickedTeams      bool
+	PlayoffMinTurnaroundSec         int
+	PlayoffTiebreakers              string
 	TbaDownloadEnabled              bool
 	TbaPublishingEnabled            bool
 	TbaEventCode                    string
@@ -60,6 +72,17 @@ type EventSettings struct {
 	SwitchAddress                   string
 	SwitchPassword                  string
 	ManualMatchAdvance              bool
+	PracticeOpenQueueEnabled        bool
+	QueueCallLeadMatches            int
+	QueueLateLeadMatches            int
+	InspectionRequired              bool
+	InspectionChecklist             string
+	VersionComplianceRequired       bool
+	AllowedDsVersions               string
+	AllowedRioVersions              string
+	AllowedRadioFirmwareVersions    string
+	TeamsPerAlliance                int
+	NumFields                       int
 	SCCManagementEnabled            bool
 	CoreSwitchManagementEnabled     bool
 	CoreSwitchAddress               string
@@ -109,7 +132,15 @@ func (database *Database) GetEventSettings() (*EventSettings, error) {
 		return nil, err
 	}
 	if len(allEventSettings) == 1 {
-		return &allEventSettings[0], nil
+		eventSettings := allEventSettings[0]
+		if eventSettings.TeamsPerAlliance == 0 {
+			// Fill in the default for databases created before the number of teams per alliance was configurable.
+			eventSettings.TeamsPerAlliance = DefaultTeamsPerAlliance
+		}
+		if eventSettings.NumFields == 0 {
+			eventSettings.NumFields = 1
+		}
+		return &eventSettings, nil
 	}
 
 	// Database record doesn't exist yet; create it now.
@@ -120,6 +151,12 @@ func (database *Database) GetEventSettings() (*EventSettings, error) {
 		SelectionRound2Order:        "L",
 		SelectionRound3Order:        "",
 		SelectionShowUnpickedTeams:  true,
+		PlayoffMinTurnaroundSec:     0,
+		QueueCallLeadMatches:        0,
+		QueueLateLeadMatches:        1,
+		InspectionChecklist:         strings.Join(inspectionDefaultChecklist, "\n"),
+		TeamsPerAlliance:            DefaultTeamsPerAlliance,
+		NumFields:                   1,
 		TbaDownloadEnabled:          true,
 		FieldNetworkAdapter:         "",
 		ApChannel:                   36,
@@ -138,6 +175,17 @@ func (database *Database) GetEventSettings() (*EventSettings, error) {
 	return &eventSettings, nil
 }
 
+// Returns the items on the configured inspection checklist, one per non-blank line.
+func (eventSettings *EventSettings) InspectionChecklistItems() []string {
+	var items []string
+	for _, line := range strings.Split(eventSettings.InspectionChecklist, "\n") {
+		if item := strings.TrimSpace(line); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func (database *Database) UpdateEventSettings(eventSettings *EventSettings) error {
 	return database.eventSettingsTable.update(eventSettings)
 }
@@ -12,6 +12,8 @@ func TestEventSettingsReadWrite(t *testing.T) {
 	db := setupTestDb(t)
 	defer db.Close()
 
+	defaultInspectionChecklist := "Weight\nBumpers\nFrame perimeter and height\nRadio firmware\nroboRIO image\n" +
+		"Robot signal light\nElectrical\nMechanical"
 	eventSettings, err := db.GetEventSettings()
 	assert.Nil(t, err)
 	assert.Equal(
@@ -24,6 +26,12 @@ func TestEventSettingsReadWrite(t *testing.T) {
 			SelectionRound2Order:        "L",
 			SelectionRound3Order:        "",
 			SelectionShowUnpickedTeams:  true,
+			PlayoffMinTurnaroundSec:     0,
+			QueueCallLeadMatches:        0,
+			QueueLateLeadMatches:        1,
+			InspectionChecklist:         defaultInspectionChecklist,
+			TeamsPerAlliance:            3,
+			NumFields:                   1,
 			TbaDownloadEnabled:          true,
 			FieldNetworkAdapter:         "",
 			ApChannel:                   36,
@@ -48,3 +56,11 @@ func TestEventSettingsReadWrite(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, eventSettings, eventSettings2)
 }
+
+func TestEventSettingsInspectionChecklistItems(t *testing.T) {
+	eventSettings := EventSettings{InspectionChecklist: " Weight \n\nBumpers\r\n  \nRadio firmware"}
+	assert.Equal(t, []string{"Weight", "Bumpers", "Radio firmware"}, eventSettings.InspectionChecklistItems())
+
+	eventSettings.InspectionChecklist = ""
+	assert.Empty(t, eventSettings.InspectionChecklistItems())
+}
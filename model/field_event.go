@@ -0,0 +1,68 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Model and datastore CRUD methods for the timeline of connection, stop and state transitions on the field during a
+// match.
+
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+type FieldEvent struct {
+	MatchId      int
+	Time         time.Time
+	MatchTimeSec float64
+	Type         string
+	Station      string
+	TeamId       int
+	Description  string
+}
+
+// All the field events recorded for a match, stored together under the match's ID so that they can be looked up and
+// appended to without scanning the events of every other match.
+type MatchFieldEvents struct {
+	Id     int `db:"id,manual"`
+	Events []FieldEvent
+}
+
+// Adds the given events to those already recorded for the given match, in a single write.
+func (database *Database) AppendFieldEvents(matchId int, fieldEvents []FieldEvent) error {
+	if len(fieldEvents) == 0 {
+		return nil
+	}
+	matchFieldEvents, err := database.matchFieldEventsTable.getById(matchId)
+	if err != nil {
+		return err
+	}
+	if matchFieldEvents == nil {
+		return database.matchFieldEventsTable.create(&MatchFieldEvents{Id: matchId, Events: fieldEvents})
+	}
+	matchFieldEvents.Events = append(matchFieldEvents.Events, fieldEvents...)
+	return database.matchFieldEventsTable.update(matchFieldEvents)
+}
+
+// Returns all the field events recorded for the given match, in the order in which they occurred.
+func (database *Database) GetFieldEventsByMatchId(matchId int) ([]FieldEvent, error) {
+	matchFieldEvents, err := database.matchFieldEventsTable.getById(matchId)
+	if err != nil || matchFieldEvents == nil {
+		return nil, err
+	}
+	fieldEvents := matchFieldEvents.Events
+	sort.SliceStable(
+		fieldEvents,
+		func(i, j int) bool {
+			return fieldEvents[i].Time.Before(fieldEvents[j].Time)
+		},
+	)
+	return fieldEvents, nil
+}
+
+func (database *Database) DeleteFieldEventsByMatchId(matchId int) error {
+	matchFieldEvents, err := database.matchFieldEventsTable.getById(matchId)
+	if err != nil || matchFieldEvents == nil {
+		return err
+	}
+	return database.matchFieldEventsTable.delete(matchId)
+}
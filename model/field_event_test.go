@@ -0,0 +1,43 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldEventCrud(t *testing.T) {
+	db := setupTestDb(t)
+	defer db.Close()
+
+	fieldEvent1 := FieldEvent{
+		MatchId:     1,
+		Time:        time.Unix(200, 0).UTC(),
+		Type:        "robotLink",
+		Station:     "R1",
+		TeamId:      254,
+		Description: "Robot link lost",
+	}
+	fieldEvent2 := FieldEvent{MatchId: 1, Time: time.Unix(100, 0).UTC(), Type: "matchState", Description: "Auto"}
+	assert.Nil(t, db.AppendFieldEvents(1, []FieldEvent{fieldEvent1}))
+	assert.Nil(t, db.AppendFieldEvents(1, []FieldEvent{fieldEvent2}))
+	fieldEvent3 := FieldEvent{MatchId: 2, Time: time.Unix(50, 0).UTC(), Type: "matchState", Description: "Auto"}
+	assert.Nil(t, db.AppendFieldEvents(2, []FieldEvent{fieldEvent3}))
+	assert.Nil(t, db.AppendFieldEvents(2, nil))
+
+	fieldEvents, err := db.GetFieldEventsByMatchId(1)
+	assert.Nil(t, err)
+	assert.Equal(t, []FieldEvent{fieldEvent2, fieldEvent1}, fieldEvents)
+
+	assert.Nil(t, db.DeleteFieldEventsByMatchId(1))
+	fieldEvents, err = db.GetFieldEventsByMatchId(1)
+	assert.Nil(t, err)
+	assert.Empty(t, fieldEvents)
+	assert.Nil(t, db.DeleteFieldEventsByMatchId(1))
+	fieldEvents, err = db.GetFieldEventsByMatchId(2)
+	assert.Nil(t, err)
+	assert.Equal(t, []FieldEvent{fieldEvent3}, fieldEvents)
+}
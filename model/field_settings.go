@@ -0,0 +1,98 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Model and datastore CRUD methods for the hardware settings of an additional field, for events that run several
+// fields from one server. The first field uses the hardware settings in the event settings.
+
+package model
+
+import "sort"
+
+// Maximum number of fields that can be run from one server.
+const MaxNumFields = 4
+
+type FieldSettings struct {
+	Id                   int `db:"id,manual"`
+	DriverStationAddress string
+	PlcAddress           string
+	ApAddress            string
+	ApPassword           string
+	ApChannel            int
+	SwitchAddress        string
+	SwitchPassword       string
+	TeamSignRed1Id       int
+	TeamSignRed2Id       int
+	TeamSignRed3Id       int
+	TeamSignRedTimerId   int
+	TeamSignBlue1Id      int
+	TeamSignBlue2Id      int
+	TeamSignBlue3Id      int
+	TeamSignBlueTimerId  int
+}
+
+func (database *Database) CreateFieldSettings(fieldSettings *FieldSettings) error {
+	return database.fieldSettingsTable.create(fieldSettings)
+}
+
+// Returns the hardware settings for the given field number, or nil if none have been saved.
+func (database *Database) GetFieldSettingsById(id int) (*FieldSettings, error) {
+	return database.fieldSettingsTable.getById(id)
+}
+
+func (database *Database) UpdateFieldSettings(fieldSettings *FieldSettings) error {
+	return database.fieldSettingsTable.update(fieldSettings)
+}
+
+func (database *Database) DeleteFieldSettings(id int) error {
+	return database.fieldSettingsTable.delete(id)
+}
+
+func (database *Database) GetAllFieldSettings() ([]FieldSettings, error) {
+	allFieldSettings, err := database.fieldSettingsTable.getAll()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(
+		allFieldSettings,
+		func(i, j int) bool {
+			return allFieldSettings[i].Id < allFieldSettings[j].Id
+		},
+	)
+	return allFieldSettings, nil
+}
+
+// ApplyTo returns a copy of the given event settings with the field's hardware settings in place of those of the first
+// field. Devices that only exist on the first field, such as the SCC and Netgear switches and the recorders, are left
+// unconfigured.
+func (fieldSettings *FieldSettings) ApplyTo(eventSettings *EventSettings) *EventSettings {
+	settings := *eventSettings
+	settings.FieldNetworkAdapter = ""
+	settings.PlcAddress = fieldSettings.PlcAddress
+	settings.ApAddress = fieldSettings.ApAddress
+	settings.ApPassword = fieldSettings.ApPassword
+	settings.ApChannel = fieldSettings.ApChannel
+	settings.SwitchAddress = fieldSettings.SwitchAddress
+	settings.SwitchPassword = fieldSettings.SwitchPassword
+	settings.TeamSignRed1Id = fieldSettings.TeamSignRed1Id
+	settings.TeamSignRed2Id = fieldSettings.TeamSignRed2Id
+	settings.TeamSignRed3Id = fieldSettings.TeamSignRed3Id
+	settings.TeamSignRedTimerId = fieldSettings.TeamSignRedTimerId
+	settings.TeamSignBlue1Id = fieldSettings.TeamSignBlue1Id
+	settings.TeamSignBlue2Id = fieldSettings.TeamSignBlue2Id
+	settings.TeamSignBlue3Id = fieldSettings.TeamSignBlue3Id
+	settings.TeamSignBlueTimerId = fieldSettings.TeamSignBlueTimerId
+	settings.SCCManagementEnabled = false
+	settings.RedSCCAddress = ""
+	settings.BlueSCCAddress = ""
+	settings.CoreSwitchManagementEnabled = false
+	settings.CoreSwitchAddress = ""
+	settings.RedTeamSwitchManagementEnabled = false
+	settings.RedTeamSwitchAddress = ""
+	settings.RedFMSSwitchManagementEnabled = false
+	settings.RedFMSSwitchAddress = ""
+	settings.BlueTeamSwitchManagementEnabled = false
+	settings.BlueTeamSwitchAddress = ""
+	settings.BlueFMSSwitchManagementEnabled = false
+	settings.BlueFMSSwitchAddress = ""
+	settings.BlackmagicAddresses = ""
+	return &settings
+}
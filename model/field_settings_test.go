@@ -0,0 +1,62 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldSettingsCrud(t *testing.T) {
+	database := setupTestDb(t)
+
+	fieldSettings2 := FieldSettings{Id: 2, PlcAddress: "10.0.200.10", ApAddress: "10.0.200.1", ApChannel: 149}
+	assert.Nil(t, database.CreateFieldSettings(&fieldSettings2))
+	fieldSettings3 := FieldSettings{Id: 3, TeamSignRed1Id: 31}
+	assert.Nil(t, database.CreateFieldSettings(&fieldSettings3))
+
+	fieldSettings, err := database.GetFieldSettingsById(2)
+	assert.Nil(t, err)
+	assert.Equal(t, fieldSettings2, *fieldSettings)
+	fieldSettings, err = database.GetFieldSettingsById(4)
+	assert.Nil(t, err)
+	assert.Nil(t, fieldSettings)
+
+	fieldSettings3.SwitchAddress = "10.0.210.61"
+	assert.Nil(t, database.UpdateFieldSettings(&fieldSettings3))
+	allFieldSettings, err := database.GetAllFieldSettings()
+	assert.Nil(t, err)
+	assert.Equal(t, []FieldSettings{fieldSettings2, fieldSettings3}, allFieldSettings)
+
+	assert.Nil(t, database.DeleteFieldSettings(2))
+	allFieldSettings, err = database.GetAllFieldSettings()
+	assert.Nil(t, err)
+	assert.Equal(t, []FieldSettings{fieldSettings3}, allFieldSettings)
+}
+
+func TestFieldSettingsApplyTo(t *testing.T) {
+	eventSettings := &EventSettings{
+		Name:                        "Chezy Champs",
+		PlcAddress:                  "10.0.100.10",
+		ApAddress:                   "10.0.100.1",
+		TeamSignRed1Id:              11,
+		CoreSwitchManagementEnabled: true,
+		CoreSwitchAddress:           "10.0.100.2",
+		TeamsPerAlliance:            3,
+	}
+	fieldSettings := FieldSettings{Id: 2, PlcAddress: "10.0.200.10", ApAddress: "10.0.200.1", TeamSignRed1Id: 21}
+
+	settings := fieldSettings.ApplyTo(eventSettings)
+	assert.Equal(t, "Chezy Champs", settings.Name)
+	assert.Equal(t, 3, settings.TeamsPerAlliance)
+	assert.Equal(t, "10.0.200.10", settings.PlcAddress)
+	assert.Equal(t, "10.0.200.1", settings.ApAddress)
+	assert.Equal(t, 21, settings.TeamSignRed1Id)
+	assert.False(t, settings.CoreSwitchManagementEnabled)
+	assert.Equal(t, "", settings.CoreSwitchAddress)
+
+	// The original settings should be untouched.
+	assert.Equal(t, "10.0.100.10", eventSettings.PlcAddress)
+	assert.True(t, eventSettings.CoreSwitchManagementEnabled)
+}
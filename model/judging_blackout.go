@@ -0,0 +1,47 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Model and datastore CRUD methods for a window of time during which a team cannot be judged.
+
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+type JudgingBlackout struct {
+	Id        int `db:"id"`
+	TeamId    int
+	StartTime time.Time
+	EndTime   time.Time
+	Reason    string
+}
+
+func (database *Database) CreateJudgingBlackout(judgingBlackout *JudgingBlackout) error {
+	return database.judgingBlackoutTable.create(judgingBlackout)
+}
+
+func (database *Database) DeleteJudgingBlackout(id int) error {
+	return database.judgingBlackoutTable.delete(id)
+}
+
+func (database *Database) TruncateJudgingBlackouts() error {
+	return database.judgingBlackoutTable.truncate()
+}
+
+func (database *Database) GetAllJudgingBlackouts() ([]JudgingBlackout, error) {
+	judgingBlackouts, err := database.judgingBlackoutTable.getAll()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(
+		judgingBlackouts,
+		func(i, j int) bool {
+			if judgingBlackouts[i].TeamId != judgingBlackouts[j].TeamId {
+				return judgingBlackouts[i].TeamId < judgingBlackouts[j].TeamId
+			}
+			return judgingBlackouts[i].StartTime.Before(judgingBlackouts[j].StartTime)
+		},
+	)
+	return judgingBlackouts, nil
+}
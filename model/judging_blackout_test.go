@@ -0,0 +1,36 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJudgingBlackoutCrud(t *testing.T) {
+	database := setupTestDb(t)
+
+	blackout1 := JudgingBlackout{0, 254, time.Unix(300, 0).UTC(), time.Unix(400, 0).UTC(), "Volunteering"}
+	assert.Nil(t, database.CreateJudgingBlackout(&blackout1))
+	blackout2 := JudgingBlackout{0, 254, time.Unix(100, 0).UTC(), time.Unix(200, 0).UTC(), "Interview"}
+	assert.Nil(t, database.CreateJudgingBlackout(&blackout2))
+	blackout3 := JudgingBlackout{0, 148, time.Unix(500, 0).UTC(), time.Unix(600, 0).UTC(), ""}
+	assert.Nil(t, database.CreateJudgingBlackout(&blackout3))
+
+	// Check that the blackouts are sorted by team and then by time.
+	blackouts, err := database.GetAllJudgingBlackouts()
+	assert.Nil(t, err)
+	assert.Equal(t, []JudgingBlackout{blackout3, blackout2, blackout1}, blackouts)
+
+	assert.Nil(t, database.DeleteJudgingBlackout(blackout2.Id))
+	blackouts, err = database.GetAllJudgingBlackouts()
+	assert.Nil(t, err)
+	assert.Equal(t, []JudgingBlackout{blackout3, blackout1}, blackouts)
+
+	assert.Nil(t, database.TruncateJudgingBlackouts())
+	blackouts, err = database.GetAllJudgingBlackouts()
+	assert.Nil(t, err)
+	assert.Empty(t, blackouts)
+}
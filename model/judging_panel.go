@@ -0,0 +1,57 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Model and datastore CRUD methods for a panel of judges that visits teams in parallel with the other panels.
+
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+type JudgingPanel struct {
+	Id             int `db:"id"`
+	Name           string
+	Room           string
+	AwardCategory  string
+	AvailableFrom  time.Time
+	AvailableUntil time.Time
+}
+
+func (database *Database) CreateJudgingPanel(judgingPanel *JudgingPanel) error {
+	return database.judgingPanelTable.create(judgingPanel)
+}
+
+func (database *Database) GetJudgingPanelById(id int) (*JudgingPanel, error) {
+	return database.judgingPanelTable.getById(id)
+}
+
+func (database *Database) DeleteJudgingPanel(id int) error {
+	return database.judgingPanelTable.delete(id)
+}
+
+func (database *Database) TruncateJudgingPanels() error {
+	return database.judgingPanelTable.truncate()
+}
+
+func (database *Database) GetAllJudgingPanels() ([]JudgingPanel, error) {
+	judgingPanels, err := database.judgingPanelTable.getAll()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(
+		judgingPanels,
+		func(i, j int) bool {
+			return judgingPanels[i].Id < judgingPanels[j].Id
+		},
+	)
+	return judgingPanels, nil
+}
+
+// Location returns where the panel sees teams; panels without a room of their own visit teams in their pits.
+func (judgingPanel *JudgingPanel) Location() string {
+	if judgingPanel.Room == "" {
+		return "Pits"
+	}
+	return judgingPanel.Room
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJudgingPanelCrud(t *testing.T) {
+	database := setupTestDb(t)
+
+	panel1 := JudgingPanel{
+		Name:           "Panel 1",
+		Room:           "Room A",
+		AwardCategory:  "Impact",
+		AvailableFrom:  time.Unix(100, 0).UTC(),
+		AvailableUntil: time.Unix(200, 0).UTC(),
+	}
+	assert.Nil(t, database.CreateJudgingPanel(&panel1))
+	panel2 := JudgingPanel{Name: "Panel 2"}
+	assert.Nil(t, database.CreateJudgingPanel(&panel2))
+
+	panel, err := database.GetJudgingPanelById(panel1.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, panel1, *panel)
+	panels, err := database.GetAllJudgingPanels()
+	assert.Nil(t, err)
+	assert.Equal(t, []JudgingPanel{panel1, panel2}, panels)
+	assert.Equal(t, "Room A", panels[0].Location())
+	assert.Equal(t, "Pits", panels[1].Location())
+
+	assert.Nil(t, database.DeleteJudgingPanel(panel1.Id))
+	panels, err = database.GetAllJudgingPanels()
+	assert.Nil(t, err)
+	assert.Equal(t, []JudgingPanel{panel2}, panels)
+
+	assert.Nil(t, database.TruncateJudgingPanels())
+	panels, err = database.GetAllJudgingPanels()
+	assert.Nil(t, err)
+	assert.Empty(t, panels)
+}
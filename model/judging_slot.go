@@ -19,6 +19,10 @@ type JudgingSlot struct {
 	NextMatchNumber     int
 	NextMatchTime       time.Time
 	JudgeNumber         int
+	EndTime             time.Time
+	PanelName           string
+	Room                string
+	AwardCategory       string
 }
 
 func (database *Database) CreateJudgingSlot(judgingSlot *JudgingSlot) error {
@@ -37,7 +41,10 @@ func (database *Database) GetAllJudgingSlots() ([]JudgingSlot, error) {
 	sort.Slice(
 		judgingSlots,
 		func(i, j int) bool {
-			return judgingSlots[i].TeamId < judgingSlots[j].TeamId
+			if judgingSlots[i].TeamId != judgingSlots[j].TeamId {
+				return judgingSlots[i].TeamId < judgingSlots[j].TeamId
+			}
+			return judgingSlots[i].Time.Before(judgingSlots[j].Time)
 		},
 	)
 	return judgingSlots, nil
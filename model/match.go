@@ -23,6 +23,15 @@ const (
 	Playoff
 )
 
+const (
+	// Number of teams per alliance in a standard match.
+	DefaultTeamsPerAlliance = 3
+
+	// Range of the number of teams per alliance that can be configured for an event.
+	MinTeamsPerAlliance = 2
+	MaxTeamsPerAlliance = game.MaxRobotsPerAlliance
+)
+
 func (t MatchType) Get() MatchType {
 	return t
 }
@@ -32,9 +41,11 @@ type Match struct {
 	Type                MatchType
 	TypeOrder           int
 	Time                time.Time
+	ProjectedTime       time.Time
 	LongName            string
 	ShortName           string
 	NameDetail          string
+	FieldNumber         int
 	PlayoffMatchGroupId string
 	PlayoffRedAlliance  int
 	PlayoffBlueAlliance int
@@ -44,20 +55,38 @@ type Match struct {
 	Red2IsSurrogate     bool
 	Red3                int
 	Red3IsSurrogate     bool
+	Red4                int
+	Red4IsSurrogate     bool
 	Blue1               int
 	Blue1IsSurrogate    bool
 	Blue2               int
 	Blue2IsSurrogate    bool
 	Blue3               int
 	Blue3IsSurrogate    bool
+	Blue4               int
+	Blue4IsSurrogate    bool
 	StartedAt           time.Time
+	FieldFaultPauses    []FieldFaultPause
 	ScoreCommittedAt    time.Time
 	FieldReadyAt        time.Time
 	Status              game.MatchStatus
 	UseTiebreakCriteria bool
+	IsReplay            bool
+	ReplayOfMatchId     int
+	ReplayMatchId       int
+	ReplayReason        string
 	TbaMatchKey         TbaMatchKey
 }
 
+// A stoppage of a match in progress to deal with a field fault, during which all robots are disabled and the match
+// clock is frozen.
+type FieldFaultPause struct {
+	StartedAt    time.Time
+	MatchTimeSec float64
+	DurationSec  float64
+	Reason       string
+}
+
 type TbaMatchKey struct {
 	CompLevel   string
 	SetNumber   int
@@ -120,10 +149,83 @@ func (database *Database) GetMatchesByType(matchType MatchType, includeHidden bo
 	return matchingMatches, nil
 }
 
+// Returns true if the match may be played on the given field; matches that haven't been assigned to a field may be
+// played on any of them.
+func (match *Match) IsForField(fieldNumber int) bool {
+	return match.FieldNumber == 0 || match.FieldNumber == fieldNumber
+}
+
 func (match *Match) IsComplete() bool {
 	return match.Status == game.RedWonMatch || match.Status == game.BlueWonMatch || match.Status == game.TieMatch
 }
 
+// Returns true if the match has been marked as requiring a replay, such that its result is kept only for the record and
+// the replay's result counts in its place.
+func (match *Match) IsSuperseded() bool {
+	return match.ReplayMatchId > 0
+}
+
+// Returns the teams in each of the red alliance stations, in order and including any that are empty.
+func (match *Match) RedTeamIds() []int {
+	return []int{match.Red1, match.Red2, match.Red3, match.Red4}
+}
+
+// Returns the teams in each of the blue alliance stations, in order and including any that are empty.
+func (match *Match) BlueTeamIds() []int {
+	return []int{match.Blue1, match.Blue2, match.Blue3, match.Blue4}
+}
+
+// Returns the teams in each of the alliance stations, red first and including any that are empty.
+func (match *Match) TeamIds() []int {
+	return append(match.RedTeamIds(), match.BlueTeamIds()...)
+}
+
+// Returns the team assigned to the given alliance station (e.g. "R1"), or zero if there is none.
+func (match *Match) TeamIdForStation(station string) int {
+	if teamId, _ := match.stationFields(station); teamId != nil {
+		return *teamId
+	}
+	return 0
+}
+
+// Returns true if the team assigned to the given alliance station is playing as a surrogate.
+func (match *Match) IsSurrogateForStation(station string) bool {
+	if _, isSurrogate := match.stationFields(station); isSurrogate != nil {
+		return *isSurrogate
+	}
+	return false
+}
+
+// Assigns the given team to the given alliance station (e.g. "R1"). Does nothing if the station doesn't exist.
+func (match *Match) SetTeamForStation(station string, teamId int, isSurrogate bool) {
+	if teamIdField, isSurrogateField := match.stationFields(station); teamIdField != nil {
+		*teamIdField = teamId
+		*isSurrogateField = isSurrogate
+	}
+}
+
+func (match *Match) stationFields(station string) (*int, *bool) {
+	switch station {
+	case "R1":
+		return &match.Red1, &match.Red1IsSurrogate
+	case "R2":
+		return &match.Red2, &match.Red2IsSurrogate
+	case "R3":
+		return &match.Red3, &match.Red3IsSurrogate
+	case "R4":
+		return &match.Red4, &match.Red4IsSurrogate
+	case "B1":
+		return &match.Blue1, &match.Blue1IsSurrogate
+	case "B2":
+		return &match.Blue2, &match.Blue2IsSurrogate
+	case "B3":
+		return &match.Blue3, &match.Blue3IsSurrogate
+	case "B4":
+		return &match.Blue4, &match.Blue4IsSurrogate
+	}
+	return nil, nil
+}
+
 // Returns true if the match is of a type that allows substitution of teams.
 func (match *Match) ShouldAllowSubstitution() bool {
 	return match.Type != Qualification
@@ -164,6 +266,17 @@ func MatchTypeFromString(matchTypeString string) (MatchType, error) {
 	return 0, fmt.Errorf("invalid match type %q", matchTypeString)
 }
 
+// Returns the names of the alliance stations in use for the given number of teams per alliance, red first.
+func AllianceStationNames(teamsPerAlliance int) []string {
+	var stations []string
+	for _, alliance := range []string{"R", "B"} {
+		for i := 1; i <= teamsPerAlliance; i++ {
+			stations = append(stations, fmt.Sprintf("%s%d", alliance, i))
+		}
+	}
+	return stations
+}
+
 // Returns the string equivalent of the given compound match key.
 func (key TbaMatchKey) String() string {
 	if key.SetNumber == 0 {
@@ -29,7 +29,7 @@ func TestMatchResultCrud(t *testing.T) {
 	assert.Equal(t, matchResult, matchResult2)
 
 	matchResult.BlueScore.EndgameStatuses =
-		[3]game.EndgameStatus{game.EndgameParked, game.EndgameNone, game.EndgameShallowCage}
+		[4]game.EndgameStatus{game.EndgameParked, game.EndgameNone, game.EndgameShallowCage}
 	assert.Nil(t, db.UpdateMatchResult(matchResult))
 	matchResult2, err = db.GetMatchResultForMatch(254)
 	assert.Nil(t, err)
@@ -0,0 +1,56 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Model and datastore CRUD methods for a team's place in the open practice match queue.
+
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+type PracticeQueueEntry struct {
+	Id       int `db:"id"`
+	TeamId   int
+	JoinedAt time.Time
+}
+
+func (database *Database) CreatePracticeQueueEntry(entry *PracticeQueueEntry) error {
+	return database.practiceQueueEntryTable.create(entry)
+}
+
+func (database *Database) DeletePracticeQueueEntry(id int) error {
+	return database.practiceQueueEntryTable.delete(id)
+}
+
+func (database *Database) TruncatePracticeQueueEntries() error {
+	return database.practiceQueueEntryTable.truncate()
+}
+
+// Returns all entries in the practice queue, in the order in which the teams joined it.
+func (database *Database) GetAllPracticeQueueEntries() ([]PracticeQueueEntry, error) {
+	entries, err := database.practiceQueueEntryTable.getAll()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(
+		entries,
+		func(i, j int) bool {
+			return entries[i].Id < entries[j].Id
+		},
+	)
+	return entries, nil
+}
+
+func (database *Database) GetPracticeQueueEntryByTeamId(teamId int) (*PracticeQueueEntry, error) {
+	entries, err := database.practiceQueueEntryTable.getAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.TeamId == teamId {
+			return &entry, nil
+		}
+	}
+	return nil, nil
+}
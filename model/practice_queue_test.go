@@ -0,0 +1,41 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPracticeQueueEntryCrud(t *testing.T) {
+	db := setupTestDb(t)
+	defer db.Close()
+
+	entry1 := PracticeQueueEntry{TeamId: 254, JoinedAt: time.Unix(100, 0).UTC()}
+	assert.Nil(t, db.CreatePracticeQueueEntry(&entry1))
+	entry2 := PracticeQueueEntry{TeamId: 1114, JoinedAt: time.Unix(200, 0).UTC()}
+	assert.Nil(t, db.CreatePracticeQueueEntry(&entry2))
+
+	entries, err := db.GetAllPracticeQueueEntries()
+	assert.Nil(t, err)
+	assert.Equal(t, []PracticeQueueEntry{entry1, entry2}, entries)
+
+	entry, err := db.GetPracticeQueueEntryByTeamId(1114)
+	assert.Nil(t, err)
+	assert.Equal(t, entry2, *entry)
+	entry, err = db.GetPracticeQueueEntryByTeamId(846)
+	assert.Nil(t, err)
+	assert.Nil(t, entry)
+
+	assert.Nil(t, db.DeletePracticeQueueEntry(entry1.Id))
+	entries, err = db.GetAllPracticeQueueEntries()
+	assert.Nil(t, err)
+	assert.Equal(t, []PracticeQueueEntry{entry2}, entries)
+
+	assert.Nil(t, db.TruncatePracticeQueueEntries())
+	entries, err = db.GetAllPracticeQueueEntries()
+	assert.Nil(t, err)
+	assert.Empty(t, entries)
+}
@@ -11,11 +11,18 @@ import (
 )
 
 type ScheduleBlock struct {
-	Id              int `db:"id"`
-	MatchType       MatchType
-	StartTime       time.Time
-	NumMatches      int
-	MatchSpacingSec int
+	Id               int `db:"id"`
+	MatchType        MatchType
+	StartTime        time.Time
+	NumMatches       int
+	MatchSpacingSec  int
+	DayIndex         int
+	BreakDescription string
+}
+
+// EndTime returns the time at which the last match in the block is done and the next one would have started.
+func (block *ScheduleBlock) EndTime() time.Time {
+	return block.StartTime.Add(time.Duration(block.NumMatches*block.MatchSpacingSec) * time.Second)
 }
 
 func (database *Database) CreateScheduleBlock(block *ScheduleBlock) error {
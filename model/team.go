@@ -5,7 +5,10 @@
 
 package model
 
-import "sort"
+import (
+	"sort"
+	"time"
+)
 
 type Team struct {
 	Id              int `db:"id,manual"`
@@ -22,6 +25,25 @@ type Team struct {
 	YellowCard      bool
 	HasConnected    bool
 	FtaNotes        string
+	// Window of time within which the team is able to play scheduled matches; a zero value means no constraint.
+	NotAvailableBefore time.Time
+	NotAvailableAfter  time.Time
+}
+
+// HasAvailabilityConstraints returns true if the team is unavailable for any part of the event.
+func (team *Team) HasAvailabilityConstraints() bool {
+	return !team.NotAvailableBefore.IsZero() || !team.NotAvailableAfter.IsZero()
+}
+
+// IsAvailableBetween returns true if the team is able to play in matches spanning the given start and end times.
+func (team *Team) IsAvailableBetween(start, end time.Time) bool {
+	if !team.NotAvailableBefore.IsZero() && start.Before(team.NotAvailableBefore) {
+		return false
+	}
+	if !team.NotAvailableAfter.IsZero() && end.After(team.NotAvailableAfter) {
+		return false
+	}
+	return true
 }
 
 func (database *Database) CreateTeam(team *Team) error {
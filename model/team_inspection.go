@@ -0,0 +1,70 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Model and datastore CRUD methods for the progress of a team through robot inspection.
+
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+type TeamInspection struct {
+	Id          int `db:"id,manual"`
+	PassedItems []string
+	Inspector   string
+	Notes       string
+	UpdatedAt   time.Time
+}
+
+// Returns true if the team has passed every item on the given checklist.
+func (inspection *TeamInspection) IsPassed(checklistItems []string) bool {
+	return len(inspection.MissingItems(checklistItems)) == 0
+}
+
+// Returns the items on the given checklist that the team has not yet passed, in checklist order.
+func (inspection *TeamInspection) MissingItems(checklistItems []string) []string {
+	passedItems := make(map[string]struct{}, len(inspection.PassedItems))
+	for _, item := range inspection.PassedItems {
+		passedItems[item] = struct{}{}
+	}
+	var missingItems []string
+	for _, item := range checklistItems {
+		if _, ok := passedItems[item]; !ok {
+			missingItems = append(missingItems, item)
+		}
+	}
+	return missingItems
+}
+
+func (database *Database) CreateTeamInspection(inspection *TeamInspection) error {
+	return database.teamInspectionTable.create(inspection)
+}
+
+func (database *Database) GetTeamInspectionById(teamId int) (*TeamInspection, error) {
+	return database.teamInspectionTable.getById(teamId)
+}
+
+func (database *Database) UpdateTeamInspection(inspection *TeamInspection) error {
+	return database.teamInspectionTable.update(inspection)
+}
+
+func (database *Database) DeleteTeamInspection(teamId int) error {
+	return database.teamInspectionTable.delete(teamId)
+}
+
+func (database *Database) TruncateTeamInspections() error {
+	return database.teamInspectionTable.truncate()
+}
+
+// Returns the inspection records of all teams that have started inspection, sorted by team number.
+func (database *Database) GetAllTeamInspections() ([]TeamInspection, error) {
+	inspections, err := database.teamInspectionTable.getAll()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(inspections, func(i, j int) bool {
+		return inspections[i].Id < inspections[j].Id
+	})
+	return inspections, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNonexistentTeamInspection(t *testing.T) {
+	db := setupTestDb(t)
+	defer db.Close()
+
+	inspection, err := db.GetTeamInspectionById(254)
+	assert.Nil(t, err)
+	assert.Nil(t, inspection)
+}
+
+func TestTeamInspectionCrud(t *testing.T) {
+	db := setupTestDb(t)
+	defer db.Close()
+
+	inspection := TeamInspection{
+		Id:          254,
+		PassedItems: []string{"Weight", "Bumpers"},
+		Inspector:   "Inspector Gadget",
+		UpdatedAt:   time.Unix(1000, 0).UTC(),
+	}
+	assert.Nil(t, db.CreateTeamInspection(&inspection))
+	inspection2, err := db.GetTeamInspectionById(254)
+	assert.Nil(t, err)
+	assert.Equal(t, inspection, *inspection2)
+
+	inspection.PassedItems = append(inspection.PassedItems, "Radio firmware")
+	inspection.Notes = "Reweigh after adding the climber"
+	assert.Nil(t, db.UpdateTeamInspection(&inspection))
+	inspection2, err = db.GetTeamInspectionById(254)
+	assert.Nil(t, err)
+	assert.Equal(t, inspection, *inspection2)
+
+	assert.Nil(t, db.DeleteTeamInspection(inspection.Id))
+	inspection2, err = db.GetTeamInspectionById(254)
+	assert.Nil(t, err)
+	assert.Nil(t, inspection2)
+}
+
+func TestGetAllTeamInspections(t *testing.T) {
+	db := setupTestDb(t)
+	defer db.Close()
+
+	inspections, err := db.GetAllTeamInspections()
+	assert.Nil(t, err)
+	assert.Empty(t, inspections)
+
+	assert.Nil(t, db.CreateTeamInspection(&TeamInspection{Id: 1114}))
+	assert.Nil(t, db.CreateTeamInspection(&TeamInspection{Id: 254}))
+	inspections, err = db.GetAllTeamInspections()
+	assert.Nil(t, err)
+	if assert.Equal(t, 2, len(inspections)) {
+		assert.Equal(t, 254, inspections[0].Id)
+		assert.Equal(t, 1114, inspections[1].Id)
+	}
+
+	assert.Nil(t, db.TruncateTeamInspections())
+	inspections, err = db.GetAllTeamInspections()
+	assert.Nil(t, err)
+	assert.Empty(t, inspections)
+}
+
+func TestTeamInspectionIsPassed(t *testing.T) {
+	checklistItems := []string{"Weight", "Bumpers", "Radio firmware"}
+	inspection := TeamInspection{Id: 254}
+	assert.False(t, inspection.IsPassed(checklistItems))
+	assert.Equal(t, checklistItems, inspection.MissingItems(checklistItems))
+
+	inspection.PassedItems = []string{"Radio firmware", "Weight", "Retired item"}
+	assert.False(t, inspection.IsPassed(checklistItems))
+	assert.Equal(t, []string{"Bumpers"}, inspection.MissingItems(checklistItems))
+
+	inspection.PassedItems = append(inspection.PassedItems, "Bumpers")
+	assert.True(t, inspection.IsPassed(checklistItems))
+	assert.Empty(t, inspection.MissingItems(checklistItems))
+
+	// An empty checklist is passed by every team.
+	assert.True(t, (&TeamInspection{}).IsPassed(nil))
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Model and datastore CRUD methods for the progress of a team through queueing for one of its matches.
+
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// QueueStatus represents how far a team has progressed through queueing for a match.
+type QueueStatus int
+
+const (
+	QueueNotCalled QueueStatus = iota
+	QueueCalled
+	QueueArrived
+	QueueOnDeck
+	QueueAtField
+)
+
+var queueStatusNames = map[QueueStatus]string{
+	QueueNotCalled: "Not Called",
+	QueueCalled:    "Called",
+	QueueArrived:   "Arrived",
+	QueueOnDeck:    "On Deck",
+	QueueAtField:   "At Field",
+}
+
+func (status QueueStatus) String() string {
+	return queueStatusNames[status]
+}
+
+type TeamQueueStatus struct {
+	Id        int `db:"id"`
+	MatchId   int
+	TeamId    int
+	Status    QueueStatus
+	CalledAt  time.Time
+	ArrivedAt time.Time
+	OnDeckAt  time.Time
+	AtFieldAt time.Time
+}
+
+// SetStatus moves the team to the given queue status, recording the time at which it first reached that status.
+func (queueStatus *TeamQueueStatus) SetStatus(status QueueStatus, now time.Time) {
+	queueStatus.Status = status
+	for _, reached := range []struct {
+		status QueueStatus
+		time   *time.Time
+	}{
+		{QueueCalled, &queueStatus.CalledAt},
+		{QueueArrived, &queueStatus.ArrivedAt},
+		{QueueOnDeck, &queueStatus.OnDeckAt},
+		{QueueAtField, &queueStatus.AtFieldAt},
+	} {
+		if status >= reached.status && reached.time.IsZero() {
+			*reached.time = now
+		}
+	}
+}
+
+func (database *Database) CreateTeamQueueStatus(queueStatus *TeamQueueStatus) error {
+	return database.teamQueueStatusTable.create(queueStatus)
+}
+
+func (database *Database) UpdateTeamQueueStatus(queueStatus *TeamQueueStatus) error {
+	return database.teamQueueStatusTable.update(queueStatus)
+}
+
+// Deletes the queue statuses of all teams for the given match.
+func (database *Database) DeleteTeamQueueStatusesByMatchId(matchId int) error {
+	queueStatuses, err := database.GetTeamQueueStatusesByMatchId(matchId)
+	if err != nil {
+		return err
+	}
+	for _, queueStatus := range queueStatuses {
+		if err = database.teamQueueStatusTable.delete(queueStatus.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the queue status of the given team for the given match, or nil if the team hasn't been called for it yet.
+func (database *Database) GetTeamQueueStatus(matchId, teamId int) (*TeamQueueStatus, error) {
+	queueStatuses, err := database.teamQueueStatusTable.getAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, queueStatus := range queueStatuses {
+		if queueStatus.MatchId == matchId && queueStatus.TeamId == teamId {
+			return &queueStatus, nil
+		}
+	}
+	return nil, nil
+}
+
+// Returns the queue statuses of all teams for the given match, sorted by team number.
+func (database *Database) GetTeamQueueStatusesByMatchId(matchId int) ([]TeamQueueStatus, error) {
+	queueStatuses, err := database.teamQueueStatusTable.getAll()
+	if err != nil {
+		return nil, err
+	}
+	var matchingQueueStatuses []TeamQueueStatus
+	for _, queueStatus := range queueStatuses {
+		if queueStatus.MatchId == matchId {
+			matchingQueueStatuses = append(matchingQueueStatuses, queueStatus)
+		}
+	}
+	sort.Slice(
+		matchingQueueStatuses,
+		func(i, j int) bool {
+			return matchingQueueStatuses[i].TeamId < matchingQueueStatuses[j].TeamId
+		},
+	)
+	return matchingQueueStatuses, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamQueueStatusCrud(t *testing.T) {
+	db := setupTestDb(t)
+	defer db.Close()
+
+	queueStatus1 := TeamQueueStatus{MatchId: 1, TeamId: 1114}
+	queueStatus1.SetStatus(QueueCalled, time.Unix(100, 0).UTC())
+	assert.Nil(t, db.CreateTeamQueueStatus(&queueStatus1))
+	queueStatus2 := TeamQueueStatus{MatchId: 1, TeamId: 254}
+	assert.Nil(t, db.CreateTeamQueueStatus(&queueStatus2))
+	queueStatus3 := TeamQueueStatus{MatchId: 2, TeamId: 254}
+	assert.Nil(t, db.CreateTeamQueueStatus(&queueStatus3))
+
+	queueStatuses, err := db.GetTeamQueueStatusesByMatchId(1)
+	assert.Nil(t, err)
+	assert.Equal(t, []TeamQueueStatus{queueStatus2, queueStatus1}, queueStatuses)
+
+	queueStatus, err := db.GetTeamQueueStatus(1, 1114)
+	assert.Nil(t, err)
+	assert.Equal(t, queueStatus1, *queueStatus)
+	queueStatus, err = db.GetTeamQueueStatus(2, 1114)
+	assert.Nil(t, err)
+	assert.Nil(t, queueStatus)
+
+	// Check that skipping straight to a later status records the times of the ones in between.
+	queueStatus1.SetStatus(QueueOnDeck, time.Unix(200, 0).UTC())
+	assert.Nil(t, db.UpdateTeamQueueStatus(&queueStatus1))
+	queueStatus, err = db.GetTeamQueueStatus(1, 1114)
+	assert.Nil(t, err)
+	assert.Equal(t, QueueOnDeck, queueStatus.Status)
+	assert.Equal(t, "On Deck", queueStatus.Status.String())
+	assert.Equal(t, time.Unix(100, 0).UTC(), queueStatus.CalledAt)
+	assert.Equal(t, time.Unix(200, 0).UTC(), queueStatus.ArrivedAt)
+	assert.Equal(t, time.Unix(200, 0).UTC(), queueStatus.OnDeckAt)
+	assert.True(t, queueStatus.AtFieldAt.IsZero())
+
+	assert.Nil(t, db.DeleteTeamQueueStatusesByMatchId(1))
+	queueStatuses, err = db.GetTeamQueueStatusesByMatchId(1)
+	assert.Nil(t, err)
+	assert.Empty(t, queueStatuses)
+	queueStatuses, err = db.GetTeamQueueStatusesByMatchId(2)
+	assert.Nil(t, err)
+	assert.Equal(t, []TeamQueueStatus{queueStatus3}, queueStatuses)
+}
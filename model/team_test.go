@@ -6,6 +6,7 @@ package model
 import (
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestGetNonexistentTeam(t *testing.T) {
@@ -88,3 +89,22 @@ func TestGetAllTeams(t *testing.T) {
 		assert.Equal(t, i+1, teams[i].Id)
 	}
 }
+
+func TestTeamAvailability(t *testing.T) {
+	team := Team{Id: 254}
+	assert.False(t, team.HasAvailabilityConstraints())
+	assert.True(t, team.IsAvailableBetween(time.Unix(0, 0), time.Unix(1000, 0)))
+
+	team.NotAvailableBefore = time.Unix(100, 0)
+	assert.True(t, team.HasAvailabilityConstraints())
+	assert.False(t, team.IsAvailableBetween(time.Unix(99, 0), time.Unix(1000, 0)))
+	assert.True(t, team.IsAvailableBetween(time.Unix(100, 0), time.Unix(1000, 0)))
+
+	team.NotAvailableAfter = time.Unix(500, 0)
+	assert.False(t, team.IsAvailableBetween(time.Unix(100, 0), time.Unix(501, 0)))
+	assert.True(t, team.IsAvailableBetween(time.Unix(100, 0), time.Unix(500, 0)))
+
+	team.NotAvailableBefore = time.Time{}
+	assert.True(t, team.HasAvailabilityConstraints())
+	assert.True(t, team.IsAvailableBetween(time.Unix(0, 0), time.Unix(500, 0)))
+}
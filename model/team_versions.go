@@ -0,0 +1,87 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Model and datastore CRUD methods for the software versions last reported for each team's driver station and robot.
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+type TeamVersions struct {
+	Id                   int `db:"id,manual"`
+	DsVersion            string
+	RioVersion           string
+	WpilibVersion        string
+	RadioFirmwareVersion string
+	UpdatedAt            time.Time
+}
+
+// Returns a description of each reported version that isn't one of those allowed by the given event settings. Versions
+// that haven't been reported, and kinds of version for which no allowed values are configured, are not checked.
+func (versions *TeamVersions) ComplianceIssues(eventSettings *EventSettings) []string {
+	var issues []string
+	for _, check := range []struct {
+		name          string
+		version       string
+		allowedValues string
+	}{
+		{"DS", versions.DsVersion, eventSettings.AllowedDsVersions},
+		{"roboRIO image", versions.RioVersion, eventSettings.AllowedRioVersions},
+		{"radio firmware", versions.RadioFirmwareVersion, eventSettings.AllowedRadioFirmwareVersions},
+	} {
+		if check.version == "" || isVersionAllowed(check.version, check.allowedValues) {
+			continue
+		}
+		issues = append(issues, fmt.Sprintf("%s version %s is not allowed", check.name, check.version))
+	}
+	return issues
+}
+
+func (database *Database) CreateTeamVersions(versions *TeamVersions) error {
+	return database.teamVersionsTable.create(versions)
+}
+
+func (database *Database) GetTeamVersionsById(teamId int) (*TeamVersions, error) {
+	return database.teamVersionsTable.getById(teamId)
+}
+
+func (database *Database) UpdateTeamVersions(versions *TeamVersions) error {
+	return database.teamVersionsTable.update(versions)
+}
+
+func (database *Database) DeleteTeamVersions(teamId int) error {
+	return database.teamVersionsTable.delete(teamId)
+}
+
+func (database *Database) TruncateTeamVersions() error {
+	return database.teamVersionsTable.truncate()
+}
+
+// Returns the versions last reported by all teams that have connected, sorted by team number.
+func (database *Database) GetAllTeamVersions() ([]TeamVersions, error) {
+	allVersions, err := database.teamVersionsTable.getAll()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(allVersions, func(i, j int) bool {
+		return allVersions[i].Id < allVersions[j].Id
+	})
+	return allVersions, nil
+}
+
+// Returns true if the given version is one of the given comma-separated allowed values, or if there are none.
+func isVersionAllowed(version, allowedValues string) bool {
+	if strings.TrimSpace(allowedValues) == "" {
+		return true
+	}
+	for _, allowedValue := range strings.Split(allowedValues, ",") {
+		if strings.TrimSpace(allowedValue) == version {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNonexistentTeamVersions(t *testing.T) {
+	db := setupTestDb(t)
+	defer db.Close()
+
+	versions, err := db.GetTeamVersionsById(254)
+	assert.Nil(t, err)
+	assert.Nil(t, versions)
+}
+
+func TestTeamVersionsCrud(t *testing.T) {
+	db := setupTestDb(t)
+	defer db.Close()
+
+	versions := TeamVersions{Id: 254, DsVersion: "25.0", UpdatedAt: time.Unix(1000, 0).UTC()}
+	assert.Nil(t, db.CreateTeamVersions(&versions))
+	versions2, err := db.GetTeamVersionsById(254)
+	assert.Nil(t, err)
+	assert.Equal(t, versions, *versions2)
+
+	versions.RioVersion = "FRC_roboRIO2_2025_v2.0"
+	versions.RadioFirmwareVersion = "1.2.0"
+	assert.Nil(t, db.UpdateTeamVersions(&versions))
+	versions2, err = db.GetTeamVersionsById(254)
+	assert.Nil(t, err)
+	assert.Equal(t, versions, *versions2)
+
+	assert.Nil(t, db.CreateTeamVersions(&TeamVersions{Id: 148}))
+	allVersions, err := db.GetAllTeamVersions()
+	assert.Nil(t, err)
+	if assert.Equal(t, 2, len(allVersions)) {
+		assert.Equal(t, 148, allVersions[0].Id)
+		assert.Equal(t, 254, allVersions[1].Id)
+	}
+
+	assert.Nil(t, db.DeleteTeamVersions(254))
+	versions2, err = db.GetTeamVersionsById(254)
+	assert.Nil(t, err)
+	assert.Nil(t, versions2)
+
+	assert.Nil(t, db.TruncateTeamVersions())
+	allVersions, err = db.GetAllTeamVersions()
+	assert.Nil(t, err)
+	assert.Empty(t, allVersions)
+}
+
+func TestTeamVersionsComplianceIssues(t *testing.T) {
+	versions := TeamVersions{Id: 254, DsVersion: "24.0", RioVersion: "FRC_roboRIO2_2025_v2.0"}
+
+	// Check that nothing is flagged when no allowed versions are configured.
+	eventSettings := EventSettings{}
+	assert.Empty(t, versions.ComplianceIssues(&eventSettings))
+
+	eventSettings.AllowedDsVersions = "25.0, 25.1"
+	eventSettings.AllowedRioVersions = "FRC_roboRIO2_2025_v2.0"
+	eventSettings.AllowedRadioFirmwareVersions = "1.2.0"
+	assert.Equal(t, []string{"DS version 24.0 is not allowed"}, versions.ComplianceIssues(&eventSettings))
+
+	versions.DsVersion = "25.1"
+	assert.Empty(t, versions.ComplianceIssues(&eventSettings))
+
+	versions.RioVersion = "FRC_roboRIO_2024_v1.0"
+	versions.RadioFirmwareVersion = "1.1.0"
+	assert.Equal(
+		t,
+		[]string{
+			"roboRIO image version FRC_roboRIO_2024_v1.0 is not allowed",
+			"radio firmware version 1.1.0 is not allowed",
+		},
+		versions.ComplianceIssues(&eventSettings),
+	)
+}
@@ -36,6 +36,6 @@ func BuildTestMatchResult(matchId int, playNumber int) *MatchResult {
 }
 
 func BuildTestAlliances(database *Database) {
-	database.CreateAlliance(&Alliance{Id: 2, TeamIds: []int{1718, 2451, 1619}, Lineup: [3]int{2451, 1718, 1619}})
-	database.CreateAlliance(&Alliance{Id: 1, TeamIds: []int{254, 469, 2848, 74, 3175}, Lineup: [3]int{469, 254, 2848}})
+	database.CreateAlliance(&Alliance{Id: 2, TeamIds: []int{1718, 2451, 1619}, Lineup: []int{2451, 1718, 1619}})
+	database.CreateAlliance(&Alliance{Id: 1, TeamIds: []int{254, 469, 2848, 74, 3175}, Lineup: []int{469, 254, 2848}})
 }
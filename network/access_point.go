@@ -33,13 +33,14 @@ type AccessPoint struct {
 }
 
 type TeamWifiStatus struct {
-	TeamId            int
-	RadioLinked       bool
-	MBits             float64
-	RxRate            float64
-	TxRate            float64
-	SignalNoiseRatio  int
-	ConnectionQuality int
+	TeamId               int
+	RadioLinked          bool
+	MBits                float64
+	RxRate               float64
+	TxRate               float64
+	SignalNoiseRatio     int
+	ConnectionQuality    int
+	RadioFirmwareVersion string
 }
 
 type configurationRequest struct {
@@ -68,6 +69,9 @@ type stationStatus struct {
 	SignalNoiseRatio  int     `json:"signalNoiseRatio"`
 	BandwidthUsedMbps float64 `json:"bandwidthUsedMbps"`
 	ConnectionQuality string  `json:"connectionQuality"`
+
+	// Firmware version of the team's robot radio, if the access point is able to report it; empty otherwise.
+	RadioFirmwareVersion string `json:"radioFirmwareVersion"`
 }
 
 var connectionQualityMap = map[string]int{
@@ -247,6 +251,7 @@ func updateTeamWifiStatus(teamWifiStatus *TeamWifiStatus, stationStatus *station
 		teamWifiStatus.TxRate = 0
 		teamWifiStatus.SignalNoiseRatio = 0
 		teamWifiStatus.ConnectionQuality = 0
+		teamWifiStatus.RadioFirmwareVersion = ""
 	} else {
 		teamWifiStatus.TeamId, _ = strconv.Atoi(stationStatus.Ssid)
 		teamWifiStatus.RadioLinked = stationStatus.IsLinked
@@ -254,6 +259,7 @@ func updateTeamWifiStatus(teamWifiStatus *TeamWifiStatus, stationStatus *station
 		teamWifiStatus.RxRate = stationStatus.RxRateMbps
 		teamWifiStatus.TxRate = stationStatus.TxRateMbps
 		teamWifiStatus.SignalNoiseRatio = stationStatus.SignalNoiseRatio
+		teamWifiStatus.RadioFirmwareVersion = stationStatus.RadioFirmwareVersion
 		if quality, ok := connectionQualityMap[stationStatus.ConnectionQuality]; ok {
 			teamWifiStatus.ConnectionQuality = quality
 		} else {
@@ -96,12 +96,12 @@ func TestAccessPoint_updateMonitoring(t *testing.T) {
 		Channel: 456,
 		Status:  "ACTIVE",
 		StationStatuses: map[string]*stationStatus{
-			"red1":  {"254", "hash111", "salt1", true, 1, 2, 3, 4, "excellent"},
-			"red2":  {"1114", "hash222", "salt2", false, 5, 6, 7, 8, ""},
-			"red3":  {"469", "hash333", "salt3", true, 9, 10, 11, 12, "caution"},
-			"blue1": {"2046", "hash444", "salt4", false, 13, 14, 15, 16, "warning"},
-			"blue2": {"2056", "hash555", "salt5", true, 17, 18, 19, 20, "nonexistent"},
-			"blue3": {"1678", "hash666", "salt6", false, 21, 22, 23, 24, "good"},
+			"red1":  {"254", "hash111", "salt1", true, 1, 2, 3, 4, "excellent", "1.2.0"},
+			"red2":  {"1114", "hash222", "salt2", false, 5, 6, 7, 8, "", ""},
+			"red3":  {"469", "hash333", "salt3", true, 9, 10, 11, 12, "caution", ""},
+			"blue1": {"2046", "hash444", "salt4", false, 13, 14, 15, 16, "warning", ""},
+			"blue2": {"2056", "hash555", "salt5", true, 17, 18, 19, 20, "nonexistent", ""},
+			"blue3": {"1678", "hash666", "salt6", false, 21, 22, 23, 24, "good", ""},
 		},
 	}
 
@@ -121,30 +121,30 @@ func TestAccessPoint_updateMonitoring(t *testing.T) {
 	assert.Nil(t, ap.updateMonitoring())
 	assert.Equal(t, 123, ap.channel) // Should not have changed to reflect the radio API.
 	assert.Equal(t, "ACTIVE", ap.Status)
-	assert.Equal(t, TeamWifiStatus{254, true, 4, 1, 2, 3, 4}, *wifiStatuses[0])
-	assert.Equal(t, TeamWifiStatus{1114, false, 8, 5, 6, 7, 0}, *wifiStatuses[1])
-	assert.Equal(t, TeamWifiStatus{469, true, 12, 9, 10, 11, 1}, *wifiStatuses[2])
-	assert.Equal(t, TeamWifiStatus{2046, false, 16, 13, 14, 15, 2}, *wifiStatuses[3])
-	assert.Equal(t, TeamWifiStatus{2056, true, 20, 17, 18, 19, 0}, *wifiStatuses[4])
-	assert.Equal(t, TeamWifiStatus{1678, false, 24, 21, 22, 23, 3}, *wifiStatuses[5])
+	assert.Equal(t, TeamWifiStatus{254, true, 4, 1, 2, 3, 4, "1.2.0"}, *wifiStatuses[0])
+	assert.Equal(t, TeamWifiStatus{1114, false, 8, 5, 6, 7, 0, ""}, *wifiStatuses[1])
+	assert.Equal(t, TeamWifiStatus{469, true, 12, 9, 10, 11, 1, ""}, *wifiStatuses[2])
+	assert.Equal(t, TeamWifiStatus{2046, false, 16, 13, 14, 15, 2, ""}, *wifiStatuses[3])
+	assert.Equal(t, TeamWifiStatus{2056, true, 20, 17, 18, 19, 0, ""}, *wifiStatuses[4])
+	assert.Equal(t, TeamWifiStatus{1678, false, 24, 21, 22, 23, 3, ""}, *wifiStatuses[5])
 
 	// Only some stations assigned.
 	apStatus.Status = "CONFIGURING"
 	apStatus.StationStatuses = map[string]*stationStatus{
 		"red1":  nil,
 		"red2":  nil,
-		"red3":  {"469", "hash333", "salt3", true, 9, 10, 11, 12, "caution"},
+		"red3":  {"469", "hash333", "salt3", true, 9, 10, 11, 12, "caution", ""},
 		"blue1": nil,
-		"blue2": {"2056", "hash555", "salt5", true, 17, 18, 19, 20, "excellent"},
+		"blue2": {"2056", "hash555", "salt5", true, 17, 18, 19, 20, "excellent", ""},
 		"blue3": nil,
 	}
 	assert.Nil(t, ap.updateMonitoring())
 	assert.Equal(t, "CONFIGURING", ap.Status)
 	assert.Equal(t, TeamWifiStatus{}, *wifiStatuses[0])
 	assert.Equal(t, TeamWifiStatus{}, *wifiStatuses[1])
-	assert.Equal(t, TeamWifiStatus{469, true, 12, 9, 10, 11, 1}, *wifiStatuses[2])
+	assert.Equal(t, TeamWifiStatus{469, true, 12, 9, 10, 11, 1, ""}, *wifiStatuses[2])
 	assert.Equal(t, TeamWifiStatus{}, *wifiStatuses[3])
-	assert.Equal(t, TeamWifiStatus{2056, true, 20, 17, 18, 19, 4}, *wifiStatuses[4])
+	assert.Equal(t, TeamWifiStatus{2056, true, 20, 17, 18, 19, 4, ""}, *wifiStatuses[4])
 	assert.Equal(t, TeamWifiStatus{}, *wifiStatuses[5])
 
 	// Radio API returns an error.
@@ -335,7 +335,13 @@ func (client *TbaClient) PublishMatches(database *model.Database) error {
 	if err != nil {
 		return err
 	}
-	matches := append(qualMatches, playoffMatches...)
+	var matches []model.Match
+	for _, match := range append(qualMatches, playoffMatches...) {
+		// A replayed match shares its TBA key with its replay, which is published in its place.
+		if !match.IsSuperseded() {
+			matches = append(matches, match)
+		}
+	}
 	tbaMatches := make([]TbaMatch, len(matches))
 
 	// Build a JSON array of TBA-format matches.
@@ -363,14 +369,14 @@ func (client *TbaClient) PublishMatches(database *model.Database) error {
 		}
 		alliances := make(map[string]*TbaAlliance)
 		alliances["red"] = createTbaAlliance(
-			[3]int{match.Red1, match.Red2, match.Red3},
-			[3]bool{match.Red1IsSurrogate, match.Red2IsSurrogate, match.Red3IsSurrogate},
+			match.RedTeamIds(),
+			[]bool{match.Red1IsSurrogate, match.Red2IsSurrogate, match.Red3IsSurrogate, match.Red4IsSurrogate},
 			redScore,
 			redCards,
 		)
 		alliances["blue"] = createTbaAlliance(
-			[3]int{match.Blue1, match.Blue2, match.Blue3},
-			[3]bool{match.Blue1IsSurrogate, match.Blue2IsSurrogate, match.Blue3IsSurrogate},
+			match.BlueTeamIds(),
+			[]bool{match.Blue1IsSurrogate, match.Blue2IsSurrogate, match.Blue3IsSurrogate, match.Blue4IsSurrogate},
 			blueScore,
 			blueCards,
 		)
@@ -606,7 +612,7 @@ func (client *TbaClient) postRequest(resource string, action string, body []byte
 	return response, err
 }
 
-func createTbaAlliance(teamIds [3]int, surrogates [3]bool, score *int, cards map[string]string) *TbaAlliance {
+func createTbaAlliance(teamIds []int, surrogates []bool, score *int, cards map[string]string) *TbaAlliance {
 	alliance := TbaAlliance{Teams: []string{}, Surrogates: []string{}, Dqs: []string{}, Score: score}
 	for i, teamId := range teamIds {
 		if teamId == 0 {
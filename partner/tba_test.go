@@ -60,8 +60,17 @@ func TestPublishMatches(t *testing.T) {
 		TbaMatchKey: model.TbaMatchKey{"qm", 0, 2},
 	}
 	match2 := model.Match{Type: model.Playoff, ShortName: "SF2-2", TbaMatchKey: model.TbaMatchKey{"omg", 5, 29}}
+	// A match superseded by a replay should be left out in favor of the replay, which shares its TBA key.
+	match3 := model.Match{
+		Type:          model.Qualification,
+		ShortName:     "Q1",
+		Status:        game.BlueWonMatch,
+		ReplayMatchId: 1000,
+		TbaMatchKey:   model.TbaMatchKey{"qm", 0, 1},
+	}
 	database.CreateMatch(&match1)
 	database.CreateMatch(&match2)
+	database.CreateMatch(&match3)
 	matchResult1 := model.BuildTestMatchResult(match1.Id, 1)
 	database.CreateMatchResult(matchResult1)
 
@@ -7,6 +7,14 @@ package playoff
 
 import "fmt"
 
+// AllianceSourceDetails describes where the alliance filling a given spot comes from, for consumers outside of this
+// package. Exactly one of AllianceSelectionId or MatchGroupId is set.
+type AllianceSourceDetails struct {
+	AllianceSelectionId int
+	MatchGroupId        string
+	UseWinner           bool
+}
+
 type allianceSource interface {
 	// AllianceId returns the alliance number that will fill this spot, or zero if it is not yet determined.
 	AllianceId() int
@@ -14,6 +22,9 @@ type allianceSource interface {
 	// displayName returns a human-readable name for the source of this alliance.
 	displayName() string
 
+	// details returns a structured description of the source of this alliance.
+	details() AllianceSourceDetails
+
 	// setDestination passes back the match group filled by this alliance source to the source.
 	setDestination(destination MatchGroup)
 
@@ -38,6 +49,10 @@ func (source allianceSelectionSource) displayName() string {
 	return fmt.Sprintf("A %d", source.allianceId)
 }
 
+func (source allianceSelectionSource) details() AllianceSourceDetails {
+	return AllianceSourceDetails{AllianceSelectionId: source.allianceId}
+}
+
 func (source allianceSelectionSource) setDestination(destination MatchGroup) {
 	// Do nothing as there are no child match groups.
 }
@@ -72,6 +87,10 @@ func (source matchupSource) displayName() string {
 	return "L " + source.matchup.Id()
 }
 
+func (source matchupSource) details() AllianceSourceDetails {
+	return AllianceSourceDetails{MatchGroupId: source.matchup.Id(), UseWinner: source.useWinner}
+}
+
 func (source matchupSource) setDestination(destination MatchGroup) {
 	if source.useWinner {
 		source.matchup.winningAllianceDestination = destination
@@ -35,6 +35,7 @@ type matchSpec struct {
 	durationSec         int
 	useTiebreakCriteria bool
 	isHidden            bool
+	isReplay            bool
 	tbaMatchKey         model.TbaMatchKey
 	redAllianceId       int
 	blueAllianceId      int
@@ -25,6 +25,8 @@ type Matchup struct {
 	NumMatchesPlayed           int
 	winningAllianceDestination MatchGroup
 	losingAllianceDestination  MatchGroup
+	replayRequired             bool
+	replayPending              bool
 }
 
 func (matchup *Matchup) Id() string {
@@ -84,6 +86,17 @@ func (matchup *Matchup) update(playoffMatchResults map[int]playoffMatchResult) {
 			match.isHidden = true
 		}
 	}
+
+	// A matchup that has run out of matches without producing a winner (i.e. ties that the tiebreakers couldn't resolve)
+	// needs another match to be added.
+	matchup.replayRequired = !matchup.IsComplete() && len(unplayedMatches) == 0 && matchup.RedAllianceId > 0 &&
+		matchup.BlueAllianceId > 0
+	matchup.replayPending = matchup.replayRequired
+	for _, match := range unplayedMatches {
+		if match.isReplay && !match.isHidden {
+			matchup.replayPending = true
+		}
+	}
 }
 
 // setSourceDestinations recursively sets the destination of the alliance sources to this matchup.
@@ -117,6 +130,28 @@ func (matchup *Matchup) BlueAllianceSourceDisplayName() string {
 	return matchup.blueAllianceSource.displayName()
 }
 
+// RedAllianceSourceDetails returns a structured description of where the red alliance is populated from.
+func (matchup *Matchup) RedAllianceSourceDetails() AllianceSourceDetails {
+	return matchup.redAllianceSource.details()
+}
+
+// BlueAllianceSourceDetails returns a structured description of where the blue alliance is populated from.
+func (matchup *Matchup) BlueAllianceSourceDetails() AllianceSourceDetails {
+	return matchup.blueAllianceSource.details()
+}
+
+// Round returns the one-based column in which the matchup appears when the bracket is drawn from left to right, i.e.
+// one more than the latest round of the matchups that feed into it.
+func (matchup *Matchup) Round() int {
+	round := 1
+	for _, source := range []allianceSource{matchup.redAllianceSource, matchup.blueAllianceSource} {
+		if source, ok := source.(matchupSource); ok {
+			round = max(round, source.matchup.Round()+1)
+		}
+	}
+	return round
+}
+
 // RedAllianceDestination returns a string representing the red alliance's next destination in the tournament.
 func (matchup *Matchup) RedAllianceDestination() string {
 	return matchup.allianceDestination(matchup.RedAllianceId)
@@ -127,6 +162,19 @@ func (matchup *Matchup) BlueAllianceDestination() string {
 	return matchup.allianceDestination(matchup.BlueAllianceId)
 }
 
+// DestinationIds returns the IDs of the match groups that the winning and losing alliances advance to, or empty
+// strings if there is no such destination.
+func (matchup *Matchup) DestinationIds() (string, string) {
+	var winningDestinationId, losingDestinationId string
+	if matchup.winningAllianceDestination != nil {
+		winningDestinationId = matchup.winningAllianceDestination.Id()
+	}
+	if matchup.losingAllianceDestination != nil {
+		losingDestinationId = matchup.losingAllianceDestination.Id()
+	}
+	return winningDestinationId, losingDestinationId
+}
+
 // StatusText returns a pair of strings indicating the leading alliance and a readable status of the matchup.
 func (matchup *Matchup) StatusText() (string, string) {
 	var leader, status string
@@ -146,6 +194,8 @@ func (matchup *Matchup) StatusText() (string, string) {
 	} else if matchup.BlueAllianceWins > matchup.RedAllianceWins {
 		leader = "blue"
 		status = fmt.Sprintf("Blue Leads %d-%d", matchup.BlueAllianceWins, matchup.RedAllianceWins)
+	} else if matchup.replayPending {
+		status = "Replay Required"
 	} else if matchup.RedAllianceWins > 0 {
 		status = fmt.Sprintf("Series Tied %d-%d", matchup.RedAllianceWins, matchup.BlueAllianceWins)
 	}
@@ -38,6 +38,35 @@ func TestMatchupAllianceSourceDisplayNames(t *testing.T) {
 	assert.Equal(t, "W QF2", sf1.BlueAllianceSourceDisplayName())
 }
 
+func TestMatchupBracketStructure(t *testing.T) {
+	matchup, _, err := newDoubleEliminationBracket(8)
+	assert.Nil(t, err)
+	matchGroups, err := collectMatchGroups(matchup)
+	assert.Nil(t, err)
+	matchup.setSourceDestinations()
+
+	m1 := matchGroups["M1"].(*Matchup)
+	assert.Equal(t, AllianceSourceDetails{AllianceSelectionId: 1}, m1.RedAllianceSourceDetails())
+	assert.Equal(t, AllianceSourceDetails{AllianceSelectionId: 8}, m1.BlueAllianceSourceDetails())
+	assert.Equal(t, 1, m1.Round())
+	winningDestinationId, losingDestinationId := m1.DestinationIds()
+	assert.Equal(t, "M7", winningDestinationId)
+	assert.Equal(t, "M5", losingDestinationId)
+
+	m13 := matchGroups["M13"].(*Matchup)
+	assert.Equal(t, AllianceSourceDetails{MatchGroupId: "M11", UseWinner: false}, m13.RedAllianceSourceDetails())
+	assert.Equal(t, AllianceSourceDetails{MatchGroupId: "M12", UseWinner: true}, m13.BlueAllianceSourceDetails())
+	assert.Equal(t, 5, m13.Round())
+	winningDestinationId, losingDestinationId = m13.DestinationIds()
+	assert.Equal(t, "F", winningDestinationId)
+	assert.Equal(t, "", losingDestinationId)
+
+	assert.Equal(t, 6, matchup.Round())
+	winningDestinationId, losingDestinationId = matchup.DestinationIds()
+	assert.Equal(t, "", winningDestinationId)
+	assert.Equal(t, "", losingDestinationId)
+}
+
 func TestMatchupStatusText(t *testing.T) {
 	matchup := Matchup{NumWinsToAdvance: 1}
 
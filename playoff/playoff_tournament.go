@@ -192,16 +192,33 @@ func (tournament *PlayoffTournament) UpdateMatches(database *model.Database) err
 		return fmt.Errorf("cannot update playoff matches; no matches exist")
 	}
 
-	playoffMatchResults := make(map[int]playoffMatchResult)
-	for _, match := range matches {
-		switch match.Status {
-		case game.RedWonMatch, game.BlueWonMatch, game.TieMatch:
-			playoffMatchResults[match.TypeOrder] = playoffMatchResult{status: match.Status}
+	if err = tournament.restoreReplays(matches); err != nil {
+		return err
+	}
+	tournament.finalMatchup.update(collectPlayoffMatchResults(matches))
+
+	// Schedule a replay for any matchup that has ended in a tie that couldn't be broken.
+	replaysScheduled := false
+	err = tournament.Traverse(
+		func(matchGroup MatchGroup) error {
+			if matchup, ok := matchGroup.(*Matchup); ok && matchup.replayRequired {
+				replaysScheduled = true
+				return tournament.scheduleReplay(database, matchup)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if replaysScheduled {
+		// Reload the matches since they will have been renumbered to make room for the replays.
+		if matches, err = database.GetMatchesByType(model.Playoff, true); err != nil {
+			return err
 		}
+		tournament.finalMatchup.update(collectPlayoffMatchResults(matches))
 	}
 
-	tournament.finalMatchup.update(playoffMatchResults)
-
 	// Update all unplayed matches to assign any alliances that have been newly populated into or removed from matches.
 	matchesByTypeOrder := make(map[int]*model.Match)
 	for i, match := range matches {
@@ -250,16 +267,34 @@ func (tournament *PlayoffTournament) UpdateMatches(database *model.Database) err
 	return nil
 }
 
-// Assigns the lineup from the alliance into the red team slots for the match.
+// Returns the results of the completed matches in the given list, keyed by type order.
+func collectPlayoffMatchResults(matches []model.Match) map[int]playoffMatchResult {
+	playoffMatchResults := make(map[int]playoffMatchResult)
+	for _, match := range matches {
+		switch match.Status {
+		case game.RedWonMatch, game.BlueWonMatch, game.TieMatch:
+			playoffMatchResults[match.TypeOrder] = playoffMatchResult{status: match.Status}
+		}
+	}
+	return playoffMatchResults
+}
+
+// Assigns the lineup from the alliance into the red team slots for the match, leaving any slots beyond it empty.
 func positionRedTeams(match *model.Match, alliance *model.Alliance) {
-	match.Red1 = alliance.Lineup[0]
-	match.Red2 = alliance.Lineup[1]
-	match.Red3 = alliance.Lineup[2]
+	positionTeams(match, "R", alliance.Lineup)
 }
 
-// Assigns the lineup from the alliance into the blue team slots for the match.
+// Assigns the lineup from the alliance into the blue team slots for the match, leaving any slots beyond it empty.
 func positionBlueTeams(match *model.Match, alliance *model.Alliance) {
-	match.Blue1 = alliance.Lineup[0]
-	match.Blue2 = alliance.Lineup[1]
-	match.Blue3 = alliance.Lineup[2]
+	positionTeams(match, "B", alliance.Lineup)
+}
+
+func positionTeams(match *model.Match, stationPrefix string, lineup []int) {
+	for i := 0; i < model.MaxTeamsPerAlliance; i++ {
+		teamId := 0
+		if i < len(lineup) {
+			teamId = lineup[i]
+		}
+		match.SetTeamForStation(fmt.Sprintf("%s%d", stationPrefix, i+1), teamId, false)
+	}
 }
@@ -129,6 +129,48 @@ func TestPlayoffTournamentCreateMatchesAndBreaks(t *testing.T) {
 	}
 }
 
+func TestPlayoffTournamentTeamsPerAlliance(t *testing.T) {
+	for _, teamsPerAlliance := range []int{2, 4} {
+		database := setupTestDb(t)
+		for i := 1; i <= 2; i++ {
+			teamIds := []int{100*i + 1, 100*i + 2, 100*i + 3, 100*i + 4}
+			alliance := model.Alliance{
+				Id: i, TeamIds: teamIds, Lineup: model.InitialAllianceLineup(teamIds, teamsPerAlliance),
+			}
+			assert.Nil(t, database.CreateAlliance(&alliance))
+		}
+		playoffTournament, err := NewPlayoffTournament(model.SingleEliminationPlayoff, 2)
+		assert.Nil(t, err)
+		assert.Nil(t, playoffTournament.CreateMatchesAndBreaks(database, time.Unix(0, 0)))
+
+		// Every station in use should be filled from the lineup, and those beyond it left empty.
+		matches, _ := database.GetMatchesByType(model.Playoff, true)
+		if assert.NotEmpty(t, matches) {
+			expectedRedTeamIds := []int{102, 101, 0, 0}
+			expectedBlueTeamIds := []int{202, 201, 0, 0}
+			if teamsPerAlliance == 4 {
+				expectedRedTeamIds = []int{102, 101, 103, 104}
+				expectedBlueTeamIds = []int{202, 201, 203, 204}
+			}
+			assert.Equal(t, expectedRedTeamIds, matches[0].RedTeamIds())
+			assert.Equal(t, expectedBlueTeamIds, matches[0].BlueTeamIds())
+		}
+
+		// A substitution recorded against the alliance should carry through to the following matches.
+		lineup := make([]int, teamsPerAlliance)
+		copy(lineup, []int{104, 101, 103, 102})
+		assert.Nil(t, database.UpdateAllianceFromMatch(1, lineup))
+		matches[0].Status = game.RedWonMatch
+		assert.Nil(t, database.UpdateMatch(&matches[0]))
+		assert.Nil(t, playoffTournament.UpdateMatches(database))
+		matches, _ = database.GetMatchesByType(model.Playoff, true)
+		assert.Equal(t, lineup, matches[1].RedTeamIds()[:teamsPerAlliance])
+		for _, teamId := range matches[1].RedTeamIds()[teamsPerAlliance:] {
+			assert.Equal(t, 0, teamId)
+		}
+	}
+}
+
 func TestPlayoffTournamentUpdateMatches(t *testing.T) {
 	database := setupTestDb(t)
 	tournament.CreateTestAlliances(database, 4)
@@ -155,9 +197,9 @@ func TestPlayoffTournamentUpdateMatches(t *testing.T) {
 	matches[0].Status = game.BlueWonMatch
 	err = database.UpdateMatch(&matches[0])
 	assert.Nil(t, err)
-	err = database.UpdateAllianceFromMatch(1, [3]int{103, 102, 101})
+	err = database.UpdateAllianceFromMatch(1, []int{103, 102, 101})
 	assert.Nil(t, err)
-	err = database.UpdateAllianceFromMatch(4, [3]int{404, 405, 406})
+	err = database.UpdateAllianceFromMatch(4, []int{404, 405, 406})
 	assert.Nil(t, err)
 
 	err = playoffTournament.UpdateMatches(database)
@@ -194,7 +236,7 @@ func TestPlayoffTournamentUpdateMatches(t *testing.T) {
 	matches[3].Status = game.BlueWonMatch
 	err = database.UpdateMatch(&matches[3])
 	assert.Nil(t, err)
-	err = database.UpdateAllianceFromMatch(4, [3]int{403, 402, 406})
+	err = database.UpdateAllianceFromMatch(4, []int{403, 402, 406})
 	assert.Nil(t, err)
 
 	err = playoffTournament.UpdateMatches(database)
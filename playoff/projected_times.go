@@ -0,0 +1,100 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Logic for re-timing the remaining playoff matches based on how the tournament is actually running.
+
+package playoff
+
+import (
+	"time"
+
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// UpdateProjectedTimes recalculates the projected start time of each unplayed playoff match, based on when the matches
+// played so far actually started, the scheduled breaks, and the minimum turnaround time that each alliance must be
+// given between the end of one match and the start of its next. Projected times are never earlier than the published
+// schedule, so that teams are never called to the field before they were told to expect it.
+func (tournament *PlayoffTournament) UpdateProjectedTimes(
+	database *model.Database, currentTime time.Time, minTurnaroundSec int,
+) error {
+	matches, err := database.GetMatchesByType(model.Playoff, true)
+	if err != nil {
+		return err
+	}
+	matchesByTypeOrder := make(map[int]*model.Match)
+	for i, match := range matches {
+		matchesByTypeOrder[match.TypeOrder] = &matches[i]
+	}
+	scheduledBreaks, err := database.GetScheduledBreaksByMatchType(model.Playoff)
+	if err != nil {
+		return err
+	}
+	scheduledBreaksByTypeOrder := make(map[int]model.ScheduledBreak)
+	for _, scheduledBreak := range scheduledBreaks {
+		scheduledBreaksByTypeOrder[scheduledBreak.TypeOrderBefore] = scheduledBreak
+	}
+
+	// Track the earliest time at which each alliance can next take the field.
+	allianceReadyTimes := make(map[int]time.Time)
+	updateAllianceReadyTimes := func(match *model.Match, startTime time.Time) {
+		readyTime := turnaroundReadyTime(startTime, minTurnaroundSec)
+		if match.PlayoffRedAlliance > 0 {
+			allianceReadyTimes[match.PlayoffRedAlliance] = readyTime
+		}
+		if match.PlayoffBlueAlliance > 0 {
+			allianceReadyTimes[match.PlayoffBlueAlliance] = readyTime
+		}
+	}
+
+	var nextEventTime time.Time
+	for _, spec := range tournament.matchSpecs {
+		match, ok := matchesByTypeOrder[spec.order]
+		if !ok || match.Status == game.MatchHidden {
+			continue
+		}
+
+		var startTime time.Time
+		if !match.StartedAt.IsZero() {
+			// The match has already been started; use its actual start time as the basis for subsequent matches.
+			startTime = match.StartedAt
+		} else if match.IsComplete() {
+			// The match was played without its start being recorded (e.g. entered via match review).
+			startTime = match.Time
+		} else {
+			if scheduledBreak, ok := scheduledBreaksByTypeOrder[spec.order]; ok {
+				nextEventTime = latestTime(nextEventTime, currentTime).
+					Add(time.Duration(scheduledBreak.DurationSec) * time.Second)
+			}
+			startTime = latestTime(
+				nextEventTime,
+				currentTime,
+				match.Time,
+				allianceReadyTimes[match.PlayoffRedAlliance],
+				allianceReadyTimes[match.PlayoffBlueAlliance],
+			)
+			if !startTime.Equal(match.ProjectedTime) {
+				match.ProjectedTime = startTime
+				if err = database.UpdateMatch(match); err != nil {
+					return err
+				}
+			}
+		}
+
+		updateAllianceReadyTimes(match, startTime)
+		nextEventTime = startTime.Add(time.Duration(spec.durationSec) * time.Second)
+	}
+
+	return nil
+}
+
+// latestTime returns the latest of the given times.
+func latestTime(times ...time.Time) time.Time {
+	var latest time.Time
+	for _, t := range times {
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
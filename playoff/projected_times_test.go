@@ -0,0 +1,72 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package playoff
+
+import (
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/Team254/cheesy-arena/tournament"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPlayoffTournamentUpdateProjectedTimes(t *testing.T) {
+	database := setupTestDb(t)
+	tournament.CreateTestAlliances(database, 8)
+
+	playoffTournament, err := NewPlayoffTournament(model.DoubleEliminationPlayoff, 8)
+	assert.Nil(t, err)
+	assert.Nil(t, playoffTournament.CreateMatchesAndBreaks(database, time.Unix(5000, 0)))
+
+	// Projected times should match the schedule when the tournament hasn't started yet and is running on time.
+	assert.Nil(t, playoffTournament.UpdateProjectedTimes(database, time.Unix(4000, 0), 480))
+	matches, _ := database.GetMatchesByType(model.Playoff, true)
+	assert.Equal(t, int64(5000), matches[0].ProjectedTime.Unix())
+	assert.Equal(t, int64(5540), matches[1].ProjectedTime.Unix())
+	assert.Equal(t, int64(9440), matches[8].ProjectedTime.Unix())
+	assert.True(t, matches[17].ProjectedTime.IsZero())
+
+	// Run the first match ten minutes late and check that the subsequent matches are pushed back.
+	matches[0].StartedAt = time.Unix(5600, 0)
+	matches[0].Status = game.RedWonMatch
+	assert.Nil(t, database.UpdateMatch(&matches[0]))
+	assert.Nil(t, playoffTournament.UpdateMatches(database))
+	assert.Nil(t, playoffTournament.UpdateProjectedTimes(database, time.Unix(5900, 0), 480))
+	matches, _ = database.GetMatchesByType(model.Playoff, true)
+	assert.Equal(t, int64(6140), matches[1].ProjectedTime.Unix())
+	assert.Equal(t, int64(6680), matches[2].ProjectedTime.Unix())
+	assert.Equal(t, int64(7220), matches[3].ProjectedTime.Unix())
+	assert.Equal(t, int64(7760), matches[4].ProjectedTime.Unix())
+
+	// Check that the scheduled break before match 9 is accounted for.
+	assert.Equal(t, int64(9380), matches[7].ProjectedTime.Unix())
+	assert.Equal(t, int64(9440+600), matches[8].ProjectedTime.Unix())
+
+	// Check that the current time is respected when a match hasn't been started yet.
+	assert.Nil(t, playoffTournament.UpdateProjectedTimes(database, time.Unix(7000, 0), 480))
+	matches, _ = database.GetMatchesByType(model.Playoff, true)
+	assert.Equal(t, int64(7000), matches[1].ProjectedTime.Unix())
+	assert.Equal(t, int64(7540), matches[2].ProjectedTime.Unix())
+}
+
+func TestPlayoffTournamentUpdateProjectedTimesTurnaround(t *testing.T) {
+	database := setupTestDb(t)
+	tournament.CreateTestAlliances(database, 2)
+
+	playoffTournament, err := NewPlayoffTournament(model.SingleEliminationPlayoff, 2)
+	assert.Nil(t, err)
+	assert.Nil(t, playoffTournament.CreateMatchesAndBreaks(database, time.Unix(1000, 0)))
+	matches, _ := database.GetMatchesByType(model.Playoff, true)
+	matches[0].StartedAt = time.Unix(1000, 0)
+	matches[0].Status = game.RedWonMatch
+	assert.Nil(t, database.UpdateMatch(&matches[0]))
+	assert.Nil(t, playoffTournament.UpdateMatches(database))
+
+	// Require a longer turnaround than the scheduled spacing and check that the next match is pushed back.
+	turnaroundSec := 1200
+	assert.Nil(t, playoffTournament.UpdateProjectedTimes(database, time.Unix(1200, 0), turnaroundSec))
+	matches, _ = database.GetMatchesByType(model.Playoff, true)
+	expectedTime := time.Unix(1000, 0).Add(game.GetDurationToTeleopEnd() + time.Duration(turnaroundSec)*time.Second)
+	assert.Equal(t, expectedTime.Unix(), matches[1].ProjectedTime.Unix())
+}
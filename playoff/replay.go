@@ -0,0 +1,225 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Logic for scheduling replays of playoff matches that end in a tie which the tiebreakers are unable to resolve.
+
+package playoff
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// restoreReplays adds the match specs for any replays that were previously scheduled and saved to the database but
+// which are not yet known to this instance of the tournament (e.g. after it has been reconstructed upon startup). The
+// given matches must be sorted by type order.
+func (tournament *PlayoffTournament) restoreReplays(matches []model.Match) error {
+	for _, match := range matches {
+		if !match.IsReplay || tournament.hasReplaySpec(match.TypeOrder) {
+			continue
+		}
+		matchup, ok := tournament.matchGroups[match.PlayoffMatchGroupId].(*Matchup)
+		if !ok {
+			return fmt.Errorf(
+				"cannot restore replay %s; matchup %q does not exist", match.ShortName, match.PlayoffMatchGroupId,
+			)
+		}
+
+		// Replays are restored in order of play so that each one lands in the same position it was originally inserted.
+		tournament.shiftSpecOrders(match.TypeOrder)
+		tournament.addSpec(
+			matchup,
+			&matchSpec{
+				longName:            match.LongName,
+				shortName:           match.ShortName,
+				nameDetail:          match.NameDetail,
+				order:               match.TypeOrder,
+				durationSec:         matchup.lastMatchSpec().durationSec,
+				useTiebreakCriteria: match.UseTiebreakCriteria,
+				isReplay:            true,
+				tbaMatchKey:         match.TbaMatchKey,
+			},
+		)
+	}
+	return nil
+}
+
+// scheduleReplay creates a replay match for the given matchup, which has run out of matches without a winner. The
+// replay is inserted before the first match that depends on the outcome of the matchup, and all subsequent matches and
+// breaks are renumbered to make room for it.
+func (tournament *PlayoffTournament) scheduleReplay(database *model.Database, matchup *Matchup) error {
+	lastSpec := matchup.lastMatchSpec()
+	tiedMatch, err := database.GetMatchByTypeOrder(model.Playoff, lastSpec.order)
+	if err != nil {
+		return err
+	}
+	if tiedMatch == nil {
+		return fmt.Errorf("cannot schedule replay; match with order %d does not exist", lastSpec.order)
+	}
+
+	// Name the replay after the last originally scheduled match in the matchup.
+	var originalSpec *matchSpec
+	numReplays := 0
+	maxTbaMatchNumber := 0
+	for _, spec := range matchup.matchSpecs {
+		if spec.isReplay {
+			numReplays++
+		} else {
+			originalSpec = spec
+		}
+		maxTbaMatchNumber = max(maxTbaMatchNumber, spec.tbaMatchKey.MatchNumber)
+	}
+	longName := originalSpec.longName + " Replay"
+	shortName := originalSpec.shortName + "R"
+	if numReplays > 0 {
+		longName += fmt.Sprintf(" %d", numReplays+1)
+		shortName += fmt.Sprintf("%d", numReplays+1)
+	}
+
+	order := tournament.replayOrder(matchup)
+	if err = tournament.shiftMatchOrders(database, order); err != nil {
+		return err
+	}
+	spec := &matchSpec{
+		longName:            longName,
+		shortName:           shortName,
+		nameDetail:          originalSpec.nameDetail,
+		order:               order,
+		durationSec:         lastSpec.durationSec,
+		useTiebreakCriteria: lastSpec.useTiebreakCriteria,
+		isReplay:            true,
+		tbaMatchKey: model.TbaMatchKey{
+			CompLevel:   lastSpec.tbaMatchKey.CompLevel,
+			SetNumber:   lastSpec.tbaMatchKey.SetNumber,
+			MatchNumber: maxTbaMatchNumber + 1,
+		},
+		redAllianceId:  matchup.RedAllianceId,
+		blueAllianceId: matchup.BlueAllianceId,
+	}
+	tournament.addSpec(matchup, spec)
+
+	tiedMatchStartTime := tiedMatch.StartedAt
+	if tiedMatchStartTime.IsZero() {
+		tiedMatchStartTime = tiedMatch.Time
+	}
+	replay := model.Match{
+		Type:                model.Playoff,
+		TypeOrder:           spec.order,
+		Time:                tiedMatchStartTime.Add(time.Duration(lastSpec.durationSec) * time.Second),
+		LongName:            spec.longName,
+		ShortName:           spec.shortName,
+		NameDetail:          spec.nameDetail,
+		PlayoffMatchGroupId: spec.matchGroupId,
+		PlayoffRedAlliance:  spec.redAllianceId,
+		PlayoffBlueAlliance: spec.blueAllianceId,
+		Status:              game.MatchScheduled,
+		UseTiebreakCriteria: spec.useTiebreakCriteria,
+		IsReplay:            true,
+		TbaMatchKey:         spec.tbaMatchKey,
+	}
+	return database.CreateMatch(&replay)
+}
+
+// replayOrder returns the order at which a replay for the given matchup should be played: just before the earliest
+// match that the winner or loser feeds into, or at the end of the tournament if there is none.
+func (tournament *PlayoffTournament) replayOrder(matchup *Matchup) int {
+	order := 0
+	for _, destination := range []MatchGroup{matchup.winningAllianceDestination, matchup.losingAllianceDestination} {
+		if destination == nil {
+			continue
+		}
+		for _, spec := range destination.MatchSpecs() {
+			if order == 0 || spec.order < order {
+				order = spec.order
+			}
+		}
+	}
+	if order == 0 {
+		order = tournament.matchSpecs[len(tournament.matchSpecs)-1].order + 1
+	}
+	return order
+}
+
+// shiftMatchOrders renumbers the in-memory specs as well as the saved matches and breaks at or after the given order
+// to make room for a match to be inserted there.
+func (tournament *PlayoffTournament) shiftMatchOrders(database *model.Database, order int) error {
+	tournament.shiftSpecOrders(order)
+
+	matches, err := database.GetMatchesByType(model.Playoff, true)
+	if err != nil {
+		return err
+	}
+	for _, match := range matches {
+		if match.TypeOrder >= order {
+			match.TypeOrder++
+			if err = database.UpdateMatch(&match); err != nil {
+				return err
+			}
+		}
+	}
+
+	scheduledBreaks, err := database.GetScheduledBreaksByMatchType(model.Playoff)
+	if err != nil {
+		return err
+	}
+	for _, scheduledBreak := range scheduledBreaks {
+		if scheduledBreak.TypeOrderBefore >= order {
+			scheduledBreak.TypeOrderBefore++
+			if err = database.UpdateScheduledBreak(&scheduledBreak); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// shiftSpecOrders renumbers the in-memory match and break specs at or after the given order.
+func (tournament *PlayoffTournament) shiftSpecOrders(order int) {
+	for _, spec := range tournament.matchSpecs {
+		if spec.order >= order {
+			spec.order++
+		}
+	}
+	for i := range tournament.breakSpecs {
+		if tournament.breakSpecs[i].orderBefore >= order {
+			tournament.breakSpecs[i].orderBefore++
+		}
+	}
+}
+
+// addSpec adds the given match spec to both the matchup and the tournament, keeping the latter in order of play.
+func (tournament *PlayoffTournament) addSpec(matchup *Matchup, spec *matchSpec) {
+	spec.matchGroupId = matchup.id
+	matchup.matchSpecs = append(matchup.matchSpecs, spec)
+	tournament.matchSpecs = append(tournament.matchSpecs, spec)
+	sort.Slice(
+		tournament.matchSpecs,
+		func(i, j int) bool {
+			return tournament.matchSpecs[i].order < tournament.matchSpecs[j].order
+		},
+	)
+}
+
+// lastMatchSpec returns the spec for the last match in the matchup, in order of play.
+func (matchup *Matchup) lastMatchSpec() *matchSpec {
+	lastSpec := matchup.matchSpecs[0]
+	for _, spec := range matchup.matchSpecs {
+		if spec.order > lastSpec.order {
+			lastSpec = spec
+		}
+	}
+	return lastSpec
+}
+
+// hasReplaySpec returns true if the tournament already contains a replay spec with the given order.
+func (tournament *PlayoffTournament) hasReplaySpec(order int) bool {
+	for _, spec := range tournament.matchSpecs {
+		if spec.isReplay && spec.order == order {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package playoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/Team254/cheesy-arena/tournament"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlayoffTournamentScheduleReplay(t *testing.T) {
+	database := setupTestDb(t)
+	tournament.CreateTestAlliances(database, 8)
+	playoffTournament, err := NewPlayoffTournament(model.DoubleEliminationPlayoff, 8)
+	assert.Nil(t, err)
+	assert.Nil(t, playoffTournament.CreateMatchesAndBreaks(database, time.Unix(5000, 0)))
+
+	// Tie Match 1 and check that a replay is inserted ahead of the first match that depends on it.
+	match, _ := database.GetMatchByTypeOrder(model.Playoff, 1)
+	match.StartedAt = time.Unix(5100, 0)
+	match.Status = game.TieMatch
+	assert.Nil(t, database.UpdateMatch(match))
+	assert.Nil(t, playoffTournament.UpdateMatches(database))
+	matches, _ := database.GetMatchesByType(model.Playoff, true)
+	if assert.Equal(t, 20, len(matches)) {
+		assertMatch(t, matches[4], 5, 5640, "Match 1 Replay", "M1R", "Round 1 Upper", "M1", 1, 8, true, "sf", 1, 2)
+		assert.True(t, matches[4].IsReplay)
+		assert.Equal(t, game.MatchScheduled, matches[4].Status)
+		assertMatch(t, matches[5], 6, 7160, "Match 5", "M5", "Round 2 Lower", "M5", 0, 0, true, "sf", 5, 1)
+		assertMatch(t, matches[19], 20, 17480, "Overtime 3", "O3", "", "F", 0, 0, true, "f", 1, 6)
+	}
+	scheduledBreaks, _ := database.GetScheduledBreaksByMatchType(model.Playoff)
+	if assert.Equal(t, 6, len(scheduledBreaks)) {
+		assert.Equal(t, 10, scheduledBreaks[0].TypeOrderBefore)
+		assert.Equal(t, 17, scheduledBreaks[5].TypeOrderBefore)
+	}
+	_, status := playoffTournament.MatchGroups()["M1"].(*Matchup).StatusText()
+	assert.Equal(t, "Replay Required", status)
+
+	// Updating again shouldn't schedule another replay.
+	assert.Nil(t, playoffTournament.UpdateMatches(database))
+	matches, _ = database.GetMatchesByType(model.Playoff, true)
+	assert.Equal(t, 20, len(matches))
+
+	// Tie the replay too and check that the next replay is numbered accordingly.
+	matches[4].Status = game.TieMatch
+	assert.Nil(t, database.UpdateMatch(&matches[4]))
+	assert.Nil(t, playoffTournament.UpdateMatches(database))
+	matches, _ = database.GetMatchesByType(model.Playoff, true)
+	if assert.Equal(t, 21, len(matches)) {
+		assertMatch(t, matches[5], 6, 6180, "Match 1 Replay 2", "M1R2", "Round 1 Upper", "M1", 1, 8, true, "sf", 1, 3)
+		assertMatch(t, matches[6], 7, 7160, "Match 5", "M5", "Round 2 Lower", "M5", 0, 0, true, "sf", 5, 1)
+	}
+
+	// Check that a reconstructed tournament picks up the existing replays without scheduling new ones.
+	playoffTournament, err = NewPlayoffTournament(model.DoubleEliminationPlayoff, 8)
+	assert.Nil(t, err)
+	assert.Nil(t, playoffTournament.UpdateMatches(database))
+	matches, _ = database.GetMatchesByType(model.Playoff, true)
+	assert.Equal(t, 21, len(matches))
+	m1 := playoffTournament.MatchGroups()["M1"].(*Matchup)
+	if assert.Equal(t, 3, len(m1.matchSpecs)) {
+		assert.Equal(t, 5, m1.matchSpecs[1].order)
+		assert.Equal(t, 6, m1.matchSpecs[2].order)
+	}
+	assert.Equal(t, 7, playoffTournament.MatchGroups()["M5"].MatchSpecs()[0].order)
+
+	// Resolve the matchup with the second replay and check that the alliances advance.
+	matches[5].Status = game.BlueWonMatch
+	assert.Nil(t, database.UpdateMatch(&matches[5]))
+	assert.Nil(t, playoffTournament.UpdateMatches(database))
+	_, status = m1.StatusText()
+	assert.Equal(t, "Blue Advances 1-0", status)
+	matches, _ = database.GetMatchesByType(model.Playoff, true)
+	assert.Equal(t, 21, len(matches))
+	assert.Equal(t, 1, matches[6].PlayoffRedAlliance)
+}
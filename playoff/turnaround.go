@@ -0,0 +1,45 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Logic for enforcing a minimum rest period for each alliance between consecutive playoff matches.
+
+package playoff
+
+import (
+	"time"
+
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// AllianceReadyTime returns the earliest time at which the given alliance may start its next playoff match, given the
+// minimum turnaround time it must be allowed between the end of its most recently started match and the start of the
+// next. The match with the given ID is ignored so that a match being replayed doesn't count against itself. Returns the
+// zero time if the alliance hasn't played yet.
+func AllianceReadyTime(
+	database *model.Database, allianceId, excludeMatchId, minTurnaroundSec int,
+) (time.Time, error) {
+	var readyTime time.Time
+	if allianceId == 0 {
+		return readyTime, nil
+	}
+
+	matches, err := database.GetMatchesByType(model.Playoff, false)
+	if err != nil {
+		return readyTime, err
+	}
+	for _, match := range matches {
+		if match.Id == excludeMatchId || match.StartedAt.IsZero() {
+			continue
+		}
+		if match.PlayoffRedAlliance == allianceId || match.PlayoffBlueAlliance == allianceId {
+			readyTime = latestTime(readyTime, turnaroundReadyTime(match.StartedAt, minTurnaroundSec))
+		}
+	}
+	return readyTime, nil
+}
+
+// turnaroundReadyTime returns the time at which an alliance may take the field again after a match started at the
+// given time.
+func turnaroundReadyTime(startTime time.Time, minTurnaroundSec int) time.Time {
+	return startTime.Add(game.GetDurationToTeleopEnd() + time.Duration(minTurnaroundSec)*time.Second)
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package playoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllianceReadyTime(t *testing.T) {
+	database := setupTestDb(t)
+
+	startTime := time.Unix(1000, 0).UTC()
+	match1 := model.Match{Type: model.Playoff, TypeOrder: 1, PlayoffRedAlliance: 1, PlayoffBlueAlliance: 8}
+	match1.StartedAt = startTime
+	match1.Status = game.RedWonMatch
+	assert.Nil(t, database.CreateMatch(&match1))
+	match2 := model.Match{Type: model.Playoff, TypeOrder: 2, PlayoffRedAlliance: 4, PlayoffBlueAlliance: 1}
+	match2.StartedAt = startTime.Add(10 * time.Minute)
+	assert.Nil(t, database.CreateMatch(&match2))
+	match3 := model.Match{Type: model.Playoff, TypeOrder: 3, PlayoffRedAlliance: 2, PlayoffBlueAlliance: 3}
+	assert.Nil(t, database.CreateMatch(&match3))
+
+	// An alliance that hasn't played yet is always ready.
+	readyTime, err := AllianceReadyTime(database, 2, 0, 300)
+	assert.Nil(t, err)
+	assert.True(t, readyTime.IsZero())
+	readyTime, err = AllianceReadyTime(database, 0, 0, 300)
+	assert.Nil(t, err)
+	assert.True(t, readyTime.IsZero())
+
+	// The turnaround should be measured from the end of the alliance's most recently started match.
+	readyTime, err = AllianceReadyTime(database, 8, 0, 300)
+	assert.Nil(t, err)
+	assert.Equal(t, startTime.Add(game.GetDurationToTeleopEnd()+300*time.Second), readyTime)
+	readyTime, err = AllianceReadyTime(database, 1, 0, 300)
+	assert.Nil(t, err)
+	assert.Equal(t, match2.StartedAt.Add(game.GetDurationToTeleopEnd()+300*time.Second), readyTime)
+
+	// The excluded match shouldn't count against the alliance.
+	readyTime, err = AllianceReadyTime(database, 1, match2.Id, 300)
+	assert.Nil(t, err)
+	assert.Equal(t, startTime.Add(game.GetDurationToTeleopEnd()+300*time.Second), readyTime)
+}
@@ -86,24 +86,10 @@ const executeTransitionQueue = function () {
 // Handles a websocket message to update the teams for the current match.
 const handleMatchLoad = function (data) {
   currentMatch = data.Match;
-  $(`#${redSide}Team1`).text(currentMatch.Red1);
-  $(`#${redSide}Team1`).attr("data-yellow-card", data.Teams["R1"]?.YellowCard);
-  $(`#${redSide}Team2`).text(currentMatch.Red2);
-  $(`#${redSide}Team2`).attr("data-yellow-card", data.Teams["R2"]?.YellowCard);
-  $(`#${redSide}Team3`).text(currentMatch.Red3);
-  $(`#${redSide}Team3`).attr("data-yellow-card", data.Teams["R3"]?.YellowCard);
-  $(`#${redSide}Team1Avatar`).attr("src", getAvatarUrl(currentMatch.Red1));
-  $(`#${redSide}Team2Avatar`).attr("src", getAvatarUrl(currentMatch.Red2));
-  $(`#${redSide}Team3Avatar`).attr("src", getAvatarUrl(currentMatch.Red3));
-  $(`#${blueSide}Team1`).text(currentMatch.Blue1);
-  $(`#${blueSide}Team1`).attr("data-yellow-card", data.Teams["B1"]?.YellowCard);
-  $(`#${blueSide}Team2`).text(currentMatch.Blue2);
-  $(`#${blueSide}Team2`).attr("data-yellow-card", data.Teams["B2"]?.YellowCard);
-  $(`#${blueSide}Team3`).text(currentMatch.Blue3);
-  $(`#${blueSide}Team3`).attr("data-yellow-card", data.Teams["B3"]?.YellowCard);
-  $(`#${blueSide}Team1Avatar`).attr("src", getAvatarUrl(currentMatch.Blue1));
-  $(`#${blueSide}Team2Avatar`).attr("src", getAvatarUrl(currentMatch.Blue2));
-  $(`#${blueSide}Team3Avatar`).attr("src", getAvatarUrl(currentMatch.Blue3));
+  for (let i = 1; i <= 4; i++) {
+    setMatchTeam(redSide, i, currentMatch[`Red${i}`], data.Teams[`R${i}`]);
+    setMatchTeam(blueSide, i, currentMatch[`Blue${i}`], data.Teams[`B${i}`]);
+  }
 
   // Show alliance numbers if this is a playoff match.
   if (currentMatch.Type === matchTypePlayoff) {
@@ -168,7 +154,9 @@ const handleScorePosted = function (data) {
   setTeamInfo(redSide, 1, data.Match.Red1, data.RedCards, data.RedRankings);
   setTeamInfo(redSide, 2, data.Match.Red2, data.RedCards, data.RedRankings);
   setTeamInfo(redSide, 3, data.Match.Red3, data.RedCards, data.RedRankings);
-  if (data.RedOffFieldTeamIds.length > 0) {
+  if (data.Match.Red4 > 0) {
+    setTeamInfo(redSide, 4, data.Match.Red4, data.RedCards, data.RedRankings);
+  } else if (data.RedOffFieldTeamIds.length > 0) {
     setTeamInfo(redSide, 4, data.RedOffFieldTeamIds[0], data.RedCards, data.RedRankings);
   } else {
     setTeamInfo(redSide, 4, 0, data.RedCards, data.RedRankings);
@@ -214,7 +202,9 @@ const handleScorePosted = function (data) {
   setTeamInfo(blueSide, 1, data.Match.Blue1, data.BlueCards, data.BlueRankings);
   setTeamInfo(blueSide, 2, data.Match.Blue2, data.BlueCards, data.BlueRankings);
   setTeamInfo(blueSide, 3, data.Match.Blue3, data.BlueCards, data.BlueRankings);
-  if (data.BlueOffFieldTeamIds.length > 0) {
+  if (data.Match.Blue4 > 0) {
+    setTeamInfo(blueSide, 4, data.Match.Blue4, data.BlueCards, data.BlueRankings);
+  } else if (data.BlueOffFieldTeamIds.length > 0) {
     setTeamInfo(blueSide, 4, data.BlueOffFieldTeamIds[0], data.BlueCards, data.BlueRankings);
   } else {
     setTeamInfo(blueSide, 4, 0, data.BlueCards, data.BlueRankings);
@@ -727,6 +717,18 @@ const initializeSponsorDisplay = function () {
   });
 };
 
+// Populates the team number, yellow card and avatar of the given position in the match overlay, hiding them if there
+// is no team in that position.
+const setMatchTeam = function (side, position, teamId, team) {
+  const teamElement = $(`#${side}Team${position}`);
+  teamElement.text(teamId);
+  teamElement.attr("data-yellow-card", team?.YellowCard);
+  teamElement.toggle(teamId > 0);
+  const avatarElement = $(`#${side}Team${position}Avatar`);
+  avatarElement.attr("src", getAvatarUrl(teamId));
+  avatarElement.toggle(teamId > 0);
+};
+
 const getAvatarUrl = function (teamId) {
   return "/api/teams/" + teamId + "/avatar";
 };
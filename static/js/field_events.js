@@ -0,0 +1,49 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Shared client-side logic for rendering a filterable timeline of field events.
+
+// Human-readable names of the field event types; must match the constants in field/field_event_log.go.
+const fieldEventTypeNames = {
+  dsLink: "DS Link",
+  radioLink: "Radio Link",
+  rioLink: "roboRIO Link",
+  robotLink: "Robot Link",
+  eStop: "E-Stop",
+  aStop: "A-Stop",
+  wrongStation: "Wrong Station",
+  bypass: "Bypass",
+  matchState: "Match State",
+};
+
+// Fills in the options of the given type and station filter dropdowns.
+const initializeFieldEventFilters = function (typeFilter, stationFilter) {
+  typeFilter.append("<option value=''>All Events</option>");
+  $.each(fieldEventTypeNames, function (type, name) {
+    typeFilter.append(`<option value="${type}">${name}</option>`);
+  });
+  stationFilter.append("<option value=''>All Stations</option>");
+  $.each(["R1", "R2", "R3", "R4", "B1", "B2", "B3", "B4"], function (i, station) {
+    stationFilter.append(`<option value="${station}">${station}</option>`);
+  });
+};
+
+// Renders the given field events into the given table body, showing only those of the selected type and station.
+// Events that aren't specific to a station are always shown when filtering by station.
+const renderFieldEvents = function (tableBody, events, type, station) {
+  tableBody.empty();
+  $.each(events || [], function (i, event) {
+    if ((type && event.Type !== type) || (station && event.Station && event.Station !== station)) {
+      return;
+    }
+    const time = new Date(event.Time).toLocaleTimeString();
+    const row = $("<tr></tr>");
+    row.attr("data-station", event.Station);
+    row.append(`<td>${time}</td>`);
+    row.append(`<td>${event.MatchTimeSec.toFixed(1)}</td>`);
+    row.append(`<td>${fieldEventTypeNames[event.Type] || event.Type}</td>`);
+    row.append(`<td>${event.Station}</td>`);
+    row.append(`<td>${event.TeamId || ""}</td>`);
+    row.append(`<td>${event.Description}</td>`);
+    tableBody.append(row);
+  });
+};
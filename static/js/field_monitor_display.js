@@ -7,6 +7,8 @@ let websocket;
 let currentMatchId;
 let redSide;
 let blueSide;
+let fieldEventsMatchId;
+let fieldEvents;
 const lowBatteryThreshold = 8;
 const highBtuThreshold = 7.0;
 
@@ -73,6 +75,16 @@ const handleArenaStatus = function (data) {
       teamNotesElement.attr("data-status", "");
     }
 
+    // Flag any team reporting software versions that aren't allowed at this event.
+    const versionIssues = data.VersionIssues ? data.VersionIssues[station] : null;
+    if (versionIssues && versionIssues.length > 0) {
+      teamIdElement.attr("data-version-issue", "true");
+      teamIdElement.attr("title", versionIssues.join("\n"));
+    } else {
+      teamIdElement.attr("data-version-issue", "");
+      teamIdElement.removeAttr("title");
+    }
+
     // Format the Ethernet status box.
     teamEthernetElement.attr("data-status-ok", stationStatus.Ethernet ? "true" : "");
     if (stationStatus.DsConn && stationStatus.DsConn.DsRobotTripTimeMs > 0) {
@@ -221,6 +233,22 @@ const handleEventStatus = function (_data) {
   // Status row no longer displays cycle time / running late; ignore updates.
 };
 
+// Handles a websocket message to flag the teams in the loaded match that have not yet arrived at queueing.
+const handleQueueStatus = function (data) {
+  $(".team-id").attr("data-queue-late", "");
+  $.each(data.Matches || [], function (i, match) {
+    if (match.MatchId !== currentMatchId) {
+      return;
+    }
+    $.each(match.Teams, function (j, team) {
+      if (team.IsLate) {
+        const side = team.Station[0] === "R" ? redSide : blueSide;
+        $(`#${side}Team${team.Station[1]}Id`).attr("data-queue-late", "true");
+      }
+    });
+  });
+};
+
 // Makes the team notes section editable and handles saving edits to the server.
 const editFtaNotes = function (element) {
   const teamNotesTextElement = $(element);
@@ -236,6 +264,34 @@ const editFtaNotes = function (element) {
   });
 };
 
+// Handles a websocket message to update the timeline of field events for the current match, which either replaces the
+// timeline or carries only the events to append to it.
+const handleFieldEvents = function (data) {
+  if (data.Incremental && fieldEventsMatchId === data.MatchId) {
+    fieldEvents = (fieldEvents || []).concat(data.Events);
+  } else {
+    fieldEvents = data.Events;
+  }
+  fieldEventsMatchId = data.MatchId;
+  $("#fieldEventsMatchName").text(data.MatchName);
+  showFieldEvents();
+};
+
+// Re-renders the field event timeline according to the selected filters, with the most recent events first.
+const showFieldEvents = function () {
+  renderFieldEvents(
+    $("#fieldEventsBody"),
+    (fieldEvents || []).slice().reverse(),
+    $("#fieldEventsTypeFilter").val(),
+    $("#fieldEventsStationFilter").val(),
+  );
+};
+
+// Shows or hides the field event timeline.
+const toggleFieldEvents = function () {
+  $("#fieldEvents").toggleClass("visible");
+};
+
 $(function () {
   // Read the configuration for this display from the URL query string.
   const urlParams = new URLSearchParams(window.location.search);
@@ -260,6 +316,7 @@ $(function () {
 
   $(".reversible-left").attr("data-reversed", reversed);
   $(".reversible-right").attr("data-reversed", reversed);
+  initializeFieldEventFilters($("#fieldEventsTypeFilter"), $("#fieldEventsStationFilter"));
 
 
   // Set up the websocket back to the server.
@@ -270,6 +327,9 @@ $(function () {
     eventStatus: function (event) {
       handleEventStatus(event.data);
     },
+    fieldEvents: function (event) {
+      handleFieldEvents(event.data);
+    },
     matchLoad: function (event) {
       handleMatchLoad(event.data);
     },
@@ -279,6 +339,9 @@ $(function () {
     matchTime: function (event) {
       handleMatchTime(event.data);
     },
+    queueStatus: function (event) {
+      handleQueueStatus(event.data);
+    },
     realtimeScore: function (event) {
       handleRealtimeScore(event.data, reversed);
     },
@@ -0,0 +1,23 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Client-side logic for the judging queue display.
+
+const refreshIntervalMs = 15000;
+
+var websocket;
+
+// Fetches the current and upcoming judging visits for each panel.
+const refreshPanels = function () {
+  fetch("/displays/judging_queue/slots")
+    .then(response => response.text())
+    .then(html => $("#panels").html(html));
+};
+
+$(function () {
+  // Set up the websocket back to the server.
+  websocket = new CheesyWebsocket("/displays/judging_queue/websocket", {});
+
+  // The judging schedule is fixed, so just refresh the display periodically as visits come and go.
+  refreshPanels();
+  setInterval(refreshPanels, refreshIntervalMs);
+});
@@ -6,6 +6,7 @@
 var websocket;
 let scoreIsReady;
 let isReplay;
+let fieldFaultPaused = false;
 const lowBatteryThreshold = 8;
 
 // Sends a websocket message to load the specified match.
@@ -24,9 +25,11 @@ const substituteTeams = function (team, position) {
     Red1: getTeamNumber("R1"),
     Red2: getTeamNumber("R2"),
     Red3: getTeamNumber("R3"),
+    Red4: getTeamNumber("R4"),
     Blue1: getTeamNumber("B1"),
     Blue2: getTeamNumber("B2"),
     Blue3: getTeamNumber("B3"),
+    Blue4: getTeamNumber("B4"),
   };
 
   websocket.send("substituteTeams", teams);
@@ -48,6 +51,22 @@ const abortMatch = function () {
   websocket.send("abortMatch");
 };
 
+// Sends a websocket message to pause the match in progress for a field fault, or to resume it if already paused.
+const toggleFieldFaultPause = function () {
+  if (fieldFaultPaused) {
+    websocket.send("resumeMatch");
+  } else {
+    websocket.send("pauseMatch", {reason: prompt("Reason for the field fault pause (optional):") ?? ""});
+  }
+};
+
+// Sends a websocket message to allow the match to start even though some of its teams haven't passed inspection.
+const overrideInspection = function () {
+  if (confirm("Start this match with teams that have not passed inspection?")) {
+    websocket.send("overrideInspection");
+  }
+};
+
 // Sends a websocket message to signal to the volunteers that they may enter the field.
 const signalVolunteers = function () {
   websocket.send("signalVolunteers");
@@ -123,9 +142,10 @@ const setTestMatchName = function () {
   websocket.send("setTestMatchName", $("#testMatchName").val());
 };
 
-// Returns the integer team number entered into the team number input box for the given station, or 0 if it is empty.
+// Returns the integer team number entered into the team number input box for the given station, or 0 if it is empty or
+// the station is not in use.
 const getTeamNumber = function (station) {
-  const teamId = $(`#status${station} .team-number`).val().trim();
+  const teamId = ($(`#status${station} .team-number`).val() || "").trim();
   return teamId ? parseInt(teamId) : 0;
 }
 
@@ -225,6 +245,20 @@ const handleArenaStatus = function (data) {
     hideMatchPlayError();
   }
 
+  // Flag the teams that are holding up the match because they haven't passed inspection.
+  const uninspectedTeamIds = data.UninspectedTeamIds ?? [];
+  if (data.InspectionOverride) {
+    $("#inspectionStatusMessage").text(
+      `Inspection overridden by the FTA for ${uninspectedTeamIds.join(", ")}.`
+    );
+  } else {
+    $("#inspectionStatusMessage").text(`Not yet passed inspection: ${uninspectedTeamIds.join(", ")}.`);
+  }
+  $("#overrideInspection").toggleClass(
+    "d-none", data.InspectionOverride || matchStates[data.MatchState] !== "PRE_MATCH"
+  );
+  $("#inspectionStatus").toggleClass("d-none", uninspectedTeamIds.length === 0);
+
   // Enable/disable the buttons based on the current match state.
   switch (matchStates[data.MatchState]) {
     case "PRE_MATCH":
@@ -360,6 +394,13 @@ const handleMatchTime = function (data) {
     $("#matchState").text(matchStateText);
     $("#matchTime").text(countdownSec);
   });
+
+  fieldFaultPaused = data.FieldFaultPaused;
+  const matchInProgress = ["WARMUP_PERIOD", "AUTO_PERIOD", "PAUSE_PERIOD", "TELEOP_PERIOD"].includes(
+    matchStates[data.MatchState]
+  );
+  $("#fieldFaultPause").prop("disabled", !matchInProgress);
+  $("#fieldFaultPause").text(fieldFaultPaused ? "Resume Match" : "Field Fault");
 };
 
 // Handles a websocket message to update the match score.
@@ -368,7 +409,7 @@ const handleRealtimeScore = function (data) {
   $("#blueScore").text(data.Blue.ScoreSummary.Score);
 };
 
-const rpiStationsOrder = ["R1","R2","R3","B1","B2","B3"];
+const rpiStationsOrder = ["R1","R2","R3","R4","B1","B2","B3","B4"];
 
 const updateRpiStatusTable = function(statuses) {
   rpiStationsOrder.forEach(station => {
@@ -455,6 +496,20 @@ const handleEventStatus = function (data) {
   $("#earlyLateMessage").text(data.EarlyLateMessage);
 };
 
+// Handles a websocket message to list the teams that have not yet arrived at queueing for the upcoming matches.
+const handleQueueStatus = function (data) {
+  const lateTeamsByMatch = [];
+  $.each(data.Matches || [], function (i, match) {
+    const lateTeamIds = match.Teams.filter(team => team.IsLate).map(team => team.TeamId);
+    if (lateTeamIds.length > 0) {
+      lateTeamsByMatch.push(`${match.ShortName}: ${lateTeamIds.join(", ")}`);
+    }
+  });
+  const queueStatusMessage = $("#queueStatusMessage");
+  queueStatusMessage.text("Not yet arrived at queueing. " + lateTeamsByMatch.join("; "));
+  queueStatusMessage.toggleClass("d-none", lateTeamsByMatch.length === 0);
+};
+
 const formatPlayoffAllianceInfo = function (allianceNumber, offFieldTeams) {
   if (allianceNumber === 0) {
     return "";
@@ -502,6 +557,9 @@ $(function () {
     matchTiming: function (event) {
       handleMatchTiming(event.data);
     },
+    queueStatus: function (event) {
+      handleQueueStatus(event.data);
+    },
     realtimeScore: function (event) {
       handleRealtimeScore(event.data);
     },
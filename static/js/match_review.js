@@ -6,6 +6,7 @@
 const scoreTemplate = Handlebars.compile($("#scoreTemplate").html());
 const allianceResults = {};
 let matchResult;
+let numRobots = 3;
 
 // Hijack the form submission to inject the data in JSON form so that it's easier for the server to parse.
 $("form").submit(function () {
@@ -38,13 +39,15 @@ const renderResults = function (alliance) {
   getInputElement(alliance, "BargeAlgae").val(result.score.BargeAlgae);
   getInputElement(alliance, "ProcessorAlgae").val(result.score.ProcessorAlgae);
 
-  for (let i = 0; i < 3; i++) {
+  for (let i = 0; i < numRobots; i++) {
     const i1 = i + 1;
 
     getInputElement(alliance, `RobotsBypassed${i1}`).prop("checked", result.score.RobotsBypassed[i]);
     getInputElement(alliance, `LeaveStatuses${i1}`).prop("checked", result.score.LeaveStatuses[i]);
     getInputElement(alliance, `EndgameStatuses${i1}`, result.score.EndgameStatuses[i]).prop("checked", true);
+  }
 
+  for (let i = 0; i < 3; i++) {
     for (let j = 0; j < 12; j++) {
       getInputElement(alliance, `ReefAutoBranchesPipe${i}Branch${j}`).prop(
         "checked", result.score.Reef.AutoBranches[i][j]
@@ -89,12 +92,14 @@ const updateResults = function (alliance) {
   result.score.BargeAlgae = parseInt(formData[`${alliance}BargeAlgae`]);
   result.score.ProcessorAlgae = parseInt(formData[`${alliance}ProcessorAlgae`]);
   result.score.EndgameStatuses = [];
-  for (let i = 0; i < 3; i++) {
+  for (let i = 0; i < numRobots; i++) {
     const i1 = i + 1;
 
     result.score.RobotsBypassed[i] = formData[`${alliance}RobotsBypassed${i1}`] === "on";
     result.score.LeaveStatuses[i] = formData[`${alliance}LeaveStatuses${i1}`] === "on";
     result.score.EndgameStatuses[i] = parseInt(formData[`${alliance}EndgameStatuses${i1}`]);
+  }
+  for (let i = 0; i < 3; i++) {
     result.score.Reef.AutoBranches[i] = [];
     result.score.Reef.Branches[i] = [];
     for (let j = 0; j < 12; j++) {
@@ -116,9 +121,10 @@ const updateResults = function (alliance) {
   }
 
   result.cards = {};
-  $.each([result.team1, result.team2, result.team3], function (i, team) {
+  for (let i = 1; i <= numRobots; i++) {
+    const team = result[`team${i}`];
     result.cards[team] = formData[`${alliance}Team${team}Card`];
-  });
+  }
 };
 
 // Appends a blank foul to the end of the list.
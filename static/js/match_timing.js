@@ -56,6 +56,9 @@ const translateMatchTime = function (data, callback) {
       matchStateText = "TIMEOUT";
       break;
   }
+  if (data.FieldFaultPaused) {
+    matchStateText = "FIELD FAULT";
+  }
   callback(matchStates[data.MatchState], matchStateText, getCountdown(data.MatchState, data.MatchTimeSec));
 };
 
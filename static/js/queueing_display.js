@@ -30,6 +30,22 @@ var handleEventStatus = function (data) {
   $("#earlyLateMessage").text(data.EarlyLateMessage);
 };
 
+// Adds the entered team to the open practice queue.
+var joinPracticeQueue = function () {
+  const teamId = $("#practiceQueueTeamId").val();
+  fetch("/displays/queueing/practice_queue/join", {
+    method: "POST",
+    body: new URLSearchParams({teamId: teamId}),
+  }).then(response => {
+    if (response.ok) {
+      $("#practiceQueueTeamId").val("");
+      $("#practiceQueueMessage").text(`Team ${teamId} has joined the practice queue.`);
+    } else {
+      response.text().then(text => $("#practiceQueueMessage").text(text));
+    }
+  });
+};
+
 $(function () {
   // Set up the websocket back to the server.
   websocket = new CheesyWebsocket("/displays/queueing/websocket", {
@@ -45,5 +61,8 @@ $(function () {
     matchTiming: function (event) {
       handleMatchTiming(event.data);
     },
+    practiceQueue: function (event) {
+      handleMatchLoad(event.data);
+    },
   });
 });
@@ -0,0 +1,55 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Client-side logic for the queueing panel.
+
+let websocket;
+
+// The queue statuses a queuer can move a team to, in order; must match the model.QueueStatus values.
+const queueStatuses = [
+  {value: 2, name: "Arrived"},
+  {value: 3, name: "On Deck"},
+  {value: 4, name: "At Field"},
+];
+
+// Sends a websocket message to move the given team to the given queue status for the given match.
+const setQueueStatus = function (matchId, teamId, status) {
+  websocket.send("setQueueStatus", {MatchId: matchId, TeamId: teamId, Status: status});
+};
+
+// Handles a websocket message to update the list of matches and the queueing progress of their teams.
+const handleQueueStatus = function (data) {
+  $("#queueingDisabled").toggleClass("d-none", data.Enabled);
+  const queueMatches = $("#queueMatches");
+  queueMatches.empty();
+  $.each(data.Matches || [], function (i, match) {
+    const matchElement = $("<div class='queue-match'></div>");
+    const matchesAway = match.MatchesAway === 0 ? "On field now" : `${match.MatchesAway} away`;
+    matchElement.append(`<h4>${match.ShortName} <small>(${matchesAway})</small></h4>`);
+    $.each(match.Teams, function (j, team) {
+      const teamElement = $("<div class='queue-team'></div>");
+      teamElement.attr("data-alliance", team.Station[0] === "R" ? "red" : "blue");
+      teamElement.attr("data-late", team.IsLate);
+      teamElement.append(`<div class="queue-team-id">${team.TeamId}</div>`);
+      teamElement.append(`<div class="queue-team-status">${team.StatusName}</div>`);
+      $.each(queueStatuses, function (k, status) {
+        const button = $(`<button type="button" class="btn btn-sm">${status.name}</button>`);
+        button.addClass(team.Status >= status.value ? "btn-success" : "btn-secondary");
+        button.click(function () {
+          setQueueStatus(match.MatchId, team.TeamId, status.value);
+        });
+        teamElement.append(button);
+      });
+      matchElement.append(teamElement);
+    });
+    queueMatches.append(matchElement);
+  });
+};
+
+$(function () {
+  // Set up the websocket back to the server.
+  websocket = new CheesyWebsocket("/panels/queueing/websocket", {
+    queueStatus: function (event) {
+      handleQueueStatus(event.data);
+    },
+  });
+});
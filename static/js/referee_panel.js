@@ -62,28 +62,31 @@ var commitMatch = function () {
   websocket.send("commitMatch");
 };
 
+// Pauses the match in progress for a field fault, or resumes it if it is already paused.
+var toggleFieldFaultPause = function () {
+  websocket.send("toggleFieldFaultPause");
+};
+
 // Handles a websocket message to update the teams for the current match.
 var handleMatchLoad = function (data) {
   $("#matchName").text(data.Match.LongName);
 
-  setTeamCard("red", 1, data.Teams["R1"]);
-  setTeamCard("red", 2, data.Teams["R2"]);
-  setTeamCard("red", 3, data.Teams["R3"]);
-  setTeamCard("blue", 1, data.Teams["B1"]);
-  setTeamCard("blue", 2, data.Teams["B2"]);
-  setTeamCard("blue", 3, data.Teams["B3"]);
-
-  $("#redScoreSummary .team-1").text(data.Teams["R1"].Id);
-  $("#redScoreSummary .team-2").text(data.Teams["R2"].Id);
-  $("#redScoreSummary .team-3").text(data.Teams["R3"].Id);
-  $("#blueScoreSummary .team-1").text(data.Teams["B1"].Id);
-  $("#blueScoreSummary .team-2").text(data.Teams["B2"].Id);
-  $("#blueScoreSummary .team-3").text(data.Teams["B3"].Id);
+  for (let i = 1; i <= data.TeamsPerAlliance; i++) {
+    setTeamCard("red", i, data.Teams[`R${i}`]);
+    setTeamCard("blue", i, data.Teams[`B${i}`]);
+    $(`#redScoreSummary .team-${i}`).text(data.Teams[`R${i}`]?.Id ?? 0);
+    $(`#blueScoreSummary .team-${i}`).text(data.Teams[`B${i}`]?.Id ?? 0);
+  }
 };
 
 // Handles a websocket message to update the match status.
 const handleMatchTime = function (data) {
-  $(".control-button").attr("data-enabled", matchStates[data.MatchState] === "POST_MATCH");
+  $(".control-button").not("#fieldFaultButton").attr("data-enabled", matchStates[data.MatchState] === "POST_MATCH");
+  const matchInProgress = ["WARMUP_PERIOD", "AUTO_PERIOD", "PAUSE_PERIOD", "TELEOP_PERIOD"].includes(
+    matchStates[data.MatchState]
+  );
+  $("#fieldFaultButton").attr("data-enabled", matchInProgress);
+  $("#fieldFaultButton").text(data.FieldFaultPaused ? "Resume Match" : "Field Fault");
 };
 
 const endgameStatusNames = [
@@ -127,12 +130,12 @@ const handleRealtimeScore = function (data) {
     let l4_auto_total = score.Reef.AutoBranches[2].filter(Boolean).length;
 
     let scoreRoot = `${alliance}ScoreSummary`;
-    $(`#${scoreRoot} .team-1-leave`).text(score.LeaveStatuses[0] ? "✓" : "❌");
-    $(`#${scoreRoot} .team-2-leave`).text(score.LeaveStatuses[1] ? "✓" : "❌");
-    $(`#${scoreRoot} .team-3-leave`).text(score.LeaveStatuses[2] ? "✓" : "❌");
-    $(`#${scoreRoot} .team-1-endgame`).text(endgameStatusNames[score.EndgameStatuses[0]]);
-    $(`#${scoreRoot} .team-2-endgame`).text(endgameStatusNames[score.EndgameStatuses[1]]);
-    $(`#${scoreRoot} .team-3-endgame`).text(endgameStatusNames[score.EndgameStatuses[2]]);
+    score.LeaveStatuses.forEach(function (leaveStatus, i) {
+      $(`#${scoreRoot} .team-${i + 1}-leave`).text(leaveStatus ? "✓" : "❌");
+    });
+    score.EndgameStatuses.forEach(function (endgameStatus, i) {
+      $(`#${scoreRoot} .team-${i + 1}-endgame`).text(endgameStatusNames[endgameStatus]);
+    });
     $(`#${scoreRoot} .coral-l1`).text(l1_total);
     $(`#${scoreRoot} .coral-l2`).text(l2_total);
     $(`#${scoreRoot} .coral-l3`).text(l3_total);
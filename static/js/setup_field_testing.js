@@ -27,11 +27,45 @@ var handlePlcIoChange = function (data) {
   });
 };
 
+// Sends a websocket message to enable the given station on its own for the mode and duration selected.
+const startStationMiniMatch = function (station) {
+  websocket.send("startStationMiniMatch", {
+    Station: station,
+    Auto: $(`#miniMatchMode${station}`).val() === "auto",
+    DurationSec: parseInt($(`#miniMatchDuration${station}`).val()),
+  });
+};
+
+// Sends a websocket message to disable the given station and end its mini-match early.
+const stopStationMiniMatch = function (station) {
+  websocket.send("stopStationMiniMatch", station);
+};
+
+// Handles a websocket message to update the team and mini-match status of each station.
+const handleArenaStatus = function (data) {
+  $.each(data.AllianceStations, function (station, allianceStation) {
+    const dsConn = allianceStation.DsConn;
+    const miniMatch = allianceStation.MiniMatch;
+    $(`#miniMatchTeam${station}`).text(dsConn ? dsConn.TeamId : "");
+    if (miniMatch) {
+      const mode = miniMatch.Auto ? "Autonomous" : "Teleoperated";
+      $(`#miniMatchStatus${station}`).text(`${mode}, ${miniMatch.RemainingSec} sec left`);
+    } else {
+      $(`#miniMatchStatus${station}`).text(dsConn ? "Disabled" : "No driver station");
+    }
+    $(`#miniMatchStart${station}`).prop("disabled", !dsConn || miniMatch !== null);
+    $(`#miniMatchStop${station}`).prop("disabled", miniMatch === null);
+  });
+};
+
 $(function () {
   // Set up the websocket back to the server.
   websocket = new CheesyWebsocket("/setup/field_testing/websocket", {
+    arenaStatus: function (event) {
+      handleArenaStatus(event.data);
+    },
     plcIoChange: function (event) {
       handlePlcIoChange(event.data);
-    }
+    },
   });
 });
@@ -7,26 +7,32 @@ var blockTemplate = Handlebars.compile($("#blockTemplate").html());
 var blockMatches = {};
 
 // Adds a new scheduling block to the page.
-var addBlock = function (startTime, numMatches, matchSpacingSec) {
+var addBlock = function (startTime, numMatches, matchSpacingSec, dayIndex, breakDescription) {
   var lastBlockNumber = getLastBlockNumber();
   if (!startTime) {
     if ($.isEmptyObject(blockMatches)) {
       matchSpacingSec = 360;
       startTime = moment().add(1, "hour").startOf("hour");
+      dayIndex = 0;
     } else {
-      // Start the next block where the last one left off and use the same spacing.
+      // Start the next block where the last one left off, on the same day and using the same spacing.
       var lastStartTime = moment($("#startTime" + lastBlockNumber).val(), "YYYY-MM-DD hh:mm:ss A");
       var lastNumMatches = blockMatches[lastBlockNumber];
       matchSpacingSec = getMatchSpacingSec(lastBlockNumber);
       startTime = moment(lastStartTime + lastNumMatches * matchSpacingSec * 1000);
+      dayIndex = $("#dayIndex" + lastBlockNumber).val();
     }
     numMatches = 10;
+    breakDescription = "";
   }
   var endTime = moment(startTime + numMatches * matchSpacingSec * 1000);
   lastBlockNumber += 1;
   var matchSpacingMinSec = moment(matchSpacingSec * 1000).format("m:ss");
-  var block = blockTemplate({blockNumber: lastBlockNumber, matchSpacingMinSec: matchSpacingMinSec});
+  var block = blockTemplate(
+    {blockNumber: lastBlockNumber, matchSpacingMinSec: matchSpacingMinSec, breakDescription: breakDescription}
+  );
   $("#blockContainer").append(block);
+  $("#dayIndex" + lastBlockNumber).val(dayIndex);
   newDateTimePicker("startTimePicker" + lastBlockNumber, startTime.toDate());
   newDateTimePicker("endTimePicker" + lastBlockNumber, endTime.toDate());
   updateBlock(lastBlockNumber);
@@ -89,6 +95,8 @@ var generateSchedule = function () {
     addField("startTime" + i, $("#startTime" + k).val());
     addField("numMatches" + i, $("#numMatches" + k).text());
     addField("matchSpacingSec" + i, getMatchSpacingSec(k));
+    addField("dayIndex" + i, $("#dayIndex" + k).val());
+    addField("breakDescription" + i, $("#breakDescription" + k).val());
     i++;
   });
   addField("numScheduleBlocks", i);
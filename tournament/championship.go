@@ -0,0 +1,149 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Functions for exchanging division winners between events in order to seed a championship playoff tournament.
+
+package tournament
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// DivisionWinner is the exported record of the alliance that won a division's playoff tournament, along with the team
+// information needed to play it in a championship event.
+type DivisionWinner struct {
+	DivisionName string
+	Alliance     model.Alliance
+	Teams        []model.Team
+}
+
+// GetDivisionWinner assembles the export record for the given alliance of this event, which is taken to be the division
+// with the given name.
+func GetDivisionWinner(database *model.Database, divisionName string, allianceId int) (*DivisionWinner, error) {
+	alliance, err := database.GetAllianceById(allianceId)
+	if err != nil {
+		return nil, err
+	}
+	if alliance == nil {
+		return nil, fmt.Errorf("alliance %d does not exist", allianceId)
+	}
+
+	divisionWinner := DivisionWinner{DivisionName: divisionName, Alliance: *alliance}
+	for _, teamId := range alliance.TeamIds {
+		team, err := database.GetTeamById(teamId)
+		if err != nil {
+			return nil, err
+		}
+		if team != nil {
+			divisionWinner.Teams = append(divisionWinner.Teams, *team)
+		}
+	}
+	return &divisionWinner, nil
+}
+
+// CreateChampionshipAlliances saves the given division winners as this event's playoff alliances, seeded in the order
+// given, and creates any of their teams that don't already exist. Every division winner is validated before anything
+// is saved, so that a bad import leaves the database untouched.
+func CreateChampionshipAlliances(
+	database *model.Database, divisionWinners []DivisionWinner, numAlliances, teamsPerAlliance int,
+) error {
+	alliances, err := database.GetAllAlliances()
+	if err != nil {
+		return err
+	}
+	if len(alliances) > 0 {
+		return fmt.Errorf("cannot import division winners; %d alliances already exist", len(alliances))
+	}
+	if len(divisionWinners) != numAlliances {
+		return fmt.Errorf("expected %d division winners but got %d", numAlliances, len(divisionWinners))
+	}
+
+	seenTeams := make(map[int]string)
+	for i, divisionWinner := range divisionWinners {
+		if strings.TrimSpace(divisionWinner.DivisionName) == "" {
+			return fmt.Errorf("division winner #%d is missing a division name", i+1)
+		}
+		if len(divisionWinner.Alliance.TeamIds) < 3 {
+			return fmt.Errorf("alliance from division %s has fewer than three teams", divisionWinner.DivisionName)
+		}
+		if len(divisionWinner.Alliance.TeamIds) < teamsPerAlliance {
+			return fmt.Errorf(
+				"alliance from division %s has fewer than the %d teams needed to fill its stations",
+				divisionWinner.DivisionName,
+				teamsPerAlliance,
+			)
+		}
+		for _, teamId := range divisionWinner.Alliance.TeamIds {
+			if otherDivisionName, ok := seenTeams[teamId]; ok {
+				if otherDivisionName == divisionWinner.DivisionName {
+					return fmt.Errorf(
+						"team %d appears more than once in the alliance from division %s",
+						teamId,
+						divisionWinner.DivisionName,
+					)
+				}
+				return fmt.Errorf(
+					"team %d appears in both division %s and division %s",
+					teamId,
+					otherDivisionName,
+					divisionWinner.DivisionName,
+				)
+			}
+			seenTeams[teamId] = divisionWinner.DivisionName
+		}
+		for _, team := range divisionWinner.Teams {
+			if seenTeams[team.Id] != divisionWinner.DivisionName {
+				return fmt.Errorf(
+					"team %d from division %s is not on its alliance", team.Id, divisionWinner.DivisionName,
+				)
+			}
+		}
+	}
+
+	// Work out which teams need to be created, preferring the full team information from the export where present.
+	var newTeams []model.Team
+	for _, divisionWinner := range divisionWinners {
+		exportedTeams := make(map[int]model.Team)
+		for _, team := range divisionWinner.Teams {
+			exportedTeams[team.Id] = team
+		}
+		for _, teamId := range divisionWinner.Alliance.TeamIds {
+			existingTeam, err := database.GetTeamById(teamId)
+			if err != nil {
+				return err
+			}
+			if existingTeam != nil {
+				continue
+			}
+			team, ok := exportedTeams[teamId]
+			if !ok {
+				team = model.Team{Id: teamId}
+			}
+			team.YellowCard = false
+			team.HasConnected = false
+			newTeams = append(newTeams, team)
+		}
+	}
+
+	for i := range newTeams {
+		if err = database.CreateTeam(&newTeams[i]); err != nil {
+			return err
+		}
+	}
+	for i, divisionWinner := range divisionWinners {
+		// Populate the initial lineup in the same way as for a locally selected alliance.
+		teamIds := divisionWinner.Alliance.TeamIds
+		alliance := model.Alliance{
+			Id:           i + 1,
+			TeamIds:      teamIds,
+			Lineup:       model.InitialAllianceLineup(teamIds, teamsPerAlliance),
+			DivisionName: divisionWinner.DivisionName,
+		}
+		if err = database.CreateAlliance(&alliance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
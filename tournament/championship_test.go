@@ -0,0 +1,148 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package tournament
+
+import (
+	"testing"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDivisionWinner(t *testing.T) {
+	database := setupTestDb(t)
+	CreateTestAlliances(database, 2)
+	assert.Nil(t, database.CreateTeam(&model.Team{Id: 201, Nickname: "First"}))
+	assert.Nil(t, database.CreateTeam(&model.Team{Id: 203, Nickname: "Third"}))
+
+	divisionWinner, err := GetDivisionWinner(database, "Galileo", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, "Galileo", divisionWinner.DivisionName)
+	assert.Equal(t, []int{201, 202, 203, 204}, divisionWinner.Alliance.TeamIds)
+	if assert.Equal(t, 2, len(divisionWinner.Teams)) {
+		assert.Equal(t, "First", divisionWinner.Teams[0].Nickname)
+		assert.Equal(t, "Third", divisionWinner.Teams[1].Nickname)
+	}
+
+	_, err = GetDivisionWinner(database, "Galileo", 3)
+	assert.EqualError(t, err, "alliance 3 does not exist")
+}
+
+func TestCreateChampionshipAlliances(t *testing.T) {
+	database := setupTestDb(t)
+	assert.Nil(t, database.CreateTeam(&model.Team{Id: 254, Nickname: "Local Copy"}))
+
+	divisionWinners := []DivisionWinner{
+		{
+			DivisionName: "Newton",
+			Alliance:     model.Alliance{Id: 4, TeamIds: []int{254, 1678, 971}},
+			Teams: []model.Team{
+				{Id: 254, Nickname: "Imported Copy"},
+				{Id: 1678, Nickname: "Citrus Circuits", YellowCard: true},
+			},
+		},
+		{
+			DivisionName: "Hopper",
+			Alliance:     model.Alliance{Id: 1, TeamIds: []int{1114, 2056, 4414, 118}},
+		},
+	}
+	assert.Nil(t, CreateChampionshipAlliances(database, divisionWinners, 2, 3))
+
+	alliances, _ := database.GetAllAlliances()
+	if assert.Equal(t, 2, len(alliances)) {
+		assert.Equal(
+			t,
+			model.Alliance{Id: 1, TeamIds: []int{254, 1678, 971}, Lineup: []int{1678, 254, 971}, DivisionName: "Newton"},
+			alliances[0],
+		)
+		assert.Equal(
+			t,
+			model.Alliance{
+				Id: 2, TeamIds: []int{1114, 2056, 4414, 118}, Lineup: []int{2056, 1114, 4414}, DivisionName: "Hopper",
+			},
+			alliances[1],
+		)
+	}
+	team, _ := database.GetTeamById(254)
+	assert.Equal(t, "Local Copy", team.Nickname)
+	team, _ = database.GetTeamById(1678)
+	assert.Equal(t, "Citrus Circuits", team.Nickname)
+	assert.False(t, team.YellowCard)
+	team, _ = database.GetTeamById(118)
+	assert.NotNil(t, team)
+
+	// Check that importing again is rejected.
+	err := CreateChampionshipAlliances(database, divisionWinners, 2, 3)
+	assert.EqualError(t, err, "cannot import division winners; 2 alliances already exist")
+}
+
+func TestCreateChampionshipAlliancesErrors(t *testing.T) {
+	database := setupTestDb(t)
+
+	err := CreateChampionshipAlliances(
+		database, []DivisionWinner{{Alliance: model.Alliance{TeamIds: []int{1, 2, 3}}}}, 1, 3,
+	)
+	assert.EqualError(t, err, "division winner #1 is missing a division name")
+
+	err = CreateChampionshipAlliances(
+		database, []DivisionWinner{{DivisionName: "Curie", Alliance: model.Alliance{TeamIds: []int{1, 2}}}}, 1, 3,
+	)
+	assert.EqualError(t, err, "alliance from division Curie has fewer than three teams")
+
+	err = CreateChampionshipAlliances(
+		database, []DivisionWinner{{DivisionName: "Curie", Alliance: model.Alliance{TeamIds: []int{1, 2, 3}}}}, 1, 4,
+	)
+	assert.EqualError(t, err, "alliance from division Curie has fewer than the 4 teams needed to fill its stations")
+
+	err = CreateChampionshipAlliances(
+		database,
+		[]DivisionWinner{
+			{DivisionName: "Curie", Alliance: model.Alliance{TeamIds: []int{1, 2, 3}}},
+			{DivisionName: "Daly", Alliance: model.Alliance{TeamIds: []int{4, 5, 2}}},
+		},
+		2,
+		3,
+	)
+	assert.EqualError(t, err, "team 2 appears in both division Curie and division Daly")
+
+	err = CreateChampionshipAlliances(
+		database, []DivisionWinner{{DivisionName: "Curie", Alliance: model.Alliance{TeamIds: []int{1, 2, 1}}}}, 1, 3,
+	)
+	assert.EqualError(t, err, "team 1 appears more than once in the alliance from division Curie")
+
+	err = CreateChampionshipAlliances(
+		database,
+		[]DivisionWinner{
+			{
+				DivisionName: "Curie",
+				Alliance:     model.Alliance{TeamIds: []int{1, 2, 3}},
+				Teams:        []model.Team{{Id: 1}, {Id: 4}},
+			},
+		},
+		1,
+		3,
+	)
+	assert.EqualError(t, err, "team 4 from division Curie is not on its alliance")
+
+	// Check that nothing is saved if a later division is invalid.
+	err = CreateChampionshipAlliances(
+		database,
+		[]DivisionWinner{
+			{DivisionName: "Curie", Alliance: model.Alliance{TeamIds: []int{1, 2, 3}}},
+			{DivisionName: "Daly", Alliance: model.Alliance{TeamIds: []int{4, 5}}},
+		},
+		2,
+		3,
+	)
+	assert.EqualError(t, err, "alliance from division Daly has fewer than three teams")
+
+	err = CreateChampionshipAlliances(
+		database, []DivisionWinner{{DivisionName: "Curie", Alliance: model.Alliance{TeamIds: []int{1, 2, 3}}}}, 2, 3,
+	)
+	assert.EqualError(t, err, "expected 2 division winners but got 1")
+
+	alliances, _ := database.GetAllAlliances()
+	assert.Empty(t, alliances)
+	teams, _ := database.GetAllTeams()
+	assert.Empty(t, teams)
+}
@@ -0,0 +1,312 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Functions for analyzing the cycle times of played matches and projecting when the remaining ones will finish.
+
+package tournament
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+)
+
+const (
+	// Scheduled gaps between matches longer than this are assumed to contain a break and are left out of the averages.
+	maxCycleTimeSec            = 900
+	numRollingCycleTimeMatches = 5
+	numBiggestCycleTimeDelays  = 5
+)
+
+// Names of the phases of a cycle between the start of one match and the next, used to attribute delays.
+const (
+	CycleTimeCauseScoring    = "Scoring and review"
+	CycleTimeCauseFieldReset = "Field reset and robot connection"
+	CycleTimeCauseStart      = "Waiting to start after field ready"
+	CycleTimeCauseUnknown    = "Unknown"
+)
+
+// MatchCycleTime holds the timing of a single match and of the cycle leading up to it from the previous match. Cycle
+// and delay fields are only meaningful if the corresponding timestamps have been recorded.
+type MatchCycleTime struct {
+	MatchId               int
+	ShortName             string
+	ScheduledTime         time.Time
+	FieldReadyAt          time.Time
+	StartedAt             time.Time
+	ScoreCommittedAt      time.Time
+	ScheduledCycleTimeSec int
+	CycleTimeSec          int
+	HasCycleTime          bool
+	LateSec               int
+	GapToNextStartSec     int
+	HasGapToNextStart     bool
+	BlockIndex            int
+	scoringSec            int
+	fieldResetSec         int
+	startSec              int
+	hasScoring            bool
+	hasFieldReset         bool
+	hasStart              bool
+}
+
+// BlockCycleTime summarizes the progress of a single schedule block. The projected end time is when the match after
+// the last one in the block would start if the remaining matches run at the current average cycle time, and is zero
+// for blocks that have already been completed.
+type BlockCycleTime struct {
+	Index               int
+	DayIndex            int
+	StartTime           time.Time
+	NumMatches          int
+	NumPlayed           int
+	MatchSpacingSec     int
+	AverageCycleTimeSec int
+	ScheduledEndTime    time.Time
+	ProjectedEndTime    time.Time
+}
+
+// CycleTimeDelay describes a cycle that took longer than scheduled, along with the phase of the cycle deemed to be
+// responsible.
+type CycleTimeDelay struct {
+	ShortName             string
+	StartedAt             time.Time
+	CycleTimeSec          int
+	ScheduledCycleTimeSec int
+	DelaySec              int
+	Cause                 string
+}
+
+// CycleTimeAnalytics is the result of analyzing the cycle times of a set of matches of one type.
+type CycleTimeAnalytics struct {
+	Matches                    []MatchCycleTime
+	RollingAverageCycleTimeSec int
+	Blocks                     []BlockCycleTime
+	CurrentBlockIndex          int
+	ProjectedBlockEndTime      time.Time
+	ProjectedDayEndTime        time.Time
+	BiggestDelays              []CycleTimeDelay
+}
+
+// BuildCycleTimeAnalytics calculates cycle time statistics from the recorded timing of the given matches, which must
+// be in order of play, and projects the finish time of the current schedule block and day as of the given time. The
+// current block is the one containing the first match that hasn't been started yet; its index is -1 if there is none.
+func BuildCycleTimeAnalytics(
+	matches []model.Match, scheduleBlocks []model.ScheduleBlock, now time.Time,
+) *CycleTimeAnalytics {
+	analytics := &CycleTimeAnalytics{CurrentBlockIndex: -1}
+	analytics.Matches = make([]MatchCycleTime, len(matches))
+	matchDuration := game.GetDurationToTeleopEnd()
+	for i, match := range matches {
+		cycle := &analytics.Matches[i]
+		cycle.MatchId = match.Id
+		cycle.ShortName = match.ShortName
+		cycle.ScheduledTime = match.Time
+		cycle.FieldReadyAt = match.FieldReadyAt
+		cycle.StartedAt = match.StartedAt
+		cycle.ScoreCommittedAt = match.ScoreCommittedAt
+		cycle.BlockIndex = blockIndexForTime(scheduleBlocks, match.Time)
+		if !match.StartedAt.IsZero() {
+			cycle.LateSec = int(match.StartedAt.Sub(match.Time).Seconds())
+		}
+		if i == 0 {
+			continue
+		}
+
+		previous := &analytics.Matches[i-1]
+		cycle.ScheduledCycleTimeSec = int(match.Time.Sub(previous.ScheduledTime).Seconds())
+		if !previous.ScoreCommittedAt.IsZero() && !match.StartedAt.IsZero() {
+			previous.GapToNextStartSec = int(match.StartedAt.Sub(previous.ScoreCommittedAt).Seconds())
+			previous.HasGapToNextStart = true
+		}
+		if previous.StartedAt.IsZero() || match.StartedAt.IsZero() || cycle.ScheduledCycleTimeSec > maxCycleTimeSec ||
+			cycle.ScheduledCycleTimeSec <= 0 {
+			continue
+		}
+		cycle.CycleTimeSec = int(match.StartedAt.Sub(previous.StartedAt).Seconds())
+		cycle.HasCycleTime = true
+
+		// Break the cycle down into phases so that delays can be attributed to one of them.
+		previousEndTime := previous.StartedAt.Add(matchDuration)
+		resetStartTime := previousEndTime
+		if !previous.ScoreCommittedAt.IsZero() {
+			cycle.scoringSec = int(previous.ScoreCommittedAt.Sub(previousEndTime).Seconds())
+			cycle.hasScoring = true
+			resetStartTime = previous.ScoreCommittedAt
+		}
+		if !match.FieldReadyAt.IsZero() {
+			cycle.fieldResetSec = int(match.FieldReadyAt.Sub(resetStartTime).Seconds())
+			cycle.hasFieldReset = true
+			cycle.startSec = int(match.StartedAt.Sub(match.FieldReadyAt).Seconds())
+			cycle.hasStart = true
+		}
+	}
+
+	analytics.RollingAverageCycleTimeSec = rollingAverageCycleTime(analytics.Matches)
+	analytics.BiggestDelays = biggestCycleTimeDelays(analytics.Matches)
+	analytics.buildBlocks(scheduleBlocks, now)
+	return analytics
+}
+
+// buildBlocks summarizes each schedule block and projects the end times of those that haven't been completed.
+func (analytics *CycleTimeAnalytics) buildBlocks(scheduleBlocks []model.ScheduleBlock, now time.Time) {
+	analytics.Blocks = make([]BlockCycleTime, len(scheduleBlocks))
+	lastStartTimes := make([]time.Time, len(scheduleBlocks))
+	cycleTimeSums := make([]int, len(scheduleBlocks))
+	numCycleTimes := make([]int, len(scheduleBlocks))
+	for _, cycle := range analytics.Matches {
+		if cycle.BlockIndex < 0 {
+			continue
+		}
+		if cycle.StartedAt.IsZero() {
+			if analytics.CurrentBlockIndex < 0 {
+				analytics.CurrentBlockIndex = cycle.BlockIndex
+			}
+			continue
+		}
+		analytics.Blocks[cycle.BlockIndex].NumPlayed++
+		lastStartTimes[cycle.BlockIndex] = cycle.StartedAt
+		if cycle.HasCycleTime {
+			cycleTimeSums[cycle.BlockIndex] += cycle.CycleTimeSec
+			numCycleTimes[cycle.BlockIndex]++
+		}
+	}
+
+	var previousEndTime time.Time
+	for i, scheduleBlock := range scheduleBlocks {
+		block := &analytics.Blocks[i]
+		block.Index = i
+		block.DayIndex = scheduleBlock.DayIndex
+		block.StartTime = scheduleBlock.StartTime
+		block.NumMatches = scheduleBlock.NumMatches
+		block.MatchSpacingSec = scheduleBlock.MatchSpacingSec
+		block.ScheduledEndTime = scheduleBlock.EndTime()
+		if numCycleTimes[i] > 0 {
+			block.AverageCycleTimeSec = cycleTimeSums[i] / numCycleTimes[i]
+		}
+		if analytics.CurrentBlockIndex < 0 || i < analytics.CurrentBlockIndex {
+			continue
+		}
+
+		cycleTimeSec := analytics.RollingAverageCycleTimeSec
+		if cycleTimeSec == 0 {
+			cycleTimeSec = scheduleBlock.MatchSpacingSec
+		}
+		cycleTime := time.Duration(cycleTimeSec) * time.Second
+		nextStartTime := latestTime(scheduleBlock.StartTime, previousEndTime)
+		if i == analytics.CurrentBlockIndex {
+			nextStartTime = latestTime(nextStartTime, now)
+			if !lastStartTimes[i].IsZero() {
+				nextStartTime = latestTime(lastStartTimes[i].Add(cycleTime), now)
+			}
+		}
+		block.ProjectedEndTime = nextStartTime.Add(time.Duration(block.NumMatches-block.NumPlayed) * cycleTime)
+		previousEndTime = block.ProjectedEndTime
+
+		if i == analytics.CurrentBlockIndex {
+			analytics.ProjectedBlockEndTime = block.ProjectedEndTime
+		}
+		if block.DayIndex == scheduleBlocks[analytics.CurrentBlockIndex].DayIndex {
+			analytics.ProjectedDayEndTime = block.ProjectedEndTime
+		}
+	}
+}
+
+// rollingAverageCycleTime returns the average of the most recent valid cycle times, or zero if there are none.
+func rollingAverageCycleTime(cycles []MatchCycleTime) int {
+	sum, count := 0, 0
+	for i := len(cycles) - 1; i >= 0 && count < numRollingCycleTimeMatches; i-- {
+		if cycles[i].HasCycleTime {
+			sum += cycles[i].CycleTimeSec
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return sum / count
+}
+
+// biggestCycleTimeDelays returns the cycles that overran their scheduled spacing by the most, largest first. Each one's
+// cause is the phase of the cycle that took the longest relative to its typical (median) duration across all cycles.
+func biggestCycleTimeDelays(cycles []MatchCycleTime) []CycleTimeDelay {
+	var scoringTimes, fieldResetTimes, startTimes []int
+	for _, cycle := range cycles {
+		if cycle.hasScoring {
+			scoringTimes = append(scoringTimes, cycle.scoringSec)
+		}
+		if cycle.hasFieldReset {
+			fieldResetTimes = append(fieldResetTimes, cycle.fieldResetSec)
+		}
+		if cycle.hasStart {
+			startTimes = append(startTimes, cycle.startSec)
+		}
+	}
+	medianScoring, medianFieldReset, medianStart := median(scoringTimes), median(fieldResetTimes), median(startTimes)
+
+	var delays []CycleTimeDelay
+	for _, cycle := range cycles {
+		if !cycle.HasCycleTime || cycle.CycleTimeSec <= cycle.ScheduledCycleTimeSec {
+			continue
+		}
+		cause := CycleTimeCauseUnknown
+		maxExcessSec := 0
+		for _, phase := range []struct {
+			isPresent bool
+			excessSec int
+			cause     string
+		}{
+			{cycle.hasScoring, cycle.scoringSec - medianScoring, CycleTimeCauseScoring},
+			{cycle.hasFieldReset, cycle.fieldResetSec - medianFieldReset, CycleTimeCauseFieldReset},
+			{cycle.hasStart, cycle.startSec - medianStart, CycleTimeCauseStart},
+		} {
+			if phase.isPresent && phase.excessSec > maxExcessSec {
+				cause = phase.cause
+				maxExcessSec = phase.excessSec
+			}
+		}
+		delays = append(
+			delays,
+			CycleTimeDelay{
+				ShortName:             cycle.ShortName,
+				StartedAt:             cycle.StartedAt,
+				CycleTimeSec:          cycle.CycleTimeSec,
+				ScheduledCycleTimeSec: cycle.ScheduledCycleTimeSec,
+				DelaySec:              cycle.CycleTimeSec - cycle.ScheduledCycleTimeSec,
+				Cause:                 cause,
+			},
+		)
+	}
+	sort.SliceStable(delays, func(i, j int) bool { return delays[i].DelaySec > delays[j].DelaySec })
+	if len(delays) > numBiggestCycleTimeDelays {
+		delays = delays[:numBiggestCycleTimeDelays]
+	}
+	return delays
+}
+
+// blockIndexForTime returns the index of the schedule block within which a match scheduled at the given time falls,
+// or -1 if there is none.
+func blockIndexForTime(scheduleBlocks []model.ScheduleBlock, matchTime time.Time) int {
+	for i, block := range scheduleBlocks {
+		if !matchTime.Before(block.StartTime) && matchTime.Before(block.EndTime()) {
+			return i
+		}
+	}
+	return -1
+}
+
+func median(values []int) int {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]int(nil), values...)
+	sort.Ints(sorted)
+	return sorted[len(sorted)/2]
+}
+
+func latestTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
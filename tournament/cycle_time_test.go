@@ -0,0 +1,99 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package tournament
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildCycleTimeAnalytics(t *testing.T) {
+	at := func(sec int) time.Time {
+		return time.Unix(int64(sec), 0).UTC()
+	}
+	matchSec := int(game.GetDurationToTeleopEnd().Seconds())
+	scheduleBlocks := []model.ScheduleBlock{
+		{MatchType: model.Qualification, StartTime: at(0), NumMatches: 4, MatchSpacingSec: 360},
+		{MatchType: model.Qualification, StartTime: at(3600), NumMatches: 2, MatchSpacingSec: 360},
+		{MatchType: model.Qualification, StartTime: at(86400), NumMatches: 2, MatchSpacingSec: 360, DayIndex: 1},
+	}
+	var matches []model.Match
+	for _, block := range scheduleBlocks {
+		for i := 0; i < block.NumMatches; i++ {
+			matchTime := block.StartTime.Add(time.Duration(i*block.MatchSpacingSec) * time.Second)
+			matches = append(matches, model.Match{ShortName: fmt.Sprintf("Q%d", len(matches)+1), Time: matchTime})
+		}
+	}
+
+	// Play the first block, with a slow field reset before the third match.
+	matches[0].StartedAt = at(0)
+	matches[0].ScoreCommittedAt = at(matchSec + 60)
+	matches[1].FieldReadyAt = at(300)
+	matches[1].StartedAt = at(330)
+	matches[1].ScoreCommittedAt = at(330 + matchSec + 60)
+	matches[2].FieldReadyAt = at(330 + matchSec + 60 + 500)
+	matches[2].StartedAt = at(1063)
+	matches[2].ScoreCommittedAt = at(1063 + matchSec + 60)
+	matches[3].FieldReadyAt = at(1063 + matchSec + 60 + 87)
+	matches[3].StartedAt = at(1393)
+	matches[3].ScoreCommittedAt = at(1393 + matchSec + 60)
+
+	analytics := BuildCycleTimeAnalytics(matches, scheduleBlocks, at(1500))
+	if assert.Equal(t, 8, len(analytics.Matches)) {
+		assert.Equal(t, "Q1", analytics.Matches[0].ShortName)
+		assert.False(t, analytics.Matches[0].HasCycleTime)
+		assert.True(t, analytics.Matches[0].HasGapToNextStart)
+		assert.Equal(t, 330-matchSec-60, analytics.Matches[0].GapToNextStartSec)
+		assert.True(t, analytics.Matches[2].HasCycleTime)
+		assert.Equal(t, 733, analytics.Matches[2].CycleTimeSec)
+		assert.Equal(t, 360, analytics.Matches[2].ScheduledCycleTimeSec)
+		assert.Equal(t, 1063-720, analytics.Matches[2].LateSec)
+		assert.False(t, analytics.Matches[3].HasGapToNextStart)
+
+		// The cycle across the break between blocks is not counted.
+		assert.Equal(t, 1, analytics.Matches[5].BlockIndex)
+		assert.False(t, analytics.Matches[4].HasCycleTime)
+	}
+	assert.Equal(t, (330+733+330)/3, analytics.RollingAverageCycleTimeSec)
+
+	if assert.Equal(t, 1, len(analytics.BiggestDelays)) {
+		delay := analytics.BiggestDelays[0]
+		assert.Equal(t, "Q3", delay.ShortName)
+		assert.Equal(t, 373, delay.DelaySec)
+		assert.Equal(t, CycleTimeCauseFieldReset, delay.Cause)
+	}
+
+	assert.Equal(t, 1, analytics.CurrentBlockIndex)
+	if assert.Equal(t, 3, len(analytics.Blocks)) {
+		assert.Equal(t, 4, analytics.Blocks[0].NumPlayed)
+		assert.Equal(t, 464, analytics.Blocks[0].AverageCycleTimeSec)
+		assert.True(t, analytics.Blocks[0].ProjectedEndTime.IsZero())
+		assert.Equal(t, at(3600+2*464), analytics.Blocks[1].ProjectedEndTime)
+		assert.Equal(t, at(86400+2*464), analytics.Blocks[2].ProjectedEndTime)
+		assert.Equal(t, at(86400+720), analytics.Blocks[2].ScheduledEndTime)
+	}
+	assert.Equal(t, at(3600+2*464), analytics.ProjectedBlockEndTime)
+	assert.Equal(t, at(3600+2*464), analytics.ProjectedDayEndTime)
+
+	// Check that the projection moves out once the current block is underway and running behind.
+	matches[4].StartedAt = at(4000)
+	analytics = BuildCycleTimeAnalytics(matches, scheduleBlocks, at(4100))
+	assert.Equal(t, 1, analytics.CurrentBlockIndex)
+	assert.Equal(t, at(4000+464*2), analytics.ProjectedBlockEndTime)
+	assert.Equal(t, at(4000+464*2), analytics.ProjectedDayEndTime)
+
+	// Check that the scheduled spacing is used when nothing has been played yet.
+	analytics = BuildCycleTimeAnalytics(matches[4:4], scheduleBlocks, at(0))
+	assert.Equal(t, -1, analytics.CurrentBlockIndex)
+	matches[4].StartedAt = time.Time{}
+	analytics = BuildCycleTimeAnalytics(matches[4:], scheduleBlocks, at(0))
+	assert.Equal(t, 0, analytics.RollingAverageCycleTimeSec)
+	assert.Equal(t, 1, analytics.CurrentBlockIndex)
+	assert.Equal(t, at(3600+720), analytics.ProjectedBlockEndTime)
+	assert.Empty(t, analytics.BiggestDelays)
+}
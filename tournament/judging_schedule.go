@@ -14,7 +14,7 @@ import (
 
 // JudgingScheduleParams contains configuration parameters for the judging schedule generation.
 type JudgingScheduleParams struct {
-	// NumJudges is the number of judge teams operating in parallel.
+	// NumJudges is the number of judge teams operating in parallel. Only used if no judging panels are configured.
 	NumJudges int
 
 	// DurationMinutes is the duration of each judging slot in minutes.
@@ -33,11 +33,22 @@ type JudgingScheduleParams struct {
 // slot.
 type judgeSchedule struct {
 	judgeNumber int
+	panel       model.JudgingPanel
 	endTime     time.Time
 	slots       []*model.JudgingSlot
+	isFinished  bool
 }
 
-// BuildJudgingSchedule generates a judging schedule based on the given parameters and qualification match schedule.
+// judgingWindow represents a span of time during which a team is busy and cannot be judged.
+type judgingWindow struct {
+	startTime time.Time
+	endTime   time.Time
+}
+
+// BuildJudgingSchedule generates a judging schedule based on the given parameters and qualification match schedule. If
+// judging panels are configured, each team is visited once for each distinct award category among them by one of the
+// panels for that category, within the panel's availability window. Teams are never scheduled during their judging
+// blackout windows or for two visits at once. Nothing is saved unless every team can be scheduled.
 func BuildJudgingSchedule(database *model.Database, params JudgingScheduleParams) error {
 	slots, err := database.GetAllJudgingSlots()
 	if err != nil {
@@ -68,6 +79,30 @@ func BuildJudgingSchedule(database *model.Database, params JudgingScheduleParams
 		return fmt.Errorf("error getting schedule blocks: %v", err)
 	}
 
+	panels, err := database.GetAllJudgingPanels()
+	if err != nil {
+		return fmt.Errorf("error getting judging panels: %v", err)
+	}
+	if len(panels) == 0 {
+		for i := 0; i < params.NumJudges; i++ {
+			panels = append(panels, model.JudgingPanel{Name: fmt.Sprintf("Judge %d", i+1)})
+		}
+	}
+	if len(panels) == 0 {
+		return fmt.Errorf("no available judges to schedule")
+	}
+
+	blackouts, err := database.GetAllJudgingBlackouts()
+	if err != nil {
+		return fmt.Errorf("error getting judging blackouts: %v", err)
+	}
+	teamBusyWindows := make(map[int][]judgingWindow)
+	for _, blackout := range blackouts {
+		teamBusyWindows[blackout.TeamId] = append(
+			teamBusyWindows[blackout.TeamId], judgingWindow{blackout.StartTime, blackout.EndTime},
+		)
+	}
+
 	// Create a map of teams to their matches.
 	teamMatches := createTeamMatchMap(teams, matches)
 
@@ -75,13 +110,23 @@ func BuildJudgingSchedule(database *model.Database, params JudgingScheduleParams
 	startTime := matches[1].Time
 
 	// Initialize judging team schedules.
-	judgeSchedules := make([]*judgeSchedule, params.NumJudges)
-	for i := 0; i < params.NumJudges; i++ {
+	judgeSchedules := make([]*judgeSchedule, len(panels))
+	scheduledTeams := make(map[string]map[int]struct{})
+	var awardCategories []string
+	for i, panel := range panels {
 		judgeSchedules[i] = &judgeSchedule{
 			judgeNumber: i + 1,
+			panel:       panel,
 			endTime:     startTime,
 			slots:       []*model.JudgingSlot{},
 		}
+		if panel.AvailableFrom.After(startTime) {
+			judgeSchedules[i].endTime = panel.AvailableFrom
+		}
+		if _, ok := scheduledTeams[panel.AwardCategory]; !ok {
+			scheduledTeams[panel.AwardCategory] = make(map[int]struct{})
+			awardCategories = append(awardCategories, panel.AwardCategory)
+		}
 	}
 
 	// Randomly shuffle the teams to avoid bias in the scheduling.
@@ -91,28 +136,33 @@ func BuildJudgingSchedule(database *model.Database, params JudgingScheduleParams
 		},
 	)
 
-	// Loop until all teams have been scheduled.
-	scheduledTeams := make(map[int]struct{})
-	for len(scheduledTeams) < len(teams) {
-		// Select the judge with fewest scheduled visits (or first if there are multiple).
+	// Loop until all teams have been scheduled for every award category or the panels have run out of time.
+	var judgingSlots []*model.JudgingSlot
+	for {
+		// Select the judge with fewest scheduled visits (or first if there are multiple), among those that still have
+		// teams left to see.
 		var selectedJudge *judgeSchedule
 		for _, judge := range judgeSchedules {
+			if judge.isFinished || len(scheduledTeams[judge.panel.AwardCategory]) == len(teams) {
+				continue
+			}
 			if selectedJudge == nil || len(judge.slots) < len(selectedJudge.slots) {
 				selectedJudge = judge
 			}
 		}
 		if selectedJudge == nil {
-			return fmt.Errorf("no available judges to schedule")
+			break
 		}
+		categoryScheduledTeams := scheduledTeams[selectedJudge.panel.AwardCategory]
 
 		candidateTime := selectedJudge.endTime
 		var selectedSlot *model.JudgingSlot
 		for _, team := range teams {
-			if _, ok := scheduledTeams[team.Id]; ok {
+			if _, ok := categoryScheduledTeams[team.Id]; ok {
 				continue
 			}
 
-			slot := getNextSlotForTeam(team, candidateTime, teamMatches[team.Id], params)
+			slot := getNextFreeSlotForTeam(team, candidateTime, teamMatches[team.Id], teamBusyWindows[team.Id], params)
 			if selectedSlot == nil || slot.Time.Before(selectedSlot.Time) {
 				selectedSlot = &slot
 			}
@@ -122,8 +172,14 @@ func BuildJudgingSchedule(database *model.Database, params JudgingScheduleParams
 			}
 		}
 
-		// Check the validity of the selected slot with respect to the scheduled breaks.
+		// Check the validity of the selected slot with respect to the judge's availability.
 		slotEndTime := selectedSlot.Time.Add(time.Duration(params.DurationMinutes) * time.Minute)
+		if !selectedJudge.panel.AvailableUntil.IsZero() && slotEndTime.After(selectedJudge.panel.AvailableUntil) {
+			selectedJudge.isFinished = true
+			continue
+		}
+
+		// Check the validity of the selected slot with respect to the scheduled breaks.
 		validAssignment := true
 		for _, block := range scheduleBlocks {
 			blockEndTime := block.StartTime.Add(time.Duration(block.NumMatches*block.MatchSpacingSec) * time.Second)
@@ -149,18 +205,69 @@ func BuildJudgingSchedule(database *model.Database, params JudgingScheduleParams
 
 		// Update the schedule.
 		selectedSlot.JudgeNumber = selectedJudge.judgeNumber
+		selectedSlot.EndTime = slotEndTime
+		selectedSlot.PanelName = selectedJudge.panel.Name
+		selectedSlot.Room = selectedJudge.panel.Location()
+		selectedSlot.AwardCategory = selectedJudge.panel.AwardCategory
 		selectedJudge.slots = append(selectedJudge.slots, selectedSlot)
-		selectedJudge.endTime = selectedSlot.Time.Add(time.Duration(params.DurationMinutes) * time.Minute)
-		scheduledTeams[selectedSlot.TeamId] = struct{}{}
+		selectedJudge.endTime = slotEndTime
+		categoryScheduledTeams[selectedSlot.TeamId] = struct{}{}
+		teamBusyWindows[selectedSlot.TeamId] = append(
+			teamBusyWindows[selectedSlot.TeamId], judgingWindow{selectedSlot.Time, slotEndTime},
+		)
+		judgingSlots = append(judgingSlots, selectedSlot)
+	}
 
-		if err := database.CreateJudgingSlot(selectedSlot); err != nil {
-			return fmt.Errorf("error saving judging slot for team %d: %v", selectedSlot.TeamId, err)
+	// Only save the schedule once it is known to cover every team, so that a failed attempt leaves nothing behind.
+
+	for _, awardCategory := range awardCategories {
+		if numUnscheduled := len(teams) - len(scheduledTeams[awardCategory]); numUnscheduled > 0 {
+			description := "judging"
+			if awardCategory != "" {
+				description = awardCategory + " judging"
+			}
+			return fmt.Errorf(
+				"%d teams could not be scheduled for %s within the availability of the judging panels",
+				numUnscheduled,
+				description,
+			)
+		}
+	}
+	for _, slot := range judgingSlots {
+		if err := database.CreateJudgingSlot(slot); err != nil {
+			return fmt.Errorf("error saving judging slot for team %d: %v", slot.TeamId, err)
 		}
 	}
-
 	return nil
 }
 
+// getNextFreeSlotForTeam finds the next available judging slot for a team at or after the given candidate time that
+// doesn't overlap with any of the given windows during which the team is busy.
+func getNextFreeSlotForTeam(
+	team model.Team,
+	candidateTime time.Time,
+	matches []model.Match,
+	busyWindows []judgingWindow,
+	params JudgingScheduleParams,
+) model.JudgingSlot {
+	for {
+		slot := getNextSlotForTeam(team, candidateTime, matches, params)
+		slotEndTime := slot.Time.Add(time.Duration(params.DurationMinutes) * time.Minute)
+		isConflicting := false
+		for _, window := range busyWindows {
+			if slot.Time.Before(window.endTime) && slotEndTime.After(window.startTime) {
+				// Try again starting from the end of the conflicting window.
+				candidateTime = window.endTime
+				isConflicting = true
+				break
+			}
+		}
+		if !isConflicting {
+			return slot
+		}
+	}
+}
+
 // createTeamMatchMap creates a map of team IDs to their scheduled qualification matches.
 func createTeamMatchMap(teams []model.Team, matches []model.Match) map[int][]model.Match {
 	teamMatches := make(map[int][]model.Match)
@@ -169,12 +276,11 @@ func createTeamMatchMap(teams []model.Team, matches []model.Match) map[int][]mod
 	}
 
 	for _, match := range matches {
-		teamMatches[match.Red1] = append(teamMatches[match.Red1], match)
-		teamMatches[match.Red2] = append(teamMatches[match.Red2], match)
-		teamMatches[match.Red3] = append(teamMatches[match.Red3], match)
-		teamMatches[match.Blue1] = append(teamMatches[match.Blue1], match)
-		teamMatches[match.Blue2] = append(teamMatches[match.Blue2], match)
-		teamMatches[match.Blue3] = append(teamMatches[match.Blue3], match)
+		for _, teamId := range match.TeamIds() {
+			if teamId > 0 {
+				teamMatches[teamId] = append(teamMatches[teamId], match)
+			}
+		}
 	}
 
 	return teamMatches
@@ -222,7 +328,14 @@ func getNextSlotForTeam(
 	}
 
 	// If we get here, the team can only be scheduled once all matches are complete.
-	candidateTime = previousMatch.Time.Add(time.Duration(params.PreviousSpacingMinutes) * time.Minute)
+	if previousMatch == nil {
+		return model.JudgingSlot{Time: candidateTime, TeamId: team.Id}
+	}
+	if earliestTime := previousMatch.Time.Add(
+		time.Duration(params.PreviousSpacingMinutes) * time.Minute,
+	); earliestTime.After(candidateTime) {
+		candidateTime = earliestTime
+	}
 	return model.JudgingSlot{
 		Time:                candidateTime,
 		TeamId:              team.Id,
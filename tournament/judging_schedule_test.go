@@ -62,15 +62,21 @@ func TestBuildJudgingSchedule(t *testing.T) {
 	for _, block := range scheduleBlocks {
 		assert.Nil(t, database.CreateScheduleBlock(&block))
 	}
-	matches, err := BuildRandomSchedule(teams, scheduleBlocks, model.Qualification)
+	matches, err := BuildRandomSchedule(teams, scheduleBlocks, model.Qualification, 3)
 	assert.Nil(t, err)
 	for _, match := range matches {
 		assert.Nil(t, database.CreateMatch(&match))
 	}
 
+	// Test error when there are neither judging panels nor judges.
+	err = BuildJudgingSchedule(database, JudgingScheduleParams{DurationMinutes: 23})
+	assert.Contains(t, err.Error(), "no available judges to schedule")
+	slots, _ := database.GetAllJudgingSlots()
+	assert.Empty(t, slots)
+
 	err = BuildJudgingSchedule(database, params)
 	assert.Nil(t, err)
-	slots, err := database.GetAllJudgingSlots()
+	slots, err = database.GetAllJudgingSlots()
 	assert.Nil(t, err)
 	assert.Equal(t, 24, len(slots))
 	judgeTeamCounts := make(map[int]int)
@@ -106,3 +112,95 @@ func TestBuildJudgingSchedule(t *testing.T) {
 		assert.Equal(t, 8, judgeTeamCounts[3])
 	}
 }
+
+func TestBuildJudgingScheduleWithPanels(t *testing.T) {
+	rand.Seed(0)
+	database := setupTestDb(t)
+
+	for i := 1; i <= 18; i++ {
+		assert.Nil(t, database.CreateTeam(&model.Team{Id: i}))
+	}
+	teams, _ := database.GetAllTeams()
+	scheduleBlock := model.ScheduleBlock{
+		MatchType:       model.Qualification,
+		StartTime:       time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC),
+		NumMatches:      36,
+		MatchSpacingSec: 600,
+	}
+	assert.Nil(t, database.CreateScheduleBlock(&scheduleBlock))
+	matches, err := BuildRandomSchedule(teams, []model.ScheduleBlock{scheduleBlock}, model.Qualification, 3)
+	assert.Nil(t, err)
+	for _, match := range matches {
+		assert.Nil(t, database.CreateMatch(&match))
+	}
+
+	panelBUntil := time.Date(2025, 4, 1, 11, 0, 0, 0, time.UTC)
+	assert.Nil(t, database.CreateJudgingPanel(&model.JudgingPanel{Name: "Panel A"}))
+	assert.Nil(t, database.CreateJudgingPanel(&model.JudgingPanel{Name: "Panel B", AvailableUntil: panelBUntil}))
+	impactPanel := model.JudgingPanel{
+		Name:          "Impact Panel",
+		Room:          "Room 2",
+		AwardCategory: "Impact",
+		AvailableFrom: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+	}
+	assert.Nil(t, database.CreateJudgingPanel(&impactPanel))
+	blackout := model.JudgingBlackout{
+		TeamId:    5,
+		StartTime: time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC),
+		Reason:    "Volunteering",
+	}
+	assert.Nil(t, database.CreateJudgingBlackout(&blackout))
+
+	params := JudgingScheduleParams{DurationMinutes: 10, PreviousSpacingMinutes: 10, NextSpacingMinutes: 10}
+	assert.Nil(t, BuildJudgingSchedule(database, params))
+	slots, err := database.GetAllJudgingSlots()
+	assert.Nil(t, err)
+	assert.Equal(t, 36, len(slots))
+	teamSlots := make(map[int][]model.JudgingSlot)
+	for _, slot := range slots {
+		teamSlots[slot.TeamId] = append(teamSlots[slot.TeamId], slot)
+		assert.Equal(t, slot.Time.Add(10*time.Minute), slot.EndTime)
+		switch slot.PanelName {
+		case "Panel A":
+			assert.Equal(t, 1, slot.JudgeNumber)
+			assert.Equal(t, "Pits", slot.Room)
+			assert.Equal(t, "", slot.AwardCategory)
+		case "Panel B":
+			assert.Equal(t, 2, slot.JudgeNumber)
+			assert.Equal(t, "", slot.AwardCategory)
+			assert.False(t, slot.EndTime.After(panelBUntil))
+		case "Impact Panel":
+			assert.Equal(t, 3, slot.JudgeNumber)
+			assert.Equal(t, "Room 2", slot.Room)
+			assert.Equal(t, "Impact", slot.AwardCategory)
+			assert.False(t, slot.Time.Before(impactPanel.AvailableFrom))
+		default:
+			assert.Fail(t, "unexpected panel", slot.PanelName)
+		}
+		if slot.TeamId == 5 {
+			assert.False(t, slot.Time.Before(blackout.EndTime))
+		}
+	}
+	for _, team := range teams {
+		if assert.Equal(t, 2, len(teamSlots[team.Id]), "team %d", team.Id) {
+			first, second := teamSlots[team.Id][0], teamSlots[team.Id][1]
+			assert.NotEqual(t, first.AwardCategory, second.AwardCategory)
+			assert.False(t, second.Time.Before(first.EndTime), "team %d has overlapping visits", team.Id)
+		}
+	}
+
+	// Check that an error is returned if the panels for a category run out of time.
+	assert.Nil(t, database.TruncateJudgingSlots())
+	impactPanel.Id = 0
+	impactPanel.AvailableUntil = impactPanel.AvailableFrom.Add(time.Hour)
+	assert.Nil(t, database.TruncateJudgingPanels())
+	assert.Nil(t, database.CreateJudgingPanel(&model.JudgingPanel{Name: "Panel A"}))
+	assert.Nil(t, database.CreateJudgingPanel(&impactPanel))
+	err = BuildJudgingSchedule(database, params)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "teams could not be scheduled for Impact judging within the availability")
+	}
+	slots, _ = database.GetAllJudgingSlots()
+	assert.Empty(t, slots)
+}
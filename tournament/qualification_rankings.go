@@ -21,30 +21,19 @@ func CalculateRankings(database *model.Database, preservePreviousRank bool) (gam
 	}
 	rankings := make(map[int]*game.Ranking)
 	for _, match := range matches {
-		if !match.IsComplete() {
+		if !match.IsComplete() || match.IsSuperseded() {
+			// Only the final play of a replayed match counts towards the rankings.
 			continue
 		}
 		matchResult, err := database.GetMatchResultForMatch(match.Id)
 		if err != nil {
 			return nil, err
 		}
-		if !match.Red1IsSurrogate {
-			addMatchResultToRankings(rankings, match.Red1, matchResult, true)
-		}
-		if !match.Red2IsSurrogate {
-			addMatchResultToRankings(rankings, match.Red2, matchResult, true)
-		}
-		if !match.Red3IsSurrogate {
-			addMatchResultToRankings(rankings, match.Red3, matchResult, true)
-		}
-		if !match.Blue1IsSurrogate {
-			addMatchResultToRankings(rankings, match.Blue1, matchResult, false)
-		}
-		if !match.Blue2IsSurrogate {
-			addMatchResultToRankings(rankings, match.Blue2, matchResult, false)
-		}
-		if !match.Blue3IsSurrogate {
-			addMatchResultToRankings(rankings, match.Blue3, matchResult, false)
+		for _, station := range model.AllianceStationNames(model.MaxTeamsPerAlliance) {
+			teamId := match.TeamIdForStation(station)
+			if teamId > 0 && !match.IsSurrogateForStation(station) {
+				addMatchResultToRankings(rankings, teamId, matchResult, station[0] == 'R')
+			}
 		}
 	}
 
@@ -0,0 +1,164 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Functions for scheduling replays of qualification matches that officials have ruled must be played again.
+
+package tournament
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// ScheduleQualificationReplay marks the given committed qualification match as requiring a replay for the given reason
+// and creates the replay match with the same teams, inserted at the given order in the schedule. All subsequent matches
+// and breaks are renumbered to make room for it. The original match keeps its result for the record, but is superseded
+// by the replay for the purposes of rankings and publishing. Returns the newly created replay match.
+func ScheduleQualificationReplay(
+	database *model.Database, matchId int, reason string, order int,
+) (*model.Match, error) {
+	match, err := database.GetMatchById(matchId)
+	if err != nil {
+		return nil, err
+	}
+	if match == nil {
+		return nil, fmt.Errorf("match %d does not exist", matchId)
+	}
+	if match.Type != model.Qualification {
+		return nil, fmt.Errorf("only qualification matches can be replayed; %s is a %s match", match.ShortName, match.Type)
+	}
+	if !match.IsComplete() {
+		return nil, fmt.Errorf("match %s cannot be replayed because it has not been committed", match.ShortName)
+	}
+	if match.IsSuperseded() {
+		return nil, fmt.Errorf("match %s has already been marked for replay", match.ShortName)
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, fmt.Errorf("a reason must be given for replaying match %s", match.ShortName)
+	}
+
+	matches, err := database.GetMatchesByType(model.Qualification, true)
+	if err != nil {
+		return nil, err
+	}
+	lastPlayedOrder := 0
+	for _, existingMatch := range matches {
+		if existingMatch.IsComplete() {
+			lastPlayedOrder = max(lastPlayedOrder, existingMatch.TypeOrder)
+		}
+	}
+	endOrder := matches[len(matches)-1].TypeOrder + 1
+	if order <= lastPlayedOrder || order > endOrder {
+		return nil, fmt.Errorf(
+			"replay must be inserted after the last played match and no later than the end of the schedule (order %d "+
+				"to %d); got %d",
+			lastPlayedOrder+1,
+			endOrder,
+			order,
+		)
+	}
+
+	// Name the replay after the originally scheduled match, numbering it if that match has been replayed before.
+	original := match
+	numReplays := 0
+	for original.ReplayOfMatchId > 0 {
+		numReplays++
+		if original, err = database.GetMatchById(original.ReplayOfMatchId); err != nil {
+			return nil, err
+		}
+		if original == nil {
+			return nil, fmt.Errorf("original match for replay %s does not exist", match.ShortName)
+		}
+	}
+	longName := original.LongName + " Replay"
+	shortName := original.ShortName + "R"
+	if numReplays > 0 {
+		longName += fmt.Sprintf(" %d", numReplays+1)
+		shortName += fmt.Sprintf("%d", numReplays+1)
+	}
+
+	// The replay takes over the scheduled slot of the match it is inserted before, or follows the last match.
+	replayTime := matches[len(matches)-1].Time
+	for _, existingMatch := range matches {
+		if existingMatch.TypeOrder >= order {
+			replayTime = existingMatch.Time
+			break
+		}
+	}
+
+	if err = shiftQualificationOrders(database, matches, order); err != nil {
+		return nil, err
+	}
+	replay := model.Match{
+		Type:                model.Qualification,
+		TypeOrder:           order,
+		Time:                replayTime,
+		LongName:            longName,
+		ShortName:           shortName,
+		Red1:                match.Red1,
+		Red1IsSurrogate:     match.Red1IsSurrogate,
+		Red2:                match.Red2,
+		Red2IsSurrogate:     match.Red2IsSurrogate,
+		Red3:                match.Red3,
+		Red3IsSurrogate:     match.Red3IsSurrogate,
+		Red4:                match.Red4,
+		Red4IsSurrogate:     match.Red4IsSurrogate,
+		Blue1:               match.Blue1,
+		Blue1IsSurrogate:    match.Blue1IsSurrogate,
+		Blue2:               match.Blue2,
+		Blue2IsSurrogate:    match.Blue2IsSurrogate,
+		Blue3:               match.Blue3,
+		Blue3IsSurrogate:    match.Blue3IsSurrogate,
+		Blue4:               match.Blue4,
+		Blue4IsSurrogate:    match.Blue4IsSurrogate,
+		Status:              game.MatchScheduled,
+		UseTiebreakCriteria: match.UseTiebreakCriteria,
+		IsReplay:            true,
+		ReplayOfMatchId:     match.Id,
+		TbaMatchKey:         match.TbaMatchKey,
+	}
+	if err = database.CreateMatch(&replay); err != nil {
+		return nil, err
+	}
+
+	// Re-read the original match since its order may have been shifted above.
+	if match, err = database.GetMatchById(match.Id); err != nil {
+		return nil, err
+	}
+	match.ReplayMatchId = replay.Id
+	match.ReplayReason = reason
+	if err = database.UpdateMatch(match); err != nil {
+		return nil, err
+	}
+	return &replay, nil
+}
+
+// shiftQualificationOrders renumbers the given saved qualification matches and the breaks at or after the given order
+// to make room for a match to be inserted there.
+func shiftQualificationOrders(database *model.Database, matches []model.Match, order int) error {
+	for _, match := range matches {
+		if match.TypeOrder >= order {
+			match.TypeOrder++
+			if err := database.UpdateMatch(&match); err != nil {
+				return err
+			}
+		}
+	}
+
+	scheduledBreaks, err := database.GetScheduledBreaksByMatchType(model.Qualification)
+	if err != nil {
+		return err
+	}
+	for _, scheduledBreak := range scheduledBreaks {
+		if scheduledBreak.TypeOrderBefore >= order {
+			scheduledBreak.TypeOrderBefore++
+			if err = database.UpdateScheduledBreak(&scheduledBreak); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
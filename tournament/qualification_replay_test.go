@@ -0,0 +1,113 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package tournament
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleQualificationReplay(t *testing.T) {
+	database := setupTestDb(t)
+	startTime := time.Unix(1000, 0)
+	for i := 1; i <= 5; i++ {
+		match := model.Match{
+			Type:             model.Qualification,
+			TypeOrder:        i,
+			Time:             startTime.Add(time.Duration(i) * 6 * time.Minute),
+			LongName:         fmt.Sprintf("Qualification %d", i),
+			ShortName:        fmt.Sprintf("Q%d", i),
+			Red1:             1,
+			Red2:             2,
+			Red3:             3,
+			Blue1:            4,
+			Blue2:            5,
+			Blue3:            6,
+			Blue3IsSurrogate: i == 2,
+			Status:           game.MatchScheduled,
+			TbaMatchKey:      model.TbaMatchKey{CompLevel: "qm", MatchNumber: i},
+		}
+		if i <= 2 {
+			match.Status = game.RedWonMatch
+		}
+		assert.Nil(t, database.CreateMatch(&match))
+		if i <= 2 {
+			assert.Nil(t, database.CreateMatchResult(model.BuildTestMatchResult(match.Id, 1)))
+		}
+	}
+	assert.Nil(
+		t,
+		database.CreateScheduledBreak(
+			&model.ScheduledBreak{MatchType: model.Qualification, TypeOrderBefore: 4, Description: "Lunch"},
+		),
+	)
+
+	// Check the validation of the request.
+	_, err := ScheduleQualificationReplay(database, 100, "Field fault", 3)
+	assert.EqualError(t, err, "match 100 does not exist")
+	_, err = ScheduleQualificationReplay(database, 3, "Field fault", 4)
+	assert.EqualError(t, err, "match Q3 cannot be replayed because it has not been committed")
+	_, err = ScheduleQualificationReplay(database, 2, " ", 3)
+	assert.EqualError(t, err, "a reason must be given for replaying match Q2")
+	_, err = ScheduleQualificationReplay(database, 2, "Field fault", 2)
+	assert.EqualError(
+		t, err, "replay must be inserted after the last played match and no later than the end of the schedule "+
+			"(order 3 to 6); got 2",
+	)
+	_, err = ScheduleQualificationReplay(database, 2, "Field fault", 7)
+	assert.NotNil(t, err)
+
+	// Insert the replay before the fourth match.
+	replay, err := ScheduleQualificationReplay(database, 2, "Field fault", 4)
+	assert.Nil(t, err)
+	assert.Equal(t, "Q2R", replay.ShortName)
+	assert.Equal(t, "Qualification 2 Replay", replay.LongName)
+	assert.Equal(t, 4, replay.TypeOrder)
+	assert.Equal(t, startTime.Add(24*time.Minute).Unix(), replay.Time.Unix())
+	assert.True(t, replay.IsReplay)
+	assert.True(t, replay.Blue3IsSurrogate)
+	assert.Equal(t, 2, replay.ReplayOfMatchId)
+	assert.Equal(t, model.TbaMatchKey{CompLevel: "qm", MatchNumber: 2}, replay.TbaMatchKey)
+	matches, _ := database.GetMatchesByType(model.Qualification, true)
+	if assert.Equal(t, 6, len(matches)) {
+		for i, shortName := range []string{"Q1", "Q2", "Q3", "Q2R", "Q4", "Q5"} {
+			assert.Equal(t, shortName, matches[i].ShortName)
+			assert.Equal(t, i+1, matches[i].TypeOrder)
+		}
+		assert.True(t, matches[1].IsSuperseded())
+		assert.Equal(t, replay.Id, matches[1].ReplayMatchId)
+		assert.Equal(t, "Field fault", matches[1].ReplayReason)
+		assert.Equal(t, game.RedWonMatch, matches[1].Status)
+	}
+	scheduledBreaks, _ := database.GetScheduledBreaksByMatchType(model.Qualification)
+	if assert.Equal(t, 1, len(scheduledBreaks)) {
+		assert.Equal(t, 5, scheduledBreaks[0].TypeOrderBefore)
+	}
+
+	// The original result should be kept but no longer count towards the rankings.
+	matchResult, _ := database.GetMatchResultForMatch(2)
+	assert.NotNil(t, matchResult)
+	rankings, err := CalculateRankings(database, false)
+	assert.Nil(t, err)
+	if assert.Equal(t, 6, len(rankings)) {
+		assert.Equal(t, 1, rankings[0].Played)
+	}
+
+	_, err = ScheduleQualificationReplay(database, 2, "Field fault", 5)
+	assert.EqualError(t, err, "match Q2 has already been marked for replay")
+
+	// Replaying the replay should number it and allow it to be appended to the end of the schedule.
+	replay.Status = game.BlueWonMatch
+	assert.Nil(t, database.UpdateMatch(replay))
+	replay2, err := ScheduleQualificationReplay(database, replay.Id, "Scoring error", 7)
+	assert.Nil(t, err)
+	assert.Equal(t, "Q2R2", replay2.ShortName)
+	assert.Equal(t, "Qualification 2 Replay 2", replay2.LongName)
+	assert.Equal(t, 7, replay2.TypeOrder)
+	assert.Equal(t, startTime.Add(30*time.Minute).Unix(), replay2.Time.Unix())
+}
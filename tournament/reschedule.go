@@ -0,0 +1,187 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Functions for regenerating the unplayed remainder of a schedule after the team list has changed mid-event.
+
+package tournament
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// BuildRemainingSchedule regenerates the part of the given existing schedule that has not yet been played, for the
+// current list of teams. Every match up to and including the last completed one is kept as is, and the remaining ones
+// are replaced with newly generated matches that bring each team's total as close as possible to an equal count,
+// adding surrogate appearances where needed to fill out the final matches. The new matches continue the numbering and
+// timing of the existing schedule within the given schedule blocks. Returns the matches to be kept and the new ones.
+func BuildRemainingSchedule(
+	teams []model.Team,
+	scheduleBlocks []model.ScheduleBlock,
+	existingMatches []model.Match,
+	matchType model.MatchType,
+	teamsPerAlliance int,
+) ([]model.Match, []model.Match, error) {
+	numTeams := len(teams)
+	teamsPerMatch := 2 * teamsPerAlliance
+	if numTeams < teamsPerMatch {
+		return nil, nil, fmt.Errorf("must have at least %d teams to generate a schedule", teamsPerMatch)
+	}
+
+	// Keep every match up to and including the last completed one.
+	numKeptMatches := 0
+	for i, match := range existingMatches {
+		if match.IsComplete() {
+			numKeptMatches = i + 1
+		}
+	}
+	keptMatches := existingMatches[:numKeptMatches:numKeptMatches]
+	nextMatchNumber := 1
+	if numKeptMatches > 0 {
+		nextMatchNumber = keptMatches[numKeptMatches-1].TypeOrder + 1
+	}
+	numAvailableMatches := countMatches(scheduleBlocks) - (nextMatchNumber - 1)
+	if numAvailableMatches <= 0 {
+		return nil, nil, fmt.Errorf(
+			"the schedule blocks have no room for any more matches after the %d already played", nextMatchNumber-1,
+		)
+	}
+
+	// Count the matches already played (or kept to be played) by each team on the current list, not counting surrogate
+	// appearances. Teams that are no longer on the list are left out.
+	teamIndices := make(map[int]int)
+	for i, team := range teams {
+		teamIndices[team.Id] = i
+	}
+	playedCounts := make([]int, numTeams)
+	for _, match := range keptMatches {
+		for _, station := range model.AllianceStationNames(model.MaxTeamsPerAlliance) {
+			if index, ok := teamIndices[match.TeamIdForStation(station)]; ok && !match.IsSurrogateForStation(station) {
+				playedCounts[index]++
+			}
+		}
+	}
+
+	// Find the highest total number of matches per team that fits within the remaining room in the schedule blocks.
+	var neededCounts []int
+	numNewMatches := 0
+	for targetCount := slices.Max(playedCounts) + numAvailableMatches; targetCount > 0; targetCount-- {
+		neededCounts, numNewMatches = remainingMatchCounts(playedCounts, targetCount, teamsPerMatch)
+		if numNewMatches <= numAvailableMatches {
+			break
+		}
+	}
+	if numNewMatches == 0 || numNewMatches > numAvailableMatches {
+		return nil, nil, fmt.Errorf("there is no room in the schedule blocks to give any team another match")
+	}
+
+	// Give the leftover appearances needed to fill out the new matches to teams as surrogates, spreading them evenly
+	// among the teams that have room for them.
+	numSurrogates := numNewMatches*teamsPerMatch - sumInts(neededCounts)
+	surrogateCounts := make([]int, numTeams)
+	for numSurrogates > 0 {
+		assigned := false
+		for _, team := range rand.Perm(numTeams) {
+			if numSurrogates > 0 && neededCounts[team]+surrogateCounts[team] < numNewMatches &&
+				surrogateCounts[team] == minSurrogateCount(surrogateCounts, neededCounts, numNewMatches) {
+				surrogateCounts[team]++
+				numSurrogates--
+				assigned = true
+			}
+		}
+		if !assigned {
+			return nil, nil, fmt.Errorf("could not fill %d new matches with %d teams", numNewMatches, numTeams)
+		}
+	}
+
+	// Lay out the appearances in randomly shuffled rounds and optimize them in the same way as a full schedule.
+	slots := make([]scheduleSlot, 0, numNewMatches*teamsPerMatch)
+	maxNeededCount := slices.Max(neededCounts)
+	for round := 0; round <= maxNeededCount; round++ {
+		if round == surrogateAppearanceIndex(maxNeededCount) {
+			for _, team := range rand.Perm(numTeams) {
+				for i := 0; i < surrogateCounts[team]; i++ {
+					slots = append(slots, scheduleSlot{team, true})
+				}
+			}
+		}
+		for _, team := range rand.Perm(numTeams) {
+			if neededCounts[team] > round {
+				slots = append(slots, scheduleSlot{team, false})
+			}
+		}
+	}
+	optimizer := newScheduleOptimizer(
+		groupScheduleSlots(slots, teamsPerMatch), numTeams, maxNeededCount, teamsPerAlliance,
+	)
+	matchTimes := scheduleMatchTimes(scheduleBlocks, nextMatchNumber-1+numNewMatches)[nextMatchNumber-1:]
+	if hasAvailabilityConstraints(teams) {
+		availableMatches := make([][2]int, numTeams)
+		for i, team := range teams {
+			first, last := availableMatchRange(team, matchTimes)
+			availableMatches[i] = [2]int{first, last}
+		}
+		optimizer.setAvailableMatches(availableMatches)
+	}
+	optimizer.optimize(scheduleOptimizationIterationsPerMatch * numNewMatches)
+	if quality := optimizer.quality(); quality.NumDuplicateTeams > 0 {
+		return nil, nil, fmt.Errorf(
+			"could not generate %d valid matches for the remaining %d teams", numNewMatches, numTeams,
+		)
+	}
+	if unavailableTeams := optimizer.unavailableTeams(); len(unavailableTeams) > 0 {
+		descriptions := make([]string, len(unavailableTeams))
+		for i, team := range unavailableTeams {
+			descriptions[i] = describeAvailability(teams[team])
+		}
+		return nil, nil, fmt.Errorf(
+			"could not reschedule the remaining matches such that every team plays only while available; unable to "+
+				"accommodate %s",
+			strings.Join(descriptions, ", "),
+		)
+	}
+
+	newMatches := make([]model.Match, numNewMatches)
+	for i, anonMatch := range optimizer.anonSchedule() {
+		if err := setScheduleMatchNumber(&newMatches[i], matchType, nextMatchNumber+i); err != nil {
+			return nil, nil, err
+		}
+		setAnonMatchTeams(&newMatches[i], anonMatch, func(anonTeam int) int { return teams[anonTeam].Id })
+		newMatches[i].Time = matchTimes[i]
+	}
+	return keptMatches, newMatches, nil
+}
+
+// remainingMatchCounts returns the number of further matches each team needs to reach the given total, along with the
+// number of matches needed to fit them all given that a team can only appear once per match.
+func remainingMatchCounts(playedCounts []int, targetCount, teamsPerMatch int) ([]int, int) {
+	neededCounts := make([]int, len(playedCounts))
+	for i, playedCount := range playedCounts {
+		neededCounts[i] = max(0, targetCount-playedCount)
+	}
+	numMatches := int(math.Ceil(float64(sumInts(neededCounts)) / float64(teamsPerMatch)))
+	return neededCounts, max(numMatches, slices.Max(neededCounts))
+}
+
+// minSurrogateCount returns the fewest surrogate appearances given to any team that still has room for another.
+func minSurrogateCount(surrogateCounts, neededCounts []int, numMatches int) int {
+	minCount := -1
+	for i, count := range surrogateCounts {
+		if neededCounts[i]+count < numMatches && (minCount < 0 || count < minCount) {
+			minCount = count
+		}
+	}
+	return minCount
+}
+
+func sumInts(values []int) int {
+	sum := 0
+	for _, value := range values {
+		sum += value
+	}
+	return sum
+}
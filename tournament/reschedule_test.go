@@ -0,0 +1,84 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package tournament
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildRemainingSchedule(t *testing.T) {
+	rand.Seed(0)
+
+	teams := make([]model.Team, 18)
+	for i := range teams {
+		teams[i].Id = i + 101
+	}
+	scheduleBlocks := []model.ScheduleBlock{
+		{MatchType: model.Qualification, StartTime: time.Unix(0, 0).UTC(), NumMatches: 36, MatchSpacingSec: 60},
+	}
+	matches, err := BuildRandomSchedule(teams, scheduleBlocks, model.Qualification, 3)
+	assert.Nil(t, err)
+	for i := 0; i < 12; i++ {
+		matches[i].Status = game.RedWonMatch
+	}
+
+	// Withdraw one team and add a walk-on.
+	teams = append(teams[1:], model.Team{Id: 254})
+	keptMatches, newMatches, err := BuildRemainingSchedule(teams, scheduleBlocks, matches, model.Qualification, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, matches[:12], keptMatches)
+	if assert.Equal(t, 22, len(newMatches)) {
+		assert.Equal(t, 13, newMatches[0].TypeOrder)
+		assert.Equal(t, "Q13", newMatches[0].ShortName)
+		assert.Equal(t, "Qualification 13", newMatches[0].LongName)
+		assert.Equal(t, model.TbaMatchKey{CompLevel: "qm", MatchNumber: 13}, newMatches[0].TbaMatchKey)
+		assert.Equal(t, time.Unix(720, 0).UTC(), newMatches[0].Time)
+		assert.Equal(t, "Q34", newMatches[21].ShortName)
+		assert.Equal(t, time.Unix(1980, 0).UTC(), newMatches[21].Time)
+	}
+	quality := EvaluateSchedule(newMatches, 3)
+	assert.Equal(t, 0, quality.NumDuplicateTeams)
+	assert.LessOrEqual(t, quality.NumSurrogates, 5)
+
+	// Check that every current team ends up with the same number of matches and the withdrawn team gets no more.
+	totals := make(map[int]int)
+	allMatches := append(keptMatches, newMatches...)
+	for _, stats := range BuildTeamScheduleStats(allMatches, 3, DefaultScheduleWarningThresholds) {
+		totals[stats.TeamId] = stats.NumMatches - len(stats.SurrogateMatches)
+	}
+	for _, team := range teams {
+		assert.Equal(t, 11, totals[team.Id], "team %d", team.Id)
+	}
+	for _, match := range newMatches {
+		for _, teamId := range []int{match.Red1, match.Red2, match.Red3, match.Blue1, match.Blue2, match.Blue3} {
+			assert.NotEqual(t, 101, teamId)
+		}
+	}
+
+	// Check that the whole schedule is regenerated if nothing has been played yet.
+	for i := range matches {
+		matches[i].Status = game.MatchScheduled
+	}
+	keptMatches, newMatches, err = BuildRemainingSchedule(teams, scheduleBlocks, matches, model.Qualification, 3)
+	assert.Nil(t, err)
+	assert.Empty(t, keptMatches)
+	if assert.Equal(t, 36, len(newMatches)) {
+		assert.Equal(t, "Q1", newMatches[0].ShortName)
+	}
+
+	// Check that there must be room left in the schedule blocks.
+	for i := range matches {
+		matches[i].Status = game.BlueWonMatch
+	}
+	_, _, err = BuildRemainingSchedule(teams, scheduleBlocks, matches, model.Qualification, 3)
+	assert.EqualError(t, err, "the schedule blocks have no room for any more matches after the 36 already played")
+
+	_, _, err = BuildRemainingSchedule(teams[:5], scheduleBlocks, matches, model.Qualification, 3)
+	assert.EqualError(t, err, "must have at least 6 teams to generate a schedule")
+}
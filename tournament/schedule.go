@@ -10,35 +10,44 @@ import (
 	"fmt"
 	"github.com/Team254/cheesy-arena/model"
 	"math"
-	"math/rand"
 	"os"
 	"path/filepath"
 	"strconv"
 	"time"
 )
 
-const (
-	schedulesDir  = "schedules"
-	TeamsPerMatch = 6
-)
+const schedulesDir = "schedules"
 
-// Creates a random schedule for the given parameters and returns it as a list of matches.
+// Creates a random schedule for the given parameters and returns it as a list of matches. Uses the precomputed schedule
+// template for the number of teams and matches per team if one exists, and otherwise generates one from scratch.
 func BuildRandomSchedule(
-	teams []model.Team, scheduleBlocks []model.ScheduleBlock, matchType model.MatchType,
+	teams []model.Team, scheduleBlocks []model.ScheduleBlock, matchType model.MatchType, teamsPerAlliance int,
 ) ([]model.Match, error) {
-	// Load the anonymized, pre-randomized match schedule for the given number of teams and matches per team.
-	numTeams := len(teams)
-	numMatches := countMatches(scheduleBlocks)
-	matchesPerTeam := int(float32(numMatches*TeamsPerMatch) / float32(numTeams))
+	numTeams, numMatches, matchesPerTeam := scheduleDimensions(teams, scheduleBlocks, teamsPerAlliance)
 
-	// Adjust the number of matches to remove any excess from non-perfect block scheduling.
-	numMatches = int(math.Ceil(float64(numTeams) * float64(matchesPerTeam) / TeamsPerMatch))
-
-	file, err := os.Open(
-		fmt.Sprintf("%s/%d_%d.csv", filepath.Join(model.BaseDir, schedulesDir), numTeams, matchesPerTeam),
-	)
+	// Load the anonymized, pre-randomized match schedule for the given number of teams and matches per team. The
+	// templates only exist for the standard number of teams per alliance.
+	var file *os.File
+	err := fmt.Errorf("no schedule templates exist for %d teams per alliance", teamsPerAlliance)
+	if teamsPerAlliance == model.DefaultTeamsPerAlliance {
+		file, err = os.Open(
+			fmt.Sprintf("%s/%d_%d.csv", filepath.Join(model.BaseDir, schedulesDir), numTeams, matchesPerTeam),
+		)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("No schedule template exists for %d teams and %d matches", numTeams, matchesPerTeam)
+		if numTeams < 2*teamsPerAlliance || matchesPerTeam < 1 {
+			return nil, fmt.Errorf("No schedule template exists for %d teams and %d matches", numTeams, matchesPerTeam)
+		}
+		anonSchedule, err := generateAnonSchedule(numTeams, matchesPerTeam, teamsPerAlliance)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"No schedule template exists for %d teams and %d matches and one could not be generated: %s",
+				numTeams,
+				matchesPerTeam,
+				err.Error(),
+			)
+		}
+		return assignScheduleTeams(teams, scheduleBlocks, matchType, teamsPerAlliance, anonSchedule)
 	}
 	defer file.Close()
 	reader := csv.NewReader(file)
@@ -51,9 +60,10 @@ func BuildRandomSchedule(
 	}
 
 	// Convert string fields from schedule to integers.
-	anonSchedule := make([][12]int, numMatches)
+	anonSchedule := make([][]int, numMatches)
 	for i := 0; i < numMatches; i++ {
-		for j := 0; j < 12; j++ {
+		anonSchedule[i] = make([]int, 4*teamsPerAlliance)
+		for j := range anonSchedule[i] {
 			anonSchedule[i][j], err = strconv.Atoi(csvLines[i][j])
 			if err != nil {
 				return nil, err
@@ -61,48 +71,124 @@ func BuildRandomSchedule(
 		}
 	}
 
-	// Generate a random permutation of the team ordering to fill into the pre-randomized schedule.
-	teamShuffle := rand.Perm(numTeams)
+	return assignScheduleTeams(teams, scheduleBlocks, matchType, teamsPerAlliance, anonSchedule)
+}
+
+// Creates a schedule for the given parameters from scratch using the native schedule generator, regardless of whether a
+// precomputed template exists, and returns it as a list of matches.
+func BuildGeneratedSchedule(
+	teams []model.Team, scheduleBlocks []model.ScheduleBlock, matchType model.MatchType, teamsPerAlliance int,
+) ([]model.Match, error) {
+	numTeams, _, matchesPerTeam := scheduleDimensions(teams, scheduleBlocks, teamsPerAlliance)
+	anonSchedule, err := generateAnonSchedule(numTeams, matchesPerTeam, teamsPerAlliance)
+	if err != nil {
+		return nil, err
+	}
+	return assignScheduleTeams(teams, scheduleBlocks, matchType, teamsPerAlliance, anonSchedule)
+}
+
+// Assigns the given matches to the given number of fields in turn, so that consecutive matches alternate between the
+// fields. With a single field the matches are left unassigned, such that they can be played on any field.
+func AssignMatchFields(matches []model.Match, numFields int) {
+	for i := range matches {
+		if numFields > 1 {
+			matches[i].FieldNumber = i%numFields + 1
+		} else {
+			matches[i].FieldNumber = 0
+		}
+	}
+}
+
+// Returns the number of teams, the number of matches and the number of matches per team for a schedule of the given
+// teams within the given blocks.
+func scheduleDimensions(
+	teams []model.Team, scheduleBlocks []model.ScheduleBlock, teamsPerAlliance int,
+) (int, int, int) {
+	numTeams := len(teams)
+	numMatches := countMatches(scheduleBlocks)
+	teamsPerMatch := 2 * teamsPerAlliance
+	matchesPerTeam := int(float32(numMatches*teamsPerMatch) / float32(numTeams))
+
+	// Adjust the number of matches to remove any excess from non-perfect block scheduling.
+	numMatches = int(math.Ceil(float64(numTeams) * float64(matchesPerTeam) / float64(teamsPerMatch)))
+	return numTeams, numMatches, matchesPerTeam
+}
+
+// Fills the given teams into the anonymized schedule in a random order and returns the resulting list of matches.
+func assignScheduleTeams(
+	teams []model.Team,
+	scheduleBlocks []model.ScheduleBlock,
+	matchType model.MatchType,
+	teamsPerAlliance int,
+	anonSchedule [][]int,
+) ([]model.Match, error) {
+	numMatches := len(anonSchedule)
+
+	matchTimes := scheduleMatchTimes(scheduleBlocks, numMatches)
+
+	// Generate a random permutation of the team ordering to fill into the pre-randomized schedule, keeping each team
+	// within its availability window. If no permutation can satisfy the availability constraints, generate a new
+	// schedule around them instead.
+	teamShuffle, ok := shuffleAvailableTeams(teams, anonSchedule, matchTimes)
+	if !ok {
+		_, _, matchesPerTeam := scheduleDimensions(teams, scheduleBlocks, teamsPerAlliance)
+		var err error
+		anonSchedule, teamShuffle, err = generateAvailableSchedule(teams, matchTimes, matchesPerTeam, teamsPerAlliance)
+		if err != nil {
+			return nil, err
+		}
+	}
 	matches := make([]model.Match, numMatches)
 	for i, anonMatch := range anonSchedule {
-		matches[i].Type = matchType
-		matches[i].TypeOrder = i + 1
-		if matchType == model.Practice {
-			matches[i].ShortName = fmt.Sprintf("P%d", i+1)
-			matches[i].LongName = fmt.Sprintf("Practice %d", i+1)
-			matches[i].TbaMatchKey.CompLevel = "p"
-		} else if matchType == model.Qualification {
-			matches[i].ShortName = fmt.Sprintf("Q%d", i+1)
-			matches[i].LongName = fmt.Sprintf("Qualification %d", i+1)
-			matches[i].TbaMatchKey.CompLevel = "qm"
-		} else {
-			return nil, fmt.Errorf("invalid match type %q", matchType)
+		if err := setScheduleMatchNumber(&matches[i], matchType, i+1); err != nil {
+			return nil, err
 		}
-		matches[i].Red1 = teams[teamShuffle[anonMatch[0]-1]].Id
-		matches[i].Red1IsSurrogate = anonMatch[1] == 1
-		matches[i].Red2 = teams[teamShuffle[anonMatch[2]-1]].Id
-		matches[i].Red2IsSurrogate = anonMatch[3] == 1
-		matches[i].Red3 = teams[teamShuffle[anonMatch[4]-1]].Id
-		matches[i].Red3IsSurrogate = anonMatch[5] == 1
-		matches[i].Blue1 = teams[teamShuffle[anonMatch[6]-1]].Id
-		matches[i].Blue1IsSurrogate = anonMatch[7] == 1
-		matches[i].Blue2 = teams[teamShuffle[anonMatch[8]-1]].Id
-		matches[i].Blue2IsSurrogate = anonMatch[9] == 1
-		matches[i].Blue3 = teams[teamShuffle[anonMatch[10]-1]].Id
-		matches[i].Blue3IsSurrogate = anonMatch[11] == 1
-		matches[i].TbaMatchKey.MatchNumber = i + 1
+		setAnonMatchTeams(&matches[i], anonMatch, func(anonTeam int) int { return teams[teamShuffle[anonTeam]].Id })
+		matches[i].Time = matchTimes[i]
+	}
+
+	return matches, nil
+}
+
+// Fills in the teams of the given match from the given row of an anonymized schedule, which lists the team and
+// surrogate flag for each station in turn, red first. The given function maps each anonymous team (by zero-based index)
+// to its team ID.
+func setAnonMatchTeams(match *model.Match, anonMatch []int, teamId func(anonTeam int) int) {
+	for i, station := range model.AllianceStationNames(len(anonMatch) / 4) {
+		match.SetTeamForStation(station, teamId(anonMatch[2*i]-1), anonMatch[2*i+1] == 1)
 	}
+}
 
-	// Fill in the match times.
+// Returns the start times of the first given number of matches to be run within the given schedule blocks.
+func scheduleMatchTimes(scheduleBlocks []model.ScheduleBlock, numMatches int) []time.Time {
+	matchTimes := make([]time.Time, numMatches)
 	matchIndex := 0
 	for _, block := range scheduleBlocks {
 		for i := 0; i < block.NumMatches && matchIndex < numMatches; i++ {
-			matches[matchIndex].Time = block.StartTime.Add(time.Duration(i*block.MatchSpacingSec) * time.Second)
+			matchTimes[matchIndex] = block.StartTime.Add(time.Duration(i*block.MatchSpacingSec) * time.Second)
 			matchIndex++
 		}
 	}
+	return matchTimes
+}
 
-	return matches, nil
+// Sets the type, order, names and TBA key of the given match to those of the given number within the schedule.
+func setScheduleMatchNumber(match *model.Match, matchType model.MatchType, number int) error {
+	match.Type = matchType
+	match.TypeOrder = number
+	if matchType == model.Practice {
+		match.ShortName = fmt.Sprintf("P%d", number)
+		match.LongName = fmt.Sprintf("Practice %d", number)
+		match.TbaMatchKey.CompLevel = "p"
+	} else if matchType == model.Qualification {
+		match.ShortName = fmt.Sprintf("Q%d", number)
+		match.LongName = fmt.Sprintf("Qualification %d", number)
+		match.TbaMatchKey.CompLevel = "qm"
+	} else {
+		return fmt.Errorf("invalid match type %q", matchType)
+	}
+	match.TbaMatchKey.MatchNumber = number
+	return nil
 }
 
 // Returns the total number of matches that can be run within the given schedule blocks.
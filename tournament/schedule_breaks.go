@@ -0,0 +1,58 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Functions for deriving the scheduled breaks of a practice or qualification schedule from its schedule blocks.
+
+package tournament
+
+import (
+	"fmt"
+
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// BuildScheduledBreaks returns a break for each gap between consecutive schedule blocks that has been given a name,
+// lasting from the end of the earlier block until the start of the later one. A gap in which the event moves on to the
+// next day is always treated as a break and is named after the day that is ending if it hasn't been given a name, but
+// it is only a label in the schedule and has no duration so that the field isn't left in a timeout overnight.
+func BuildScheduledBreaks(
+	scheduleBlocks []model.ScheduleBlock, matchType model.MatchType,
+) ([]model.ScheduledBreak, error) {
+	var scheduledBreaks []model.ScheduledBreak
+	numMatches := 0
+	for i, block := range scheduleBlocks {
+		if i > 0 {
+			previousBlock := scheduleBlocks[i-1]
+			if block.DayIndex < previousBlock.DayIndex {
+				return nil, fmt.Errorf(
+					"block %d is on day %d but comes after a block on day %d",
+					i+1,
+					block.DayIndex+1,
+					previousBlock.DayIndex+1,
+				)
+			}
+			description := block.BreakDescription
+			if description == "" && block.DayIndex > previousBlock.DayIndex {
+				description = fmt.Sprintf("End of Day %d", previousBlock.DayIndex+1)
+			}
+			if description != "" && numMatches > 0 && block.NumMatches > 0 {
+				breakTime := previousBlock.EndTime()
+				durationSec := max(0, int(block.StartTime.Sub(breakTime).Seconds()))
+				if block.DayIndex > previousBlock.DayIndex {
+					durationSec = 0
+				}
+				scheduledBreaks = append(
+					scheduledBreaks,
+					model.ScheduledBreak{
+						MatchType:       matchType,
+						TypeOrderBefore: numMatches + 1,
+						Time:            breakTime,
+						DurationSec:     durationSec,
+						Description:     description,
+					},
+				)
+			}
+		}
+		numMatches += block.NumMatches
+	}
+	return scheduledBreaks, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package tournament
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildScheduledBreaks(t *testing.T) {
+	scheduleBlocks := []model.ScheduleBlock{
+		{MatchType: model.Qualification, StartTime: time.Unix(0, 0).UTC(), NumMatches: 10, MatchSpacingSec: 360},
+		{
+			MatchType:        model.Qualification,
+			StartTime:        time.Unix(7200, 0).UTC(),
+			NumMatches:       10,
+			MatchSpacingSec:  360,
+			BreakDescription: "Lunch",
+		},
+		{MatchType: model.Qualification, StartTime: time.Unix(10800, 0).UTC(), NumMatches: 5, MatchSpacingSec: 360},
+		{
+			MatchType:       model.Qualification,
+			StartTime:       time.Unix(86400, 0).UTC(),
+			NumMatches:      10,
+			MatchSpacingSec: 360,
+			DayIndex:        1,
+		},
+		{
+			MatchType:        model.Qualification,
+			StartTime:        time.Unix(90000, 0).UTC(),
+			NumMatches:       5,
+			MatchSpacingSec:  360,
+			DayIndex:         1,
+			BreakDescription: "Awards",
+		},
+	}
+	scheduledBreaks, err := BuildScheduledBreaks(scheduleBlocks, model.Qualification)
+	assert.Nil(t, err)
+	assert.Equal(
+		t,
+		[]model.ScheduledBreak{
+			{0, model.Qualification, 11, time.Unix(3600, 0).UTC(), 3600, "Lunch"},
+			{0, model.Qualification, 26, time.Unix(12600, 0).UTC(), 0, "End of Day 1"},
+			{0, model.Qualification, 36, time.Unix(90000, 0).UTC(), 0, "Awards"},
+		},
+		scheduledBreaks,
+	)
+
+	// Check that a named break on the first block is ignored.
+	scheduleBlocks[0].BreakDescription = "Opening Ceremonies"
+	scheduledBreaks, err = BuildScheduledBreaks(scheduleBlocks, model.Qualification)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(scheduledBreaks))
+
+	// Check that blocks going back to an earlier day are rejected.
+	scheduleBlocks[4].DayIndex = 0
+	_, err = BuildScheduledBreaks(scheduleBlocks, model.Qualification)
+	assert.EqualError(t, err, "block 5 is on day 1 but comes after a block on day 2")
+}
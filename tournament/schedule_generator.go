@@ -0,0 +1,588 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Native generator for anonymized match schedules, for use when no precomputed schedule template exists.
+
+package tournament
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"sort"
+
+	"github.com/Team254/cheesy-arena/model"
+)
+
+const (
+	scheduleOptimizationIterationsPerMatch = 2000
+	scheduleOptimizationStartTemperature   = 10.0
+	scheduleOptimizationEndTemperature     = 0.01
+	maxTargetMatchSeparation               = 5
+	maxGeneratedMatchesPerTeam             = 30
+	duplicateTeamPenalty                   = 1000.0
+	partnerRepeatPenalty                   = 10.0
+	opponentRepeatPenalty                  = 2.0
+	matchSeparationPenalty                 = 3.0
+	redBlueImbalancePenalty                = 5.0
+	surrogatePlacementPenalty              = 5.0
+	unavailableAppearancePenalty           = 1000.0
+)
+
+// ScheduleQuality summarizes how well a schedule meets the criteria used to judge the precomputed schedule templates.
+type ScheduleQuality struct {
+	NumTeams               int
+	NumMatches             int
+	MatchesPerTeam         int
+	NumSurrogates          int
+	TargetMatchSeparation  int
+	MinMatchSeparation     int
+	NumShortSeparations    int
+	MaxPartnerRepeats      int
+	NumRepeatedPartners    int
+	MaxOpponentRepeats     int
+	MaxRedBlueImbalance    int
+	NumMisplacedSurrogates int
+	NumDuplicateTeams      int
+	Penalty                float64
+}
+
+// scheduleSlot represents a single team's appearance in a match of an anonymized schedule.
+type scheduleSlot struct {
+	team        int
+	isSurrogate bool
+}
+
+// scheduleOptimizer holds the running tallies needed to incrementally score changes to an anonymized schedule.
+type scheduleOptimizer struct {
+	numTeams              int
+	matchesPerTeam        int
+	teamsPerAlliance      int
+	idealPartnerCount     int
+	idealOpponentCount    int
+	targetMatchSeparation int
+	matches               [][]scheduleSlot
+	partnerCounts         [][]int
+	opponentCounts        [][]int
+	redCounts             []int
+	blueCounts            []int
+	teamMatches           [][]int
+	availableMatches      [][2]int
+	emptyTeam             int
+	penalty               float64
+}
+
+// teamScheduleMetrics holds the measures of a single team's schedule from which both the overall schedule quality and
+// the per-team schedule report are derived. Match separations are counted as the number of other matches played
+// between two consecutive appearances of the team, and repeated partners and opponents as the number of other teams
+// that the team is paired with more than once in that role.
+type teamScheduleMetrics struct {
+	numMatches           int
+	minMatchSeparation   int
+	totalMatchSeparation int
+	numShortSeparations  int
+	maxPartnerCount      int
+	numRepeatedPartners  int
+	maxOpponentCount     int
+	numRepeatedOpponents int
+	redCount             int
+	blueCount            int
+	surrogateIndex       int
+}
+
+// generateAnonSchedule creates an anonymized schedule from scratch for the given number of teams and matches per team,
+// in the same format as the precomputed schedule templates.
+func generateAnonSchedule(numTeams, matchesPerTeam, teamsPerAlliance int) ([][]int, error) {
+	optimizer, err := optimizeAnonSchedule(numTeams, matchesPerTeam, teamsPerAlliance, nil)
+	if err != nil {
+		return nil, err
+	}
+	return optimizer.anonSchedule(), nil
+}
+
+// optimizeAnonSchedule lays out and optimizes an anonymized schedule for the given number of teams, matches per team
+// and teams per alliance. If availableMatches is non-nil, it gives the first and last match index (inclusive) in which
+// each team may play, and appearances outside of that range are heavily penalized.
+func optimizeAnonSchedule(
+	numTeams, matchesPerTeam, teamsPerAlliance int, availableMatches [][2]int,
+) (*scheduleOptimizer, error) {
+	teamsPerMatch := 2 * teamsPerAlliance
+	if numTeams < teamsPerMatch {
+		return nil, fmt.Errorf("must have at least %d teams to generate a schedule", teamsPerMatch)
+	}
+	if matchesPerTeam < 1 {
+		return nil, fmt.Errorf("must have at least one match per team to generate a schedule")
+	}
+	if matchesPerTeam > maxGeneratedMatchesPerTeam {
+		return nil, fmt.Errorf(
+			"cannot generate a schedule with more than %d matches per team", maxGeneratedMatchesPerTeam,
+		)
+	}
+	numMatches := int(math.Ceil(float64(numTeams*matchesPerTeam) / float64(teamsPerMatch)))
+	numSurrogates := numMatches*teamsPerMatch - numTeams*matchesPerTeam
+
+	// Lay out the teams in randomly shuffled rounds, placing the extra appearances of the surrogate teams after the
+	// round in which they should happen (the third match, or the last one for teams with fewer matches).
+	slots := make([]scheduleSlot, 0, numMatches*teamsPerMatch)
+	for round := 0; round < matchesPerTeam; round++ {
+		if round == surrogateAppearanceIndex(matchesPerTeam) {
+			for _, team := range rand.Perm(numTeams)[:numSurrogates] {
+				slots = append(slots, scheduleSlot{team, true})
+			}
+		}
+		for _, team := range rand.Perm(numTeams) {
+			slots = append(slots, scheduleSlot{team, false})
+		}
+	}
+	if surrogateAppearanceIndex(matchesPerTeam) == matchesPerTeam {
+		for _, team := range rand.Perm(numTeams)[:numSurrogates] {
+			slots = append(slots, scheduleSlot{team, true})
+		}
+	}
+	optimizer := newScheduleOptimizer(
+		groupScheduleSlots(slots, teamsPerMatch), numTeams, matchesPerTeam, teamsPerAlliance,
+	)
+	if availableMatches != nil {
+		optimizer.setAvailableMatches(availableMatches)
+	}
+	optimizer.optimize(scheduleOptimizationIterationsPerMatch * numMatches)
+	if quality := optimizer.quality(); quality.NumDuplicateTeams > 0 {
+		return nil, fmt.Errorf(
+			"could not generate a valid schedule for %d teams and %d matches per team", numTeams, matchesPerTeam,
+		)
+	}
+	return optimizer, nil
+}
+
+// EvaluateSchedule assesses the quality of the given list of matches, played with the given number of teams per
+// alliance, using the same criteria as the generator.
+func EvaluateSchedule(matches []model.Match, teamsPerAlliance int) ScheduleQuality {
+	optimizer, teamIds := newScheduleEvaluator(matches, teamsPerAlliance)
+	if len(teamIds) < 2 {
+		return ScheduleQuality{NumTeams: len(teamIds), NumMatches: len(matches)}
+	}
+	return optimizer.quality()
+}
+
+// newScheduleEvaluator anonymizes the given list of matches into an optimizer for measuring it, and returns it along
+// with the team number corresponding to each of its team indices. Empty stations are given a team index of their own,
+// which is left out of the measurements.
+func newScheduleEvaluator(matches []model.Match, teamsPerAlliance int) (*scheduleOptimizer, []int) {
+	teamIndices := make(map[int]int)
+	var teamIds []int
+	anonMatches := make([][]scheduleSlot, len(matches))
+	for i, match := range matches {
+		for _, station := range model.AllianceStationNames(teamsPerAlliance) {
+			teamId := match.TeamIdForStation(station)
+			if _, ok := teamIndices[teamId]; !ok {
+				teamIndices[teamId] = len(teamIds)
+				teamIds = append(teamIds, teamId)
+			}
+			anonMatches[i] = append(
+				anonMatches[i], scheduleSlot{teamIndices[teamId], match.IsSurrogateForStation(station)},
+			)
+		}
+	}
+	emptyTeam, ok := teamIndices[0]
+	if !ok {
+		emptyTeam = -1
+	}
+
+	// Take the number of matches per team to be the fewest that any team plays, not counting surrogate appearances.
+	matchCounts := make([]int, len(teamIds))
+	for _, match := range anonMatches {
+		for _, slot := range match {
+			if !slot.isSurrogate {
+				matchCounts[slot.team]++
+			}
+		}
+	}
+	matchesPerTeam := 0
+	for team, matchCount := range matchCounts {
+		if team != emptyTeam && (matchesPerTeam == 0 || matchCount < matchesPerTeam) {
+			matchesPerTeam = matchCount
+		}
+	}
+
+	optimizer := newScheduleOptimizer(anonMatches, len(teamIds), matchesPerTeam, teamsPerAlliance)
+	optimizer.emptyTeam = emptyTeam
+	return optimizer, teamIds
+}
+
+// surrogateAppearanceIndex returns the index among a surrogate team's appearances at which it should play as a
+// surrogate, which is its third match or its last one if it has fewer.
+func surrogateAppearanceIndex(matchesPerTeam int) int {
+	return min(2, matchesPerTeam)
+}
+
+// groupScheduleSlots divides the given list of appearances into matches of the given size, in order.
+func groupScheduleSlots(slots []scheduleSlot, teamsPerMatch int) [][]scheduleSlot {
+	matches := make([][]scheduleSlot, 0, len(slots)/teamsPerMatch)
+	for i := 0; i < len(slots); i += teamsPerMatch {
+		matches = append(matches, slots[i:i+teamsPerMatch:i+teamsPerMatch])
+	}
+	return matches
+}
+
+func newScheduleOptimizer(
+	matches [][]scheduleSlot, numTeams, matchesPerTeam, teamsPerAlliance int,
+) *scheduleOptimizer {
+	optimizer := scheduleOptimizer{
+		numTeams:         numTeams,
+		matchesPerTeam:   matchesPerTeam,
+		teamsPerAlliance: teamsPerAlliance,
+		idealPartnerCount: max(
+			1, int(math.Ceil(float64((teamsPerAlliance-1)*matchesPerTeam)/float64(max(1, numTeams-1)))),
+		),
+		idealOpponentCount: max(
+			1, int(math.Ceil(float64(teamsPerAlliance*matchesPerTeam)/float64(max(1, numTeams-1)))),
+		),
+		targetMatchSeparation: min(maxTargetMatchSeparation, max(0, numTeams/(2*teamsPerAlliance)-1)),
+		matches:               matches,
+		partnerCounts:         make([][]int, numTeams),
+		opponentCounts:        make([][]int, numTeams),
+		redCounts:             make([]int, numTeams),
+		blueCounts:            make([]int, numTeams),
+		teamMatches:           make([][]int, numTeams),
+		emptyTeam:             -1,
+	}
+	for i := 0; i < numTeams; i++ {
+		optimizer.partnerCounts[i] = make([]int, numTeams)
+		optimizer.opponentCounts[i] = make([]int, numTeams)
+	}
+	for i, match := range matches {
+		optimizer.penalty += optimizer.tallyMatch(i, 1)
+		for _, slot := range match {
+			optimizer.teamMatches[slot.team] = append(optimizer.teamMatches[slot.team], i)
+		}
+	}
+	for team := 0; team < numTeams; team++ {
+		optimizer.penalty += optimizer.teamPenalty(team)
+	}
+	return &optimizer
+}
+
+// optimize improves the schedule using simulated annealing, by repeatedly swapping two randomly chosen appearances and
+// keeping the change if it reduces the penalty (or occasionally if it doesn't, to escape from local minima).
+func (optimizer *scheduleOptimizer) optimize(iterations int) {
+	numSlots := len(optimizer.matches) * optimizer.teamsPerMatch()
+	cooling := math.Pow(scheduleOptimizationEndTemperature/scheduleOptimizationStartTemperature, 1/float64(iterations))
+	temperature := scheduleOptimizationStartTemperature
+	for i := 0; i < iterations; i++ {
+		slot1, slot2 := rand.Intn(numSlots), rand.Intn(numSlots)
+		delta := optimizer.swap(slot1, slot2)
+		if delta > 0 && rand.Float64() >= math.Exp(-delta/temperature) {
+			optimizer.swap(slot1, slot2)
+		}
+		temperature *= cooling
+	}
+}
+
+// swap exchanges the two given appearances and returns the resulting change in penalty.
+func (optimizer *scheduleOptimizer) swap(slot1, slot2 int) float64 {
+	teamsPerMatch := optimizer.teamsPerMatch()
+	match1, position1 := slot1/teamsPerMatch, slot1%teamsPerMatch
+	match2, position2 := slot2/teamsPerMatch, slot2%teamsPerMatch
+	team1, team2 := optimizer.matches[match1][position1].team, optimizer.matches[match2][position2].team
+	if team1 == team2 {
+		return 0
+	}
+
+	delta := -optimizer.teamPenalty(team1) - optimizer.teamPenalty(team2)
+	delta += optimizer.tallyMatch(match1, -1)
+	if match2 != match1 {
+		delta += optimizer.tallyMatch(match2, -1)
+	}
+	optimizer.matches[match1][position1], optimizer.matches[match2][position2] =
+		optimizer.matches[match2][position2], optimizer.matches[match1][position1]
+	if match2 != match1 {
+		optimizer.moveTeam(team1, match1, match2)
+		optimizer.moveTeam(team2, match2, match1)
+	}
+	delta += optimizer.tallyMatch(match1, 1)
+	if match2 != match1 {
+		delta += optimizer.tallyMatch(match2, 1)
+	}
+	delta += optimizer.teamPenalty(team1) + optimizer.teamPenalty(team2)
+
+	optimizer.penalty += delta
+	return delta
+}
+
+// moveTeam updates the record of the matches in which the given team appears.
+func (optimizer *scheduleOptimizer) moveTeam(team, fromMatch, toMatch int) {
+	teamMatches := optimizer.teamMatches[team]
+	for i, match := range teamMatches {
+		if match == fromMatch {
+			teamMatches[i] = toMatch
+			break
+		}
+	}
+	sort.Ints(teamMatches)
+}
+
+// tallyMatch adds (for a sign of 1) or removes (for a sign of -1) the pairings and station assignments of the given
+// match to or from the running totals, and returns the resulting change in penalty.
+func (optimizer *scheduleOptimizer) tallyMatch(matchIndex int, sign int) float64 {
+	delta := 0.0
+	match := optimizer.matches[matchIndex]
+	isRed := func(position int) bool { return position < optimizer.teamsPerAlliance }
+	for i := range match {
+		for j := i + 1; j < len(match); j++ {
+			team1, team2 := match[i].team, match[j].team
+			if team1 == team2 {
+				delta += float64(sign) * duplicateTeamPenalty
+				continue
+			}
+			if isRed(i) == isRed(j) {
+				delta += updatePairCount(
+					optimizer.partnerCounts, team1, team2, sign, optimizer.idealPartnerCount, partnerRepeatPenalty,
+				)
+			} else {
+				delta += updatePairCount(
+					optimizer.opponentCounts, team1, team2, sign, optimizer.idealOpponentCount, opponentRepeatPenalty,
+				)
+			}
+		}
+
+		team := match[i].team
+		before := redBlueImbalancePenaltyFor(optimizer.redCounts[team], optimizer.blueCounts[team])
+		if isRed(i) {
+			optimizer.redCounts[team] += sign
+		} else {
+			optimizer.blueCounts[team] += sign
+		}
+		delta += redBlueImbalancePenaltyFor(optimizer.redCounts[team], optimizer.blueCounts[team]) - before
+	}
+
+	// Only allow one surrogate per alliance.
+	for _, alliance := range optimizer.alliances(match) {
+		numSurrogates := 0
+		for _, slot := range alliance {
+			if slot.isSurrogate {
+				numSurrogates++
+			}
+		}
+		if numSurrogates > 1 {
+			delta += float64(sign) * surrogatePlacementPenalty * float64(numSurrogates-1)
+		}
+	}
+	return delta
+}
+
+// updatePairCount adjusts the number of times the given two teams have been paired and returns the resulting change in
+// penalty.
+func updatePairCount(counts [][]int, team1, team2, sign, idealCount int, weight float64) float64 {
+	if team1 > team2 {
+		team1, team2 = team2, team1
+	}
+	before := pairPenalty(counts[team1][team2], idealCount, weight)
+	counts[team1][team2] += sign
+	return pairPenalty(counts[team1][team2], idealCount, weight) - before
+}
+
+func pairPenalty(count, idealCount int, weight float64) float64 {
+	excess := float64(max(0, count-idealCount))
+	return weight * excess * excess
+}
+
+func redBlueImbalancePenaltyFor(redCount, blueCount int) float64 {
+	excess := float64(max(0, abs(redCount-blueCount)-1))
+	return redBlueImbalancePenalty * excess * excess
+}
+
+// teamPenalty returns the penalty for the given team's match separation, surrogate placement and any appearances
+// outside of its available range.
+func (optimizer *scheduleOptimizer) teamPenalty(team int) float64 {
+	penalty := 0.0
+	teamMatches := optimizer.teamMatches[team]
+	for i := 1; i < len(teamMatches); i++ {
+		if shortfall := optimizer.targetMatchSeparation - (teamMatches[i] - teamMatches[i-1] - 1); shortfall > 0 {
+			penalty += matchSeparationPenalty * float64(shortfall*shortfall)
+		}
+	}
+	if index := optimizer.surrogateIndex(team); index >= 0 {
+		offset := float64(index - surrogateAppearanceIndex(optimizer.matchesPerTeam))
+		penalty += surrogatePlacementPenalty * offset * offset
+	}
+	penalty += unavailableAppearancePenalty * float64(optimizer.countUnavailableAppearances(team))
+	return penalty
+}
+
+// surrogateIndex returns the index among the given team's appearances at which it plays as a surrogate, or -1 if it
+// doesn't.
+func (optimizer *scheduleOptimizer) surrogateIndex(team int) int {
+	for i, matchIndex := range optimizer.teamMatches[team] {
+		for _, slot := range optimizer.matches[matchIndex] {
+			if slot.team == team && slot.isSurrogate {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// anonSchedule returns the current state of the schedule in the same format as the precomputed schedule templates.
+func (optimizer *scheduleOptimizer) anonSchedule() [][]int {
+	anonSchedule := make([][]int, len(optimizer.matches))
+	for i, match := range optimizer.matches {
+		anonSchedule[i] = make([]int, 2*len(match))
+		match = slices.Clone(match)
+
+		// Follow the convention of the templates by listing any surrogate first within its alliance.
+		for _, alliance := range optimizer.alliances(match) {
+			sort.SliceStable(
+				alliance, func(j, k int) bool { return alliance[j].isSurrogate && !alliance[k].isSurrogate },
+			)
+		}
+		for j, slot := range match {
+			anonSchedule[i][2*j] = slot.team + 1
+			if slot.isSurrogate {
+				anonSchedule[i][2*j+1] = 1
+			}
+		}
+	}
+	return anonSchedule
+}
+
+// alliances returns the red and blue alliance portions of the given match.
+func (optimizer *scheduleOptimizer) alliances(match []scheduleSlot) [][]scheduleSlot {
+	return [][]scheduleSlot{match[:optimizer.teamsPerAlliance], match[optimizer.teamsPerAlliance:]}
+}
+
+func (optimizer *scheduleOptimizer) teamsPerMatch() int {
+	return 2 * optimizer.teamsPerAlliance
+}
+
+// setAvailableMatches restricts each team to the given range of match indices (inclusive) and rescores the schedule.
+func (optimizer *scheduleOptimizer) setAvailableMatches(availableMatches [][2]int) {
+	for team := 0; team < optimizer.numTeams; team++ {
+		optimizer.penalty -= optimizer.teamPenalty(team)
+	}
+	optimizer.availableMatches = availableMatches
+	for team := 0; team < optimizer.numTeams; team++ {
+		optimizer.penalty += optimizer.teamPenalty(team)
+	}
+}
+
+// unavailableTeams returns the teams that are scheduled to play in any match outside of their available range.
+func (optimizer *scheduleOptimizer) unavailableTeams() []int {
+	var teams []int
+	for team := 0; team < optimizer.numTeams; team++ {
+		if optimizer.countUnavailableAppearances(team) > 0 {
+			teams = append(teams, team)
+		}
+	}
+	return teams
+}
+
+// countUnavailableAppearances returns the number of matches the given team plays outside of its available range.
+func (optimizer *scheduleOptimizer) countUnavailableAppearances(team int) int {
+	if optimizer.availableMatches == nil {
+		return 0
+	}
+	count := 0
+	for _, matchIndex := range optimizer.teamMatches[team] {
+		if matchIndex < optimizer.availableMatches[team][0] || matchIndex > optimizer.availableMatches[team][1] {
+			count++
+		}
+	}
+	return count
+}
+
+// quality summarizes the current state of the schedule.
+func (optimizer *scheduleOptimizer) quality() ScheduleQuality {
+	quality := ScheduleQuality{
+		NumTeams:              optimizer.numTeams,
+		NumMatches:            len(optimizer.matches),
+		MatchesPerTeam:        optimizer.matchesPerTeam,
+		TargetMatchSeparation: optimizer.targetMatchSeparation,
+		MinMatchSeparation:    -1,
+		Penalty:               optimizer.penalty,
+	}
+	if optimizer.emptyTeam >= 0 {
+		quality.NumTeams--
+	}
+	for _, match := range optimizer.matches {
+		for i, slot := range match {
+			if slot.isSurrogate {
+				quality.NumSurrogates++
+			}
+			for j := i + 1; j < len(match); j++ {
+				if match[j].team == slot.team && slot.team != optimizer.emptyTeam {
+					quality.NumDuplicateTeams++
+				}
+			}
+		}
+	}
+	for team := 0; team < optimizer.numTeams; team++ {
+		if team == optimizer.emptyTeam {
+			continue
+		}
+		metrics := optimizer.teamMetrics(team)
+		if metrics.minMatchSeparation >= 0 &&
+			(quality.MinMatchSeparation < 0 || metrics.minMatchSeparation < quality.MinMatchSeparation) {
+			quality.MinMatchSeparation = metrics.minMatchSeparation
+		}
+		quality.NumShortSeparations += metrics.numShortSeparations
+		quality.MaxPartnerRepeats = max(quality.MaxPartnerRepeats, metrics.maxPartnerCount)
+		quality.NumRepeatedPartners += metrics.numRepeatedPartners
+		quality.MaxOpponentRepeats = max(quality.MaxOpponentRepeats, metrics.maxOpponentCount)
+		quality.MaxRedBlueImbalance = max(quality.MaxRedBlueImbalance, abs(metrics.redCount-metrics.blueCount))
+		surrogateIndex := metrics.surrogateIndex
+		if surrogateIndex >= 0 && surrogateIndex != surrogateAppearanceIndex(optimizer.matchesPerTeam) {
+			quality.NumMisplacedSurrogates++
+		}
+	}
+
+	// Each repeated pairing has been counted once from the perspective of each of the two teams.
+	quality.NumRepeatedPartners /= 2
+	quality.MinMatchSeparation = max(0, quality.MinMatchSeparation)
+	return quality
+}
+
+// teamMetrics measures the current state of the given team's schedule, leaving out any pairings with empty stations.
+func (optimizer *scheduleOptimizer) teamMetrics(team int) teamScheduleMetrics {
+	teamMatches := optimizer.teamMatches[team]
+	metrics := teamScheduleMetrics{
+		numMatches:         len(teamMatches),
+		minMatchSeparation: -1,
+		redCount:           optimizer.redCounts[team],
+		blueCount:          optimizer.blueCounts[team],
+		surrogateIndex:     optimizer.surrogateIndex(team),
+	}
+	for i := 1; i < len(teamMatches); i++ {
+		separation := teamMatches[i] - teamMatches[i-1] - 1
+		if metrics.minMatchSeparation < 0 || separation < metrics.minMatchSeparation {
+			metrics.minMatchSeparation = separation
+		}
+		metrics.totalMatchSeparation += separation
+		if separation < optimizer.targetMatchSeparation {
+			metrics.numShortSeparations++
+		}
+	}
+	for otherTeam := 0; otherTeam < optimizer.numTeams; otherTeam++ {
+		if otherTeam == team || otherTeam == optimizer.emptyTeam {
+			continue
+		}
+		team1, team2 := min(team, otherTeam), max(team, otherTeam)
+		partnerCount := optimizer.partnerCounts[team1][team2]
+		metrics.maxPartnerCount = max(metrics.maxPartnerCount, partnerCount)
+		if partnerCount > 1 {
+			metrics.numRepeatedPartners++
+		}
+		opponentCount := optimizer.opponentCounts[team1][team2]
+		metrics.maxOpponentCount = max(metrics.maxOpponentCount, opponentCount)
+		if opponentCount > 1 {
+			metrics.numRepeatedOpponents++
+		}
+	}
+	return metrics
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package tournament
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateAnonSchedule(t *testing.T) {
+	rand.Seed(0)
+
+	_, err := generateAnonSchedule(5, 2, 3)
+	assert.EqualError(t, err, "must have at least 6 teams to generate a schedule")
+	_, err = generateAnonSchedule(6, 0, 3)
+	assert.EqualError(t, err, "must have at least one match per team to generate a schedule")
+	_, err = generateAnonSchedule(6, 31, 3)
+	assert.EqualError(t, err, "cannot generate a schedule with more than 30 matches per team")
+
+	anonSchedule, err := generateAnonSchedule(31, 8, 3)
+	assert.Nil(t, err)
+	if assert.Equal(t, 42, len(anonSchedule)) {
+		appearances := make(map[int]int)
+		surrogates := make(map[int]int)
+		for _, anonMatch := range anonSchedule {
+			matchTeams := make(map[int]bool)
+			numRedSurrogates, numBlueSurrogates := 0, 0
+			for i := 0; i < 6; i++ {
+				team := anonMatch[2*i]
+				assert.False(t, matchTeams[team])
+				matchTeams[team] = true
+				appearances[team]++
+				if anonMatch[2*i+1] == 1 {
+					surrogates[team]++
+					if i < 3 {
+						numRedSurrogates++
+					} else {
+						numBlueSurrogates++
+					}
+				}
+			}
+			assert.LessOrEqual(t, numRedSurrogates, 1)
+			assert.LessOrEqual(t, numBlueSurrogates, 1)
+		}
+		assert.Equal(t, 31, len(appearances))
+		assert.Equal(t, 4, len(surrogates))
+		for team, count := range appearances {
+			assert.Equal(t, 8+surrogates[team], count)
+		}
+	}
+}
+
+func TestScheduleGeneratorFallback(t *testing.T) {
+	rand.Seed(0)
+
+	numTeams := 12
+	teams := make([]model.Team, numTeams)
+	for i := 0; i < numTeams; i++ {
+		teams[i].Id = i + 101
+	}
+	scheduleBlocks := []model.ScheduleBlock{{0, model.Qualification, time.Unix(0, 0).UTC(), 30, 60, 0, ""}}
+	matches, err := BuildRandomSchedule(teams, scheduleBlocks, model.Qualification, 3)
+	assert.Nil(t, err)
+	if assert.Equal(t, 30, len(matches)) {
+		assert.Equal(t, "Q30", matches[29].ShortName)
+		assert.Equal(t, time.Unix(1740, 0).UTC(), matches[29].Time)
+	}
+	quality := EvaluateSchedule(matches, 3)
+	assert.Equal(t, 12, quality.NumTeams)
+	assert.Equal(t, 30, quality.NumMatches)
+	assert.Equal(t, 15, quality.MatchesPerTeam)
+	assert.Equal(t, 0, quality.NumSurrogates)
+	assert.Equal(t, 0, quality.NumDuplicateTeams)
+	assert.LessOrEqual(t, quality.MaxRedBlueImbalance, 2)
+
+	// Check that the generator can also be used when a template exists.
+	scheduleBlocks = []model.ScheduleBlock{{0, model.Practice, time.Unix(0, 0).UTC(), 6, 60, 0, ""}}
+	matches, err = BuildGeneratedSchedule(make([]model.Team, 18), scheduleBlocks, model.Practice, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 6, len(matches))
+	_, err = BuildGeneratedSchedule(make([]model.Team, 5), scheduleBlocks, model.Practice, 3)
+	assert.EqualError(t, err, "must have at least 6 teams to generate a schedule")
+}
+
+func TestBuildRandomScheduleTeamsPerAlliance(t *testing.T) {
+	rand.Seed(0)
+
+	numTeams := 18
+	teams := make([]model.Team, numTeams)
+	for i := 0; i < numTeams; i++ {
+		teams[i].Id = i + 101
+	}
+
+	// Check that a schedule is generated rather than taken from a template for four teams per alliance.
+	scheduleBlocks := []model.ScheduleBlock{{0, model.Qualification, time.Unix(0, 0).UTC(), 27, 60, 0, ""}}
+	matches, err := BuildRandomSchedule(teams, scheduleBlocks, model.Qualification, 4)
+	assert.Nil(t, err)
+	if assert.Equal(t, 27, len(matches)) {
+		for _, match := range matches {
+			assert.NotZero(t, match.Red4)
+			assert.NotZero(t, match.Blue4)
+		}
+	}
+	quality := EvaluateSchedule(matches, 4)
+	assert.Equal(t, 18, quality.NumTeams)
+	assert.Equal(t, 12, quality.MatchesPerTeam)
+	assert.Equal(t, 0, quality.NumDuplicateTeams)
+	for _, stats := range BuildTeamScheduleStats(matches, 4, DefaultScheduleWarningThresholds) {
+		assert.Equal(t, 8, len(stats.StationCounts))
+	}
+
+	// Check two teams per alliance.
+	matches, err = BuildRandomSchedule(teams, scheduleBlocks, model.Qualification, 2)
+	assert.Nil(t, err)
+	if assert.Equal(t, 27, len(matches)) {
+		for _, match := range matches {
+			assert.NotZero(t, match.Blue2)
+			assert.Zero(t, match.Red3)
+			assert.Zero(t, match.Blue3)
+		}
+	}
+	quality = EvaluateSchedule(matches, 2)
+	assert.Equal(t, 6, quality.MatchesPerTeam)
+	assert.Equal(t, 0, quality.NumDuplicateTeams)
+	_, err = BuildGeneratedSchedule(teams[:3], scheduleBlocks, model.Qualification, 2)
+	assert.EqualError(t, err, "must have at least 4 teams to generate a schedule")
+}
+
+func TestEvaluateSchedule(t *testing.T) {
+	matches := []model.Match{
+		{Red1: 1, Red2: 2, Red3: 3, Blue1: 4, Blue2: 5, Blue3: 6},
+		{Red1: 1, Red2: 2, Red3: 7, Blue1: 8, Blue2: 9, Blue3: 10},
+		{Red1: 3, Red2: 4, Red3: 5, Blue1: 6, Blue2: 7, Blue3: 8, Blue1IsSurrogate: true},
+		{Red1: 9, Red2: 10, Red3: 11, Blue1: 12, Blue2: 11, Blue3: 6},
+	}
+	quality := EvaluateSchedule(matches, 3)
+	assert.Equal(t, 12, quality.NumTeams)
+	assert.Equal(t, 4, quality.NumMatches)
+	assert.Equal(t, 1, quality.MatchesPerTeam)
+	assert.Equal(t, 1, quality.NumSurrogates)
+	assert.Equal(t, 0, quality.MinMatchSeparation)
+	assert.Equal(t, 2, quality.MaxPartnerRepeats)
+	assert.Equal(t, 3, quality.NumRepeatedPartners)
+	assert.Equal(t, 2, quality.MaxOpponentRepeats)
+	assert.Equal(t, 3, quality.MaxRedBlueImbalance)
+	assert.Equal(t, 1, quality.NumDuplicateTeams)
+	assert.Greater(t, quality.Penalty, duplicateTeamPenalty)
+
+	assert.Equal(t, ScheduleQuality{}, EvaluateSchedule([]model.Match{}, 3))
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Functions for assessing a match schedule from the perspective of each team.
+
+package tournament
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// ScheduleWarningThresholds holds the limits beyond which a team's schedule is flagged as problematic.
+type ScheduleWarningThresholds struct {
+	MinMatchGap         int
+	MaxPartnerRepeats   int
+	MaxOpponentRepeats  int
+	MaxRedBlueImbalance int
+}
+
+var DefaultScheduleWarningThresholds = ScheduleWarningThresholds{
+	MinMatchGap:         3,
+	MaxPartnerRepeats:   1,
+	MaxOpponentRepeats:  2,
+	MaxRedBlueImbalance: 2,
+}
+
+// TeamScheduleStats summarizes a single team's schedule, measured in the same way as the overall schedule quality.
+// Match gaps are counted as the number of other matches played between two consecutive appearances of the team, and
+// repeats as the number of other teams that the team is paired with more than once as a partner or opponent. Station
+// counts are given in the order of model.AllianceStationNames.
+type TeamScheduleStats struct {
+	TeamId           int
+	NumMatches       int
+	SurrogateMatches []string
+	MinMatchGap      int
+	AverageMatchGap  float64
+	PartnerRepeats   int
+	OpponentRepeats  int
+	StationCounts    []int
+	RedCount         int
+	BlueCount        int
+	FirstMatch       string
+	FirstMatchTime   time.Time
+	LastMatch        string
+	LastMatchTime    time.Time
+	Warnings         []string
+}
+
+// BuildTeamScheduleStats calculates the schedule statistics for each team appearing in the given matches, which must be
+// in order of play with the given number of teams per alliance, and flags any that exceed the given thresholds. The
+// results are sorted by team number.
+func BuildTeamScheduleStats(
+	matches []model.Match, teamsPerAlliance int, thresholds ScheduleWarningThresholds,
+) []TeamScheduleStats {
+	stations := model.AllianceStationNames(teamsPerAlliance)
+	statsByTeam := make(map[int]*TeamScheduleStats)
+	for _, match := range matches {
+		for station, stationName := range stations {
+			teamId := match.TeamIdForStation(stationName)
+			if teamId == 0 {
+				continue
+			}
+			stats, ok := statsByTeam[teamId]
+			if !ok {
+				stats = &TeamScheduleStats{
+					TeamId:         teamId,
+					StationCounts:  make([]int, len(stations)),
+					FirstMatch:     match.ShortName,
+					FirstMatchTime: match.Time,
+				}
+				statsByTeam[teamId] = stats
+			}
+			stats.LastMatch = match.ShortName
+			stats.LastMatchTime = match.Time
+			stats.StationCounts[station]++
+			if match.IsSurrogateForStation(stationName) {
+				stats.SurrogateMatches = append(stats.SurrogateMatches, match.ShortName)
+			}
+		}
+	}
+
+	teamStats := make([]TeamScheduleStats, 0, len(statsByTeam))
+	optimizer, teamIds := newScheduleEvaluator(matches, teamsPerAlliance)
+	for team, teamId := range teamIds {
+		if teamId == 0 {
+			continue
+		}
+		stats := statsByTeam[teamId]
+		metrics := optimizer.teamMetrics(team)
+		stats.NumMatches = metrics.numMatches
+		stats.MinMatchGap = max(0, metrics.minMatchSeparation)
+		if metrics.numMatches > 1 {
+			stats.AverageMatchGap = float64(metrics.totalMatchSeparation) / float64(metrics.numMatches-1)
+		}
+		stats.PartnerRepeats = metrics.numRepeatedPartners
+		stats.OpponentRepeats = metrics.numRepeatedOpponents
+		stats.RedCount = metrics.redCount
+		stats.BlueCount = metrics.blueCount
+
+		if metrics.numMatches > 1 && stats.MinMatchGap < thresholds.MinMatchGap {
+			stats.Warnings = append(
+				stats.Warnings, fmt.Sprintf("only %d matches between appearances", stats.MinMatchGap),
+			)
+		}
+		if stats.PartnerRepeats > thresholds.MaxPartnerRepeats {
+			stats.Warnings = append(stats.Warnings, fmt.Sprintf("%d repeated partners", stats.PartnerRepeats))
+		}
+		if stats.OpponentRepeats > thresholds.MaxOpponentRepeats {
+			stats.Warnings = append(stats.Warnings, fmt.Sprintf("%d repeated opponents", stats.OpponentRepeats))
+		}
+		if imbalance := abs(stats.RedCount - stats.BlueCount); imbalance > thresholds.MaxRedBlueImbalance {
+			stats.Warnings = append(
+				stats.Warnings, fmt.Sprintf("%d red and %d blue appearances", stats.RedCount, stats.BlueCount),
+			)
+		}
+		teamStats = append(teamStats, *stats)
+	}
+	sort.Slice(teamStats, func(i, j int) bool {
+		return teamStats[i].TeamId < teamStats[j].TeamId
+	})
+	return teamStats
+}
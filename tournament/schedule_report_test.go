@@ -0,0 +1,86 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package tournament
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildTeamScheduleStats(t *testing.T) {
+	matches := []model.Match{
+		{ShortName: "Q1", Time: time.Unix(100, 0), Red1: 1, Red2: 2, Red3: 3, Blue1: 4, Blue2: 5, Blue3: 6},
+		{ShortName: "Q2", Time: time.Unix(200, 0), Red1: 7, Red2: 8, Red3: 9, Blue1: 10, Blue2: 11, Blue3: 12},
+		{
+			ShortName:       "Q3",
+			Time:            time.Unix(300, 0),
+			Red1:            1,
+			Red2:            2,
+			Red3:            7,
+			Blue1:           4,
+			Blue2:           8,
+			Blue3:           9,
+			Red3IsSurrogate: true,
+		},
+		{ShortName: "Q4", Time: time.Unix(400, 0), Red1: 3, Red2: 5, Red3: 6, Blue1: 10, Blue2: 11, Blue3: 12},
+		{ShortName: "Q5", Time: time.Unix(500, 0), Red1: 1, Red2: 10, Red3: 11, Blue1: 12, Blue2: 4, Blue3: 5},
+	}
+	teamStats := BuildTeamScheduleStats(matches, 3, DefaultScheduleWarningThresholds)
+	if assert.Equal(t, 12, len(teamStats)) {
+		team1 := teamStats[0]
+		assert.Equal(t, 1, team1.TeamId)
+		assert.Equal(t, 3, team1.NumMatches)
+		assert.Equal(t, 1, team1.MinMatchGap)
+		assert.Equal(t, 1.0, team1.AverageMatchGap)
+		assert.Equal(t, 1, team1.PartnerRepeats)
+		assert.Equal(t, 2, team1.OpponentRepeats)
+		assert.Equal(t, []int{3, 0, 0, 0, 0, 0}, team1.StationCounts)
+		assert.Equal(t, 3, team1.RedCount)
+		assert.Equal(t, 0, team1.BlueCount)
+		assert.Equal(t, "Q1", team1.FirstMatch)
+		assert.Equal(t, time.Unix(100, 0), team1.FirstMatchTime)
+		assert.Equal(t, "Q5", team1.LastMatch)
+		assert.Equal(t, time.Unix(500, 0), team1.LastMatchTime)
+		assert.Equal(
+			t,
+			[]string{
+				"only 1 matches between appearances",
+				"3 red and 0 blue appearances",
+			},
+			team1.Warnings,
+		)
+
+		team7 := teamStats[6]
+		assert.Equal(t, 7, team7.TeamId)
+		assert.Equal(t, []string{"Q3"}, team7.SurrogateMatches)
+		assert.Equal(t, 0, team7.MinMatchGap)
+		assert.Equal(t, 0, team7.PartnerRepeats)
+
+		team3 := teamStats[2]
+		assert.Equal(t, 2, team3.MinMatchGap)
+		assert.Equal(t, []string{"only 2 matches between appearances"}, team3.Warnings)
+	}
+
+	// Check that the repeats are counted in the same way as for the overall schedule quality.
+	numRepeatedPartners := 0
+	for _, stats := range teamStats {
+		numRepeatedPartners += stats.PartnerRepeats
+	}
+	assert.Equal(t, EvaluateSchedule(matches, 3).NumRepeatedPartners, numRepeatedPartners/2)
+
+	// Check that a single repeated partner is only flagged with a stricter threshold.
+	thresholds := DefaultScheduleWarningThresholds
+	thresholds.MaxPartnerRepeats = 0
+	assert.Contains(t, BuildTeamScheduleStats(matches, 3, thresholds)[0].Warnings, "1 repeated partners")
+
+	// Check that looser thresholds clear the warnings.
+	thresholds = ScheduleWarningThresholds{
+		MinMatchGap: 0, MaxPartnerRepeats: 3, MaxOpponentRepeats: 3, MaxRedBlueImbalance: 3,
+	}
+	for _, stats := range BuildTeamScheduleStats(matches, 3, thresholds) {
+		assert.Empty(t, stats.Warnings)
+	}
+}
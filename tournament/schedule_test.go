@@ -20,8 +20,8 @@ func TestMain(m *testing.M) {
 
 func TestNonExistentSchedule(t *testing.T) {
 	teams := make([]model.Team, 5)
-	scheduleBlocks := []model.ScheduleBlock{{0, model.Test, time.Unix(0, 0).UTC(), 2, 60}}
-	_, err := BuildRandomSchedule(teams, scheduleBlocks, model.Test)
+	scheduleBlocks := []model.ScheduleBlock{{0, model.Test, time.Unix(0, 0).UTC(), 2, 60, 0, ""}}
+	_, err := BuildRandomSchedule(teams, scheduleBlocks, model.Test, 3)
 	expectedErr := "No schedule template exists for 5 teams and 2 matches"
 	if assert.NotNil(t, err) {
 		assert.Equal(t, expectedErr, err.Error())
@@ -35,8 +35,8 @@ func TestMalformedSchedule(t *testing.T) {
 	scheduleFile.WriteString("1,0,2,0,3,0,4,0,5,0,6,0\n6,0,5,0,4,0,3,0,2,0,1,0\n")
 	scheduleFile.Close()
 	teams := make([]model.Team, 5)
-	scheduleBlocks := []model.ScheduleBlock{{0, model.Test, time.Unix(0, 0).UTC(), 1, 60}}
-	_, err := BuildRandomSchedule(teams, scheduleBlocks, model.Test)
+	scheduleBlocks := []model.ScheduleBlock{{0, model.Test, time.Unix(0, 0).UTC(), 1, 60, 0, ""}}
+	_, err := BuildRandomSchedule(teams, scheduleBlocks, model.Test, 3)
 	expectedErr := "Schedule file contains 2 matches, expected 1"
 	if assert.NotNil(t, err) {
 		assert.Equal(t, expectedErr, err.Error())
@@ -46,7 +46,7 @@ func TestMalformedSchedule(t *testing.T) {
 	scheduleFile, _ = os.Create(filename)
 	scheduleFile.WriteString("1,0,asdf,0,3,0,4,0,5,0,6,0\n")
 	scheduleFile.Close()
-	_, err = BuildRandomSchedule(teams, scheduleBlocks, model.Test)
+	_, err = BuildRandomSchedule(teams, scheduleBlocks, model.Test, 3)
 	if assert.NotNil(t, err) {
 		assert.Contains(t, err.Error(), "strconv.Atoi")
 	}
@@ -60,8 +60,8 @@ func TestScheduleTeams(t *testing.T) {
 	for i := 0; i < numTeams; i++ {
 		teams[i].Id = i + 101
 	}
-	scheduleBlocks := []model.ScheduleBlock{{0, model.Practice, time.Unix(0, 0).UTC(), 6, 60}}
-	matches, err := BuildRandomSchedule(teams, scheduleBlocks, model.Practice)
+	scheduleBlocks := []model.ScheduleBlock{{0, model.Practice, time.Unix(0, 0).UTC(), 6, 60, 0, ""}}
+	matches, err := BuildRandomSchedule(teams, scheduleBlocks, model.Practice, 3)
 	assert.Nil(t, err)
 	assertMatch(t, matches[0], model.Practice, 1, 0, "P1", "Practice 1", "p", 115, 111, 108, 109, 116, 117)
 	assertMatch(t, matches[1], model.Practice, 2, 60, "P2", "Practice 2", "p", 114, 112, 103, 101, 104, 118)
@@ -71,14 +71,14 @@ func TestScheduleTeams(t *testing.T) {
 	assertMatch(t, matches[5], model.Practice, 6, 300, "P6", "Practice 6", "p", 118, 105, 106, 107, 104, 116)
 
 	// Check with excess room for matches in the schedule.
-	scheduleBlocks = []model.ScheduleBlock{{0, model.Practice, time.Unix(0, 0).UTC(), 7, 60}}
-	matches, err = BuildRandomSchedule(teams, scheduleBlocks, model.Practice)
+	scheduleBlocks = []model.ScheduleBlock{{0, model.Practice, time.Unix(0, 0).UTC(), 7, 60, 0, ""}}
+	matches, err = BuildRandomSchedule(teams, scheduleBlocks, model.Practice, 3)
 	assert.Nil(t, err)
 
 	// Check with qualification matches.
 	rand.Seed(0)
-	scheduleBlocks = []model.ScheduleBlock{{0, model.Qualification, time.Unix(0, 0).UTC(), 6, 60}}
-	matches, err = BuildRandomSchedule(teams, scheduleBlocks, model.Qualification)
+	scheduleBlocks = []model.ScheduleBlock{{0, model.Qualification, time.Unix(0, 0).UTC(), 6, 60, 0, ""}}
+	matches, err = BuildRandomSchedule(teams, scheduleBlocks, model.Qualification, 3)
 	assert.Nil(t, err)
 	assertMatch(t, matches[0], model.Qualification, 1, 0, "Q1", "Qualification 1", "qm", 115, 111, 108, 109, 116, 117)
 	assertMatch(t, matches[1], model.Qualification, 2, 60, "Q2", "Qualification 2", "qm", 114, 112, 103, 101, 104, 118)
@@ -91,11 +91,11 @@ func TestScheduleTeams(t *testing.T) {
 func TestScheduleTiming(t *testing.T) {
 	teams := make([]model.Team, 18)
 	scheduleBlocks := []model.ScheduleBlock{
-		{0, model.Qualification, time.Unix(100, 0).UTC(), 10, 75},
-		{0, model.Qualification, time.Unix(20000, 0).UTC(), 5, 1000},
-		{0, model.Qualification, time.Unix(100000, 0).UTC(), 15, 29},
+		{0, model.Qualification, time.Unix(100, 0).UTC(), 10, 75, 0, ""},
+		{0, model.Qualification, time.Unix(20000, 0).UTC(), 5, 1000, 0, ""},
+		{0, model.Qualification, time.Unix(100000, 0).UTC(), 15, 29, 0, ""},
 	}
-	matches, err := BuildRandomSchedule(teams, scheduleBlocks, model.Qualification)
+	matches, err := BuildRandomSchedule(teams, scheduleBlocks, model.Qualification, 3)
 	assert.Nil(t, err)
 	assert.Equal(t, time.Unix(100, 0).UTC(), matches[0].Time)
 	assert.Equal(t, time.Unix(775, 0).UTC(), matches[9].Time)
@@ -105,6 +105,24 @@ func TestScheduleTiming(t *testing.T) {
 	assert.Equal(t, time.Unix(100406, 0).UTC(), matches[29].Time)
 }
 
+func TestAssignMatchFields(t *testing.T) {
+	matches := make([]model.Match, 5)
+	AssignMatchFields(matches, 2)
+	for i, fieldNumber := range []int{1, 2, 1, 2, 1} {
+		assert.Equal(t, fieldNumber, matches[i].FieldNumber)
+	}
+	AssignMatchFields(matches, 3)
+	for i, fieldNumber := range []int{1, 2, 3, 1, 2} {
+		assert.Equal(t, fieldNumber, matches[i].FieldNumber)
+	}
+
+	// A single field should leave the matches unassigned.
+	AssignMatchFields(matches, 1)
+	for _, match := range matches {
+		assert.Equal(t, 0, match.FieldNumber)
+	}
+}
+
 func TestScheduleSurrogates(t *testing.T) {
 	rand.Seed(0)
 
@@ -113,8 +131,8 @@ func TestScheduleSurrogates(t *testing.T) {
 	for i := 0; i < numTeams; i++ {
 		teams[i].Id = i + 101
 	}
-	scheduleBlocks := []model.ScheduleBlock{{0, model.Qualification, time.Unix(0, 0).UTC(), 64, 60}}
-	matches, _ := BuildRandomSchedule(teams, scheduleBlocks, model.Qualification)
+	scheduleBlocks := []model.ScheduleBlock{{0, model.Qualification, time.Unix(0, 0).UTC(), 64, 60, 0, ""}}
+	matches, _ := BuildRandomSchedule(teams, scheduleBlocks, model.Qualification, 3)
 	for i, match := range matches {
 		if i == 13 || i == 14 {
 			if !match.Red1IsSurrogate || match.Red2IsSurrogate || match.Red3IsSurrogate ||
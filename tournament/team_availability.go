@@ -0,0 +1,201 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Functions for building schedules in which teams only play while they are available.
+
+package tournament
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// shuffleAvailableTeams returns a random mapping from each anonymous team in the schedule (by zero-based index) to one
+// of the given teams, such that every team's matches fall within its availability window. Returns false if no such
+// mapping exists for the given schedule.
+func shuffleAvailableTeams(teams []model.Team, anonSchedule [][]int, matchTimes []time.Time) ([]int, bool) {
+	numTeams := len(teams)
+	if !hasAvailabilityConstraints(teams) {
+		return rand.Perm(numTeams), true
+	}
+
+	// Determine the span of time over which each anonymous team plays.
+	firstMatchTimes := make([]time.Time, numTeams)
+	lastMatchTimes := make([]time.Time, numTeams)
+	for i, anonMatch := range anonSchedule {
+		for j := 0; j < len(anonMatch); j += 2 {
+			anonTeam := anonMatch[j] - 1
+			if firstMatchTimes[anonTeam].IsZero() || matchTimes[i].Before(firstMatchTimes[anonTeam]) {
+				firstMatchTimes[anonTeam] = matchTimes[i]
+			}
+			if matchTimes[i].After(lastMatchTimes[anonTeam]) {
+				lastMatchTimes[anonTeam] = matchTimes[i]
+			}
+		}
+	}
+
+	// Build the list of anonymous teams that each team is able to fill in for, in random order.
+	candidates := make([][]int, numTeams)
+	for i, team := range teams {
+		for _, anonTeam := range rand.Perm(numTeams) {
+			if team.IsAvailableBetween(firstMatchTimes[anonTeam], lastMatchTimes[anonTeam]) {
+				candidates[i] = append(candidates[i], anonTeam)
+			}
+		}
+		if len(candidates[i]) == 0 {
+			return nil, false
+		}
+	}
+
+	// Find a complete assignment by augmenting paths (Kuhn's bipartite matching algorithm), considering the teams in
+	// random order.
+	teamShuffle := make([]int, numTeams)
+	for i := range teamShuffle {
+		teamShuffle[i] = -1
+	}
+	var assign func(team int, visited []bool) bool
+	assign = func(team int, visited []bool) bool {
+		for _, anonTeam := range candidates[team] {
+			if visited[anonTeam] {
+				continue
+			}
+			visited[anonTeam] = true
+			if teamShuffle[anonTeam] < 0 || assign(teamShuffle[anonTeam], visited) {
+				teamShuffle[anonTeam] = team
+				return true
+			}
+		}
+		return false
+	}
+	for _, team := range rand.Perm(numTeams) {
+		if !assign(team, make([]bool, numTeams)) {
+			return nil, false
+		}
+	}
+	return teamShuffle, true
+}
+
+// generateAvailableSchedule generates an anonymized schedule from scratch in which each team only plays within its
+// availability window, for use when no permutation of a precomputed schedule satisfies the constraints. Returns the
+// schedule along with the mapping from each anonymous team to one of the given teams.
+func generateAvailableSchedule(
+	teams []model.Team, matchTimes []time.Time, matchesPerTeam, teamsPerAlliance int,
+) ([][]int, []int, error) {
+	numTeams := len(teams)
+	if numTeams < 2*teamsPerAlliance || matchesPerTeam < 1 {
+		return nil, nil, fmt.Errorf(
+			"cannot schedule %d teams with %d matches each around their availability", numTeams, matchesPerTeam,
+		)
+	}
+	teamShuffle := rand.Perm(numTeams)
+
+	// Convert each team's availability window into the range of match indices in which it may play.
+	availableMatches := make([][2]int, numTeams)
+	var problems []string
+	for anonTeam, teamIndex := range teamShuffle {
+		team := teams[teamIndex]
+		first, last := availableMatchRange(team, matchTimes)
+		availableMatches[anonTeam] = [2]int{first, last}
+		if numAvailable := last - first + 1; first < 0 || numAvailable < matchesPerTeam {
+			problems = append(
+				problems,
+				fmt.Sprintf(
+					"%s can only play in %d of the %d matches but needs to play in %d",
+					describeAvailability(team),
+					max(0, numAvailable),
+					len(matchTimes),
+					matchesPerTeam,
+				),
+			)
+		}
+	}
+	for i, matchTime := range matchTimes {
+		numAvailable := 0
+		for _, team := range teams {
+			if team.IsAvailableBetween(matchTime, matchTime) {
+				numAvailable++
+			}
+		}
+		if numAvailable < 2*teamsPerAlliance {
+			problems = append(
+				problems,
+				fmt.Sprintf(
+					"only %d teams are available for match %d at %s", numAvailable, i+1, formatAvailabilityTime(matchTime),
+				),
+			)
+		}
+	}
+	if len(problems) > 0 {
+		return nil, nil, fmt.Errorf(
+			"the team availability constraints cannot be satisfied: %s", strings.Join(problems, "; "),
+		)
+	}
+
+	optimizer, err := optimizeAnonSchedule(numTeams, matchesPerTeam, teamsPerAlliance, availableMatches)
+	if err != nil {
+		return nil, nil, err
+	}
+	if unavailableTeams := optimizer.unavailableTeams(); len(unavailableTeams) > 0 {
+		descriptions := make([]string, len(unavailableTeams))
+		for i, anonTeam := range unavailableTeams {
+			descriptions[i] = describeAvailability(teams[teamShuffle[anonTeam]])
+		}
+		return nil, nil, fmt.Errorf(
+			"could not find a schedule in which every team plays only while available; unable to accommodate %s. "+
+				"Try widening the availability windows or adding matches to the schedule blocks.",
+			strings.Join(descriptions, ", "),
+		)
+	}
+	return optimizer.anonSchedule(), teamShuffle, nil
+}
+
+// availableMatchRange returns the indices of the first and last of the given match times at which the team is
+// available, or -1 for both if there are none.
+func availableMatchRange(team model.Team, matchTimes []time.Time) (int, int) {
+	first, last := -1, -1
+	for i, matchTime := range matchTimes {
+		if team.IsAvailableBetween(matchTime, matchTime) {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	return first, last
+}
+
+// hasAvailabilityConstraints returns true if any of the given teams is unavailable for part of the event.
+func hasAvailabilityConstraints(teams []model.Team) bool {
+	for _, team := range teams {
+		if team.HasAvailabilityConstraints() {
+			return true
+		}
+	}
+	return false
+}
+
+// describeAvailability returns a human-readable description of the given team's availability window.
+func describeAvailability(team model.Team) string {
+	switch {
+	case !team.NotAvailableBefore.IsZero() && !team.NotAvailableAfter.IsZero():
+		return fmt.Sprintf(
+			"team %d (available %s to %s)",
+			team.Id,
+			formatAvailabilityTime(team.NotAvailableBefore),
+			formatAvailabilityTime(team.NotAvailableAfter),
+		)
+	case !team.NotAvailableBefore.IsZero():
+		return fmt.Sprintf("team %d (available from %s)", team.Id, formatAvailabilityTime(team.NotAvailableBefore))
+	case !team.NotAvailableAfter.IsZero():
+		return fmt.Sprintf("team %d (available until %s)", team.Id, formatAvailabilityTime(team.NotAvailableAfter))
+	default:
+		return fmt.Sprintf("team %d", team.Id)
+	}
+}
+
+func formatAvailabilityTime(value time.Time) string {
+	return value.Local().Format("Mon 3:04 PM")
+}
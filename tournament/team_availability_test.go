@@ -0,0 +1,94 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package tournament
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleTeamsWithAvailability(t *testing.T) {
+	rand.Seed(0)
+
+	numTeams := 18
+	teams := make([]model.Team, numTeams)
+	for i := 0; i < numTeams; i++ {
+		teams[i].Id = i + 101
+	}
+	teams[0].NotAvailableBefore = time.Unix(1200, 0).UTC()
+	teams[1].NotAvailableAfter = time.Unix(3000, 0).UTC()
+	teams[2].NotAvailableBefore = time.Unix(600, 0).UTC()
+	teams[2].NotAvailableAfter = time.Unix(3600, 0).UTC()
+	scheduleBlocks := []model.ScheduleBlock{
+		{MatchType: model.Qualification, StartTime: time.Unix(0, 0).UTC(), NumMatches: 36, MatchSpacingSec: 120},
+	}
+	matches, err := BuildRandomSchedule(teams, scheduleBlocks, model.Qualification, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 36, len(matches))
+	assert.Equal(t, time.Unix(4200, 0).UTC(), matches[35].Time)
+	teamStats := BuildTeamScheduleStats(matches, 3, DefaultScheduleWarningThresholds)
+	if assert.Equal(t, numTeams, len(teamStats)) {
+		for _, stats := range teamStats {
+			team := teams[stats.TeamId-101]
+			assert.Equal(t, 12, stats.NumMatches)
+			assert.True(
+				t,
+				team.IsAvailableBetween(stats.FirstMatchTime, stats.LastMatchTime),
+				"team %d scheduled outside of its availability",
+				team.Id,
+			)
+		}
+	}
+	assert.Equal(t, 0, EvaluateSchedule(matches, 3).NumDuplicateTeams)
+
+	// Check that a team whose window doesn't leave room for all of its matches is reported.
+	teams[3].NotAvailableBefore = time.Unix(3600, 0).UTC()
+	_, err = BuildRandomSchedule(teams, scheduleBlocks, model.Qualification, 3)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "the team availability constraints cannot be satisfied: team 104 (available from ")
+		assert.Contains(t, err.Error(), "can only play in 6 of the 36 matches but needs to play in 12")
+	}
+
+	// Check that a match for which too few teams are available is reported.
+	teams[3].NotAvailableBefore = time.Time{}
+	for i := 0; i < 13; i++ {
+		teams[i].NotAvailableBefore = time.Unix(60, 0).UTC()
+	}
+	_, err = BuildRandomSchedule(teams, scheduleBlocks, model.Qualification, 3)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "only 5 teams are available for match 1 at ")
+	}
+}
+
+func TestShuffleAvailableTeams(t *testing.T) {
+	rand.Seed(0)
+
+	teams := make([]model.Team, 12)
+	for i := range teams {
+		teams[i].Id = i + 101
+	}
+	anonSchedule := [][]int{
+		{1, 0, 2, 0, 3, 0, 4, 0, 5, 0, 6, 0},
+		{7, 0, 8, 0, 9, 0, 10, 0, 11, 0, 12, 0},
+	}
+	matchTimes := []time.Time{time.Unix(0, 0), time.Unix(100, 0)}
+
+	// Check that teams available only for the first match are placed in it.
+	for i := 0; i < 6; i++ {
+		teams[i].NotAvailableAfter = time.Unix(50, 0)
+	}
+	teamShuffle, ok := shuffleAvailableTeams(teams, anonSchedule, matchTimes)
+	assert.True(t, ok)
+	for anonTeam := 0; anonTeam < 6; anonTeam++ {
+		assert.Less(t, teamShuffle[anonTeam], 6)
+	}
+
+	// Check that no mapping is found when more teams are limited to the first match than can fit in it.
+	teams[6].NotAvailableAfter = time.Unix(50, 0)
+	_, ok = shuffleAvailableTeams(teams, anonSchedule, matchTimes)
+	assert.False(t, ok)
+}
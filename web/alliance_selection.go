@@ -115,11 +115,22 @@ func (web *Web) allianceSelectionStartHandler(w http.ResponseWriter, r *http.Req
 	}
 
 	// Create a blank alliance set matching the event configuration.
-	web.arena.AllianceSelectionAlliances = make([]model.Alliance, web.arena.EventSettings.NumPlayoffAlliances)
 	teamsPerAlliance := 3
 	if web.arena.EventSettings.SelectionRound3Order != "" {
 		teamsPerAlliance = 4
 	}
+	if teamsPerAlliance < web.arena.EventSettings.TeamsPerAlliance {
+		web.renderAllianceSelection(
+			w,
+			r,
+			fmt.Sprintf(
+				"Alliances need %d teams to fill their stations; enable the third selection round in the settings.",
+				web.arena.EventSettings.TeamsPerAlliance,
+			),
+		)
+		return
+	}
+	web.arena.AllianceSelectionAlliances = make([]model.Alliance, web.arena.EventSettings.NumPlayoffAlliances)
 	for i := 0; i < web.arena.EventSettings.NumPlayoffAlliances; i++ {
 		web.arena.AllianceSelectionAlliances[i].Id = i + 1
 		web.arena.AllianceSelectionAlliances[i].TeamIds = make([]int, teamsPerAlliance)
@@ -204,11 +215,7 @@ func (web *Web) allianceSelectionFinalizeHandler(w http.ResponseWriter, r *http.
 
 	// Save alliances to the database.
 	for _, alliance := range web.arena.AllianceSelectionAlliances {
-		// Populate the initial lineup according to the tournament rules (alliance captain in the middle, first pick on
-		// the left, second pick on the right).
-		alliance.Lineup[0] = alliance.TeamIds[1]
-		alliance.Lineup[1] = alliance.TeamIds[0]
-		alliance.Lineup[2] = alliance.TeamIds[2]
+		alliance.Lineup = model.InitialAllianceLineup(alliance.TeamIds, web.arena.EventSettings.TeamsPerAlliance)
 
 		err := web.arena.Database.CreateAlliance(&alliance)
 		if err != nil {
@@ -111,8 +111,16 @@ func TestAllianceSelectionErrors(t *testing.T) {
 		web.arena.Database.CreateRanking(&game.Ranking{TeamId: 100 + i, Rank: i})
 	}
 
-	// Start an alliance selection that is already underway.
+	// Start an alliance selection without enough picks to fill every station.
+	web.arena.EventSettings.TeamsPerAlliance = 4
 	recorder := web.postHttpResponse("/alliance_selection/start", "")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Alliances need 4 teams to fill their stations")
+	assert.Empty(t, web.arena.AllianceSelectionAlliances)
+	web.arena.EventSettings.TeamsPerAlliance = 3
+
+	// Start an alliance selection that is already underway.
+	recorder = web.postHttpResponse("/alliance_selection/start", "")
 	assert.Equal(t, 303, recorder.Code)
 	recorder = web.postHttpResponse("/alliance_selection/start", "")
 	assert.Equal(t, 200, recorder.Code)
@@ -12,11 +12,14 @@ import (
 	"github.com/Team254/cheesy-arena/model"
 	"github.com/Team254/cheesy-arena/partner"
 	"github.com/Team254/cheesy-arena/playoff"
+	"github.com/Team254/cheesy-arena/tournament"
 	"github.com/Team254/cheesy-arena/websocket"
 	"io"
 	"net/http"
 	"os"
+	"sort"
 	"strconv"
+	"time"
 )
 
 type MatchResultWithSummary struct {
@@ -47,6 +50,80 @@ type allianceMatchup struct {
 	IsComplete         bool
 }
 
+type bracketApiAllianceSource struct {
+	DisplayName string
+	playoff.AllianceSourceDetails
+}
+
+type bracketApiMatch struct {
+	Id            int
+	TypeOrder     int
+	ShortName     string
+	LongName      string
+	Time          time.Time
+	ProjectedTime time.Time
+	Status        game.MatchStatus
+	IsComplete    bool
+	IsReplay      bool
+	RedScore      int
+	BlueScore     int
+}
+
+type bracketApiMatchGroup struct {
+	Id                           string
+	Round                        int
+	NumWinsToAdvance             int
+	RedAllianceSource            bracketApiAllianceSource
+	BlueAllianceSource           bracketApiAllianceSource
+	RedAllianceId                int
+	BlueAllianceId               int
+	RedAlliance                  *model.Alliance
+	BlueAlliance                 *model.Alliance
+	RedAllianceWins              int
+	BlueAllianceWins             int
+	NumMatchesPlayed             int
+	SeriesLeader                 string
+	SeriesStatus                 string
+	IsComplete                   bool
+	WinningAllianceId            int
+	LosingAllianceId             int
+	WinningAllianceDestinationId string
+	LosingAllianceDestinationId  string
+	Matches                      []bracketApiMatch
+}
+
+type bracketApiResponse struct {
+	PlayoffType        string
+	NumAlliances       int
+	IsComplete         bool
+	WinningAllianceId  int
+	FinalistAllianceId int
+	MatchGroups        []bracketApiMatchGroup
+}
+
+// Generates a JSON dump of the cycle time analytics for the given match type.
+func (web *Web) cycleTimeApiHandler(w http.ResponseWriter, r *http.Request) {
+	_, analytics, err := web.getCycleTimeAnalytics(r)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	jsonData, err := json.MarshalIndent(analytics, "", "  ")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.Header().Add("Access-Control-Allow-Origin", "*")
+	_, err = w.Write(jsonData)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+}
+
 // Generates a JSON dump of the matches and results.
 func (web *Web) matchesApiHandler(w http.ResponseWriter, r *http.Request) {
 	matchType, err := model.MatchTypeFromString(r.PathValue("type"))
@@ -204,6 +281,36 @@ func (web *Web) alliancesApiHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Generates a JSON dump of this event's winning alliance, for import as a seed into a championship event.
+func (web *Web) divisionWinnerApiHandler(w http.ResponseWriter, r *http.Request) {
+	if web.arena.PlayoffTournament == nil || !web.arena.PlayoffTournament.IsComplete() {
+		http.Error(w, "playoff tournament is not yet complete", http.StatusNotFound)
+		return
+	}
+
+	divisionWinner, err := tournament.GetDivisionWinner(
+		web.arena.Database, web.arena.EventSettings.Name, web.arena.PlayoffTournament.WinningAllianceId(),
+	)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	jsonData, err := json.MarshalIndent(divisionWinner, "", "  ")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.Header().Add("Access-Control-Allow-Origin", "*")
+	_, err = w.Write(jsonData)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+}
+
 // Websocket API for receiving arena status updates.
 func (web *Web) arenaWebsocketApiHandler(w http.ResponseWriter, r *http.Request) {
 	ws, err := websocket.NewWebsocket(w, r)
@@ -233,6 +340,152 @@ func (web *Web) teamAvatarsApiHandler(w http.ResponseWriter, r *http.Request) {
 	http.ServeFile(w, r, avatarPath)
 }
 
+// Generates a JSON dump of the full state of the playoff bracket, for use by overlays that render it themselves.
+func (web *Web) bracketApiHandler(w http.ResponseWriter, r *http.Request) {
+	bracket, err := web.getBracketApiResponse()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	jsonData, err := json.MarshalIndent(bracket, "", "  ")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.Header().Add("Access-Control-Allow-Origin", "*")
+	_, err = w.Write(jsonData)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+}
+
+// Assembles the structured representation of the playoff bracket, with the match groups ordered by round and then by
+// order of play.
+func (web *Web) getBracketApiResponse() (*bracketApiResponse, error) {
+	bracket := bracketApiResponse{
+		PlayoffType:  "double",
+		NumAlliances: web.arena.EventSettings.NumPlayoffAlliances,
+		MatchGroups:  make([]bracketApiMatchGroup, 0),
+	}
+	if web.arena.EventSettings.PlayoffType == model.SingleEliminationPlayoff {
+		bracket.PlayoffType = "single"
+	}
+	playoffTournament := web.arena.PlayoffTournament
+	if playoffTournament == nil {
+		return &bracket, nil
+	}
+	bracket.IsComplete = playoffTournament.IsComplete()
+	bracket.WinningAllianceId = playoffTournament.WinningAllianceId()
+	bracket.FinalistAllianceId = playoffTournament.FinalistAllianceId()
+
+	alliances, err := web.arena.Database.GetAllAlliances()
+	if err != nil {
+		return nil, err
+	}
+	getAlliance := func(allianceId int) *model.Alliance {
+		if allianceId == 0 {
+			return nil
+		}
+		if len(alliances) >= allianceId {
+			return &alliances[allianceId-1]
+		}
+		return &model.Alliance{Id: allianceId}
+	}
+
+	matches, err := web.arena.Database.GetMatchesByType(model.Playoff, false)
+	if err != nil {
+		return nil, err
+	}
+	matchesByGroupId := make(map[string][]bracketApiMatch)
+	for _, match := range matches {
+		bracketMatch := bracketApiMatch{
+			Id:            match.Id,
+			TypeOrder:     match.TypeOrder,
+			ShortName:     match.ShortName,
+			LongName:      match.LongName,
+			Time:          match.Time,
+			ProjectedTime: match.ProjectedTime,
+			Status:        match.Status,
+			IsComplete:    match.IsComplete(),
+			IsReplay:      match.IsReplay,
+		}
+		if match.IsComplete() {
+			matchResult, err := web.arena.Database.GetMatchResultForMatch(match.Id)
+			if err != nil {
+				return nil, err
+			}
+			if matchResult != nil {
+				bracketMatch.RedScore = matchResult.RedScoreSummary().Score
+				bracketMatch.BlueScore = matchResult.BlueScoreSummary().Score
+			}
+		}
+		matchesByGroupId[match.PlayoffMatchGroupId] = append(matchesByGroupId[match.PlayoffMatchGroupId], bracketMatch)
+	}
+
+	for _, matchGroup := range playoffTournament.MatchGroups() {
+		matchup, ok := matchGroup.(*playoff.Matchup)
+		if !ok {
+			continue
+		}
+		bracketMatchGroup := bracketApiMatchGroup{
+			Id:               matchup.Id(),
+			Round:            matchup.Round(),
+			NumWinsToAdvance: matchup.NumWinsToAdvance,
+			RedAllianceSource: bracketApiAllianceSource{
+				matchup.RedAllianceSourceDisplayName(), matchup.RedAllianceSourceDetails(),
+			},
+			BlueAllianceSource: bracketApiAllianceSource{
+				matchup.BlueAllianceSourceDisplayName(), matchup.BlueAllianceSourceDetails(),
+			},
+			RedAllianceId:     matchup.RedAllianceId,
+			BlueAllianceId:    matchup.BlueAllianceId,
+			RedAlliance:       getAlliance(matchup.RedAllianceId),
+			BlueAlliance:      getAlliance(matchup.BlueAllianceId),
+			RedAllianceWins:   matchup.RedAllianceWins,
+			BlueAllianceWins:  matchup.BlueAllianceWins,
+			NumMatchesPlayed:  matchup.NumMatchesPlayed,
+			IsComplete:        matchup.IsComplete(),
+			WinningAllianceId: matchup.WinningAllianceId(),
+			LosingAllianceId:  matchup.LosingAllianceId(),
+			Matches:           matchesByGroupId[matchup.Id()],
+		}
+		bracketMatchGroup.SeriesLeader, bracketMatchGroup.SeriesStatus = matchup.StatusText()
+		bracketMatchGroup.WinningAllianceDestinationId, bracketMatchGroup.LosingAllianceDestinationId =
+			matchup.DestinationIds()
+		if bracketMatchGroup.Matches == nil {
+			bracketMatchGroup.Matches = make([]bracketApiMatch, 0)
+		}
+		bracket.MatchGroups = append(bracket.MatchGroups, bracketMatchGroup)
+	}
+
+	// Order the match groups by round and then by their first match, falling back to the ID for groups without matches.
+	firstMatchOrder := func(matchGroup bracketApiMatchGroup) int {
+		if len(matchGroup.Matches) == 0 {
+			return 0
+		}
+		return matchGroup.Matches[0].TypeOrder
+	}
+	sort.Slice(
+		bracket.MatchGroups,
+		func(i, j int) bool {
+			a, b := bracket.MatchGroups[i], bracket.MatchGroups[j]
+			if a.Round != b.Round {
+				return a.Round < b.Round
+			}
+			if firstMatchOrder(a) != firstMatchOrder(b) {
+				return firstMatchOrder(a) < firstMatchOrder(b)
+			}
+			return a.Id < b.Id
+		},
+	)
+
+	return &bracket, nil
+}
+
 func (web *Web) bracketSvgApiHandler(w http.ResponseWriter, r *http.Request) {
 	var activeMatch *model.Match
 	if activeMatchValue, ok := r.URL.Query()["activeMatch"]; ok {
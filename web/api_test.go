@@ -80,6 +80,37 @@ func TestMatchesApi(t *testing.T) {
 	}
 }
 
+func TestCycleTimeApi(t *testing.T) {
+	web := setupTestWeb(t)
+
+	web.arena.Database.CreateMatch(
+		&model.Match{
+			Type: model.Practice, TypeOrder: 1, ShortName: "P1", Time: time.Unix(0, 0), StartedAt: time.Unix(0, 0),
+		},
+	)
+	web.arena.Database.CreateMatch(
+		&model.Match{
+			Type: model.Practice, TypeOrder: 2, ShortName: "P2", Time: time.Unix(300, 0), StartedAt: time.Unix(400, 0),
+		},
+	)
+
+	recorder := web.getHttpResponse("/api/cycle_time/practice")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header()["Content-Type"][0])
+	var analytics tournament.CycleTimeAnalytics
+	err := json.Unmarshal([]byte(recorder.Body.String()), &analytics)
+	assert.Nil(t, err)
+	assert.Equal(t, 400, analytics.RollingAverageCycleTimeSec)
+	if assert.Equal(t, 2, len(analytics.Matches)) {
+		assert.Equal(t, 400, analytics.Matches[1].CycleTimeSec)
+		assert.Equal(t, 100, analytics.Matches[1].LateSec)
+	}
+	if assert.Equal(t, 1, len(analytics.BiggestDelays)) {
+		assert.Equal(t, "P2", analytics.BiggestDelays[0].ShortName)
+		assert.Equal(t, tournament.CycleTimeCauseUnknown, analytics.BiggestDelays[0].Cause)
+	}
+}
+
 func TestRankingsApi(t *testing.T) {
 	web := setupTestWeb(t)
 
@@ -193,6 +224,52 @@ func TestBracketSvgApiDoubleElimination(t *testing.T) {
 	assert.Contains(t, recorder.Body.String(), "Best-of-3")
 }
 
+func TestBracketApi(t *testing.T) {
+	web := setupTestWeb(t)
+	web.arena.EventSettings.PlayoffType = model.DoubleEliminationPlayoff
+	tournament.CreateTestAlliances(web.arena.Database, 8)
+	web.arena.CreatePlayoffTournament()
+	assert.Nil(t, web.arena.CreatePlayoffMatches(time.Unix(1000, 0)))
+	match, _ := web.arena.Database.GetMatchByTypeOrder(model.Playoff, 1)
+	match.Status = game.BlueWonMatch
+	assert.Nil(t, web.arena.Database.UpdateMatch(match))
+	assert.Nil(t, web.arena.UpdatePlayoffTournament())
+
+	recorder := web.getHttpResponse("/api/bracket")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header()["Content-Type"][0])
+	var bracket bracketApiResponse
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &bracket))
+	assert.Equal(t, "double", bracket.PlayoffType)
+	assert.False(t, bracket.IsComplete)
+	if assert.Equal(t, 14, len(bracket.MatchGroups)) {
+		m1 := bracket.MatchGroups[0]
+		assert.Equal(t, "M1", m1.Id)
+		assert.Equal(t, 1, m1.Round)
+		assert.Equal(t, 1, m1.RedAllianceSource.AllianceSelectionId)
+		assert.Equal(t, "A 8", m1.BlueAllianceSource.DisplayName)
+		assert.Equal(t, 8, m1.WinningAllianceId)
+		assert.Equal(t, "M7", m1.WinningAllianceDestinationId)
+		assert.Equal(t, "M5", m1.LosingAllianceDestinationId)
+		assert.Equal(t, []int{802, 801, 803}, m1.BlueAlliance.Lineup[:])
+		if assert.Equal(t, 1, len(m1.Matches)) {
+			assert.True(t, m1.Matches[0].IsComplete)
+		}
+
+		m5 := bracket.MatchGroups[4]
+		assert.Equal(t, "M5", m5.Id)
+		assert.Equal(t, 2, m5.Round)
+		assert.Equal(t, "M1", m5.RedAllianceSource.MatchGroupId)
+		assert.False(t, m5.RedAllianceSource.UseWinner)
+		assert.Equal(t, 1, m5.RedAllianceId)
+		assert.Nil(t, m5.BlueAlliance)
+
+		final := bracket.MatchGroups[13]
+		assert.Equal(t, "F", final.Id)
+		assert.Equal(t, 3, len(final.Matches))
+	}
+}
+
 func TestStationStopsApiDisabled(t *testing.T) {
 	web := setupTestWeb(t)
 
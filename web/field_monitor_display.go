@@ -69,6 +69,8 @@ func (web *Web) fieldMonitorDisplayWebsocketHandler(w http.ResponseWriter, r *ht
 		web.arena.RealtimeScoreNotifier,
 		web.arena.MatchTimeNotifier,
 		web.arena.MatchLoadNotifier,
+		web.arena.QueueStatusNotifier,
+		web.arena.FieldEventsNotifier,
 		web.arena.ReloadDisplaysNotifier,
 	)
 
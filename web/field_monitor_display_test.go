@@ -23,7 +23,7 @@ func TestFieldMonitorDisplay(t *testing.T) {
 func TestFieldMonitorDisplayWebsocket(t *testing.T) {
 	web := setupTestWeb(t)
 	web.arena.Database.CreateTeam(&model.Team{Id: 254})
-	assert.Nil(t, web.arena.SubstituteTeams(0, 0, 0, 254, 0, 0))
+	assert.Nil(t, web.arena.SubstituteTeams([]int{0, 0, 0}, []int{254, 0, 0}))
 
 	server, wsUrl := web.startTestServer()
 	defer server.Close()
@@ -42,6 +42,8 @@ func TestFieldMonitorDisplayWebsocket(t *testing.T) {
 	readWebsocketType(t, ws, "realtimeScore")
 	readWebsocketType(t, ws, "matchTime")
 	readWebsocketType(t, ws, "matchLoad")
+	readWebsocketType(t, ws, "queueStatus")
+	readWebsocketType(t, ws, "fieldEvents")
 
 	// Should not be able to update team notes.
 	ws.Write("updateTeamNotes", map[string]any{"station": "B1", "notes": "Bypassed in M1"})
@@ -52,7 +54,7 @@ func TestFieldMonitorDisplayWebsocket(t *testing.T) {
 func TestFieldMonitorFtaDisplayWebsocket(t *testing.T) {
 	web := setupTestWeb(t)
 	web.arena.Database.CreateTeam(&model.Team{Id: 254})
-	assert.Nil(t, web.arena.SubstituteTeams(0, 0, 0, 254, 0, 0))
+	assert.Nil(t, web.arena.SubstituteTeams([]int{0, 0, 0}, []int{254, 0, 0}))
 
 	server, wsUrl := web.startTestServer()
 	defer server.Close()
@@ -71,6 +73,8 @@ func TestFieldMonitorFtaDisplayWebsocket(t *testing.T) {
 	readWebsocketType(t, ws, "realtimeScore")
 	readWebsocketType(t, ws, "matchTime")
 	readWebsocketType(t, ws, "matchLoad")
+	readWebsocketType(t, ws, "queueStatus")
+	readWebsocketType(t, ws, "fieldEvents")
 
 	// Should not be able to update team notes.
 	ws.Write("updateTeamNotes", map[string]any{"station": "B1", "notes": "Bypassed in M1"})
@@ -82,4 +86,16 @@ func TestFieldMonitorFtaDisplayWebsocket(t *testing.T) {
 	assert.Contains(t, readWebsocketError(t, ws), "Invalid alliance station")
 	ws.Write("updateTeamNotes", map[string]any{"station": "R3", "notes": "Bypassed in M3"})
 	assert.Contains(t, readWebsocketError(t, ws), "No team present")
+
+	// Check that only the newly recorded field events are sent after each arena loop iteration.
+	web.arena.Update()
+	readWebsocketType(t, ws, "matchTime")
+	web.arena.AllianceStations["R2"].Bypass = true
+	web.arena.Update()
+	messages := readWebsocketMultiple(t, ws, 2)
+	message := messages["fieldEvents"].(map[string]any)
+	assert.Equal(t, true, message["Incremental"])
+	if assert.Equal(t, 1, len(message["Events"].([]any))) {
+		assert.Equal(t, "Bypassed", message["Events"].([]any)[0].(map[string]any)["Description"])
+	}
 }
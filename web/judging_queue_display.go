@@ -0,0 +1,141 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Web handlers for the judging queue display.
+
+package web
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/Team254/cheesy-arena/websocket"
+)
+
+const numUpcomingJudgingSlotsToShow = 3
+
+// judgingQueuePanel represents the visit currently in progress and those coming up next for a single judging panel.
+type judgingQueuePanel struct {
+	Name          string
+	Room          string
+	AwardCategory string
+	CurrentSlot   *model.JudgingSlot
+	UpcomingSlots []model.JudgingSlot
+}
+
+// Renders the judging queue display that shows the current and upcoming judging visits for each panel.
+func (web *Web) judgingQueueDisplayHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.enforceDisplayConfiguration(w, r, nil) {
+		return
+	}
+
+	template, err := web.parseFiles("templates/judging_queue_display.html")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	data := struct {
+		*model.EventSettings
+	}{
+		web.arena.EventSettings,
+	}
+	err = template.ExecuteTemplate(w, "judging_queue_display.html", data)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+}
+
+// Renders a partial template containing the current and upcoming judging visits for each panel.
+func (web *Web) judgingQueueDisplaySlotsHandler(w http.ResponseWriter, r *http.Request) {
+	slots, err := web.arena.Database.GetAllJudgingSlots()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	template, err := web.parseFiles("templates/judging_queue_display_slots.html")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	data := struct {
+		Panels []judgingQueuePanel
+	}{
+		buildJudgingQueuePanels(slots, time.Now()),
+	}
+	err = template.ExecuteTemplate(w, "judging_queue_display_slots.html", data)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+}
+
+// The websocket endpoint for the judging queue display to receive updates.
+func (web *Web) judgingQueueDisplayWebsocketHandler(w http.ResponseWriter, r *http.Request) {
+	display, err := web.registerDisplay(r)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	defer web.arena.MarkDisplayDisconnected(display.DisplayConfiguration.Id)
+
+	ws, err := websocket.NewWebsocket(w, r)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	defer ws.Close()
+
+	// Subscribe the websocket to the notifiers whose messages will be passed on to the client.
+	ws.HandleNotifiers(display.Notifier, web.arena.ReloadDisplaysNotifier)
+}
+
+// Groups the given judging slots by panel and picks out the visit in progress and the next few for each one as of the
+// given time. Panels that have no visits left are omitted.
+func buildJudgingQueuePanels(slots []model.JudgingSlot, now time.Time) []judgingQueuePanel {
+	sort.Slice(
+		slots,
+		func(i, j int) bool {
+			if slots[i].JudgeNumber != slots[j].JudgeNumber {
+				return slots[i].JudgeNumber < slots[j].JudgeNumber
+			}
+			return slots[i].Time.Before(slots[j].Time)
+		},
+	)
+
+	var panels []judgingQueuePanel
+	panelIndices := make(map[int]int)
+	for _, slot := range slots {
+		endTime := slot.EndTime
+		if endTime.IsZero() {
+			endTime = slot.Time.Add(time.Duration(judgingScheduleParams.DurationMinutes) * time.Minute)
+		}
+		if !endTime.After(now) {
+			continue
+		}
+
+		index, ok := panelIndices[slot.JudgeNumber]
+		if !ok {
+			index = len(panels)
+			panelIndices[slot.JudgeNumber] = index
+			panels = append(
+				panels, judgingQueuePanel{Name: slot.PanelName, Room: slot.Room, AwardCategory: slot.AwardCategory},
+			)
+			if panels[index].Name == "" {
+				panels[index].Name = "Judge " + strconv.Itoa(slot.JudgeNumber)
+			}
+		}
+		panel := &panels[index]
+		if !slot.Time.After(now) {
+			panel.CurrentSlot = &slot
+		} else if len(panel.UpcomingSlots) < numUpcomingJudgingSlotsToShow {
+			panel.UpcomingSlots = append(panel.UpcomingSlots, slot)
+		}
+	}
+	return panels
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package web
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/Team254/cheesy-arena/websocket"
+	gorillawebsocket "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJudgingQueueDisplay(t *testing.T) {
+	web := setupTestWeb(t)
+
+	recorder := web.getHttpResponse("/displays/judging_queue?displayId=1")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Judging Queue Display - Untitled Event - Cheesy Arena")
+
+	recorder = web.getHttpResponse("/displays/judging_queue/slots")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "There are no judging visits remaining.")
+
+	now := time.Now()
+	slots := []model.JudgingSlot{
+		{Time: now.Add(-5 * time.Minute), EndTime: now.Add(5 * time.Minute), TeamId: 254, JudgeNumber: 1,
+			PanelName: "Impact Panel", Room: "Room 2", AwardCategory: "Impact"},
+		{Time: now.Add(10 * time.Minute), EndTime: now.Add(20 * time.Minute), TeamId: 1114, JudgeNumber: 1,
+			PanelName: "Impact Panel", Room: "Room 2", AwardCategory: "Impact"},
+		{Time: now.Add(-30 * time.Minute), EndTime: now.Add(-20 * time.Minute), TeamId: 2056, JudgeNumber: 2,
+			PanelName: "Panel B", Room: "Pits"},
+	}
+	for _, slot := range slots {
+		assert.Nil(t, web.arena.Database.CreateJudgingSlot(&slot))
+	}
+	recorder = web.getHttpResponse("/displays/judging_queue/slots")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Impact Panel")
+	assert.Contains(t, recorder.Body.String(), "Room 2 &ndash; Impact")
+	assert.Contains(t, recorder.Body.String(), "<h1 class=\"current-team\">254</h1>")
+	assert.Contains(t, recorder.Body.String(), "1114")
+	assert.NotContains(t, recorder.Body.String(), "Panel B")
+}
+
+func TestBuildJudgingQueuePanels(t *testing.T) {
+	now := time.Unix(1000, 0)
+	slots := []model.JudgingSlot{
+		{Time: time.Unix(1300, 0), EndTime: time.Unix(1400, 0), TeamId: 4, JudgeNumber: 1},
+		{Time: time.Unix(900, 0), EndTime: time.Unix(1100, 0), TeamId: 1, JudgeNumber: 1},
+		{Time: time.Unix(1100, 0), EndTime: time.Unix(1200, 0), TeamId: 2, JudgeNumber: 1},
+		{Time: time.Unix(1500, 0), EndTime: time.Unix(1600, 0), TeamId: 5, JudgeNumber: 1},
+		{Time: time.Unix(1200, 0), EndTime: time.Unix(1300, 0), TeamId: 3, JudgeNumber: 1},
+		{Time: time.Unix(1100, 0), EndTime: time.Unix(1200, 0), TeamId: 6, JudgeNumber: 2, PanelName: "Robot Panel"},
+	}
+	panels := buildJudgingQueuePanels(slots, now)
+	if assert.Equal(t, 2, len(panels)) {
+		assert.Equal(t, "Judge 1", panels[0].Name)
+		if assert.NotNil(t, panels[0].CurrentSlot) {
+			assert.Equal(t, 1, panels[0].CurrentSlot.TeamId)
+		}
+		if assert.Equal(t, 3, len(panels[0].UpcomingSlots)) {
+			assert.Equal(t, 2, panels[0].UpcomingSlots[0].TeamId)
+			assert.Equal(t, 3, panels[0].UpcomingSlots[1].TeamId)
+			assert.Equal(t, 4, panels[0].UpcomingSlots[2].TeamId)
+		}
+		assert.Equal(t, "Robot Panel", panels[1].Name)
+		assert.Nil(t, panels[1].CurrentSlot)
+		assert.Equal(t, 1, len(panels[1].UpcomingSlots))
+	}
+}
+
+func TestJudgingQueueDisplayWebsocket(t *testing.T) {
+	web := setupTestWeb(t)
+
+	server, wsUrl := web.startTestServer()
+	defer server.Close()
+	conn, _, err := gorillawebsocket.DefaultDialer.Dial(wsUrl+"/displays/judging_queue/websocket?displayId=1", nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+	ws := websocket.NewTestWebsocket(conn)
+
+	// Should get a few status updates right after connection.
+	readWebsocketType(t, ws, "displayConfiguration")
+}
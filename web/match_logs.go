@@ -134,6 +134,41 @@ func (web *Web) matchLogsViewGetHandler(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// Shows the timeline of field events recorded during a match.
+func (web *Web) matchLogsEventsGetHandler(w http.ResponseWriter, r *http.Request) {
+	matchId, _ := strconv.Atoi(r.PathValue("matchId"))
+	match, err := web.arena.Database.GetMatchById(matchId)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	if match == nil {
+		handleWebErr(w, fmt.Errorf("Error: No such match: %d", matchId))
+		return
+	}
+	fieldEvents, err := web.arena.Database.GetFieldEventsByMatchId(match.Id)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	template, err := web.parseFiles("templates/view_match_events.html", "templates/base.html")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	data := struct {
+		*model.EventSettings
+		Match       *model.Match
+		FieldEvents []model.FieldEvent
+	}{web.arena.EventSettings, match, fieldEvents}
+	err = template.ExecuteTemplate(w, "base", data)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+}
+
 // Load the match logs for the match referenced in the HTTP query string.
 func (web *Web) getMatchLogFromRequest(r *http.Request) (*model.Match, *MatchLogs, bool, error) {
 	matchId, _ := strconv.Atoi(r.PathValue("matchId"))
@@ -150,20 +185,7 @@ func (web *Web) getMatchLogFromRequest(r *http.Request) (*model.Match, *MatchLog
 	if match == nil {
 		return nil, nil, false, fmt.Errorf("Error: No such match: %d", matchId)
 	}
-	switch stationId {
-	case "R1":
-		logs.TeamId = match.Red1
-	case "R2":
-		logs.TeamId = match.Red2
-	case "R3":
-		logs.TeamId = match.Red3
-	case "B1":
-		logs.TeamId = match.Blue1
-	case "B2":
-		logs.TeamId = match.Blue2
-	case "B3":
-		logs.TeamId = match.Blue3
-	}
+	logs.TeamId = match.TeamIdForStation(stationId)
 	headerMap := make(map[string]int)
 	// rows []MatchLogRow
 	// Load a csv file.
@@ -248,8 +270,8 @@ func (web *Web) buildMatchLogsList(matchType model.MatchType) ([]MatchLogsListIt
 		matchLogsList[i].Id = match.Id
 		matchLogsList[i].ShortName = match.ShortName
 		matchLogsList[i].Time = match.Time.Local().Format("Mon 1/02 03:04 PM")
-		matchLogsList[i].RedTeams = []int{match.Red1, match.Red2, match.Red3}
-		matchLogsList[i].BlueTeams = []int{match.Blue1, match.Blue2, match.Blue3}
+		matchLogsList[i].RedTeams = match.RedTeamIds()[:web.arena.EventSettings.TeamsPerAlliance]
+		matchLogsList[i].BlueTeams = match.BlueTeamIds()[:web.arena.EventSettings.TeamsPerAlliance]
 		if err != nil {
 			return []MatchLogsListItem{}, err
 		}
@@ -0,0 +1,43 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package web
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/field"
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchLogsEvents(t *testing.T) {
+	web := setupTestWeb(t)
+
+	match := model.Match{Type: model.Qualification, ShortName: "Q7"}
+	assert.Nil(t, web.arena.Database.CreateMatch(&match))
+	assert.Nil(t, web.arena.Database.AppendFieldEvents(match.Id, []model.FieldEvent{{
+		MatchId:      match.Id,
+		Time:         time.Now(),
+		MatchTimeSec: 12.5,
+		Type:         field.FieldEventRobotLink,
+		Station:      "B2",
+		TeamId:       1114,
+		Description:  "Robot link lost",
+	}}))
+
+	recorder := web.getHttpResponse("/match_logs")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "/match_logs/1/events")
+
+	recorder = web.getHttpResponse("/match_logs/1/events")
+	assert.Equal(t, 200, recorder.Code, recorder.Body.String())
+	body := recorder.Body.String()
+	assert.Contains(t, body, "Field Events: Q7")
+	assert.Contains(t, body, "<td>12.5</td>")
+	assert.Contains(t, body, "<td>Robot link lost</td>")
+
+	recorder = web.getHttpResponse("/match_logs/2/events")
+	assert.Equal(t, 500, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "No such match: 2")
+}
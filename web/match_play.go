@@ -10,6 +10,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"slices"
 	"sort"
 	"time"
 
@@ -22,11 +23,12 @@ import (
 )
 
 type MatchPlayListItem struct {
-	Id         int
-	ShortName  string
-	Time       string
-	Status     game.MatchStatus
-	ColorClass string
+	Id          int
+	ShortName   string
+	Time        string
+	FieldNumber int
+	Status      game.MatchStatus
+	ColorClass  string
 }
 
 type MatchPlayList []MatchPlayListItem
@@ -49,11 +51,13 @@ func (web *Web) matchPlayHandler(w http.ResponseWriter, r *http.Request) {
 		PlcIsEnabled          bool
 		PlcArmorBlockStatuses map[string]bool
 		StationIds            []string
+		FieldNumber           int
 	}{
 		web.arena.EventSettings,
 		web.arena.Plc.IsEnabled(),
 		web.arena.Plc.GetArmorBlockStatuses(),
-		[]string{"R1", "R2", "R3", "B1", "B2", "B3"},
+		web.arena.AllianceStationNames(),
+		web.arena.FieldNumber,
 	}
 	err = template.ExecuteTemplate(w, "base", data)
 	if err != nil {
@@ -102,9 +106,11 @@ func (web *Web) matchPlayMatchLoadHandler(w http.ResponseWriter, r *http.Request
 	data := struct {
 		MatchesByType    map[model.MatchType]MatchPlayList
 		CurrentMatchType model.MatchType
+		NumFields        int
 	}{
 		matchesByType,
 		currentMatchType,
+		web.arena.EventSettings.NumFields,
 	}
 	err = template.ExecuteTemplate(w, "match_play_match_load.html", data)
 	if err != nil {
@@ -135,6 +141,7 @@ func (web *Web) matchPlayWebsocketHandler(w http.ResponseWriter, r *http.Request
 		web.arena.EventStatusNotifier,
 		web.arena.MatchLoadNotifier,
 		web.arena.MatchTimeNotifier,
+		web.arena.QueueStatusNotifier,
 		web.arena.RealtimeScoreNotifier,
 		web.arena.ScorePostedNotifier,
 		web.arena.ScoringStatusNotifier,
@@ -240,9 +247,11 @@ func (web *Web) matchPlayWebsocketHandler(w http.ResponseWriter, r *http.Request
 				Red1  int
 				Red2  int
 				Red3  int
+				Red4  int
 				Blue1 int
 				Blue2 int
 				Blue3 int
+				Blue4 int
 			}{}
 			err = mapstructure.Decode(data, &args)
 			if err != nil {
@@ -253,7 +262,9 @@ func (web *Web) matchPlayWebsocketHandler(w http.ResponseWriter, r *http.Request
 				ws.WriteError(err.Error())
 				continue
 			}
-			err = web.arena.SubstituteTeams(args.Red1, args.Red2, args.Red3, args.Blue1, args.Blue2, args.Blue3)
+			err = web.arena.SubstituteTeams(
+				[]int{args.Red1, args.Red2, args.Red3, args.Red4}, []int{args.Blue1, args.Blue2, args.Blue3, args.Blue4},
+			)
 			if err != nil {
 				ws.WriteError(err.Error())
 				continue
@@ -264,7 +275,7 @@ func (web *Web) matchPlayWebsocketHandler(w http.ResponseWriter, r *http.Request
 				ws.WriteError(fmt.Sprintf("Failed to parse '%s' message.", messageType))
 				continue
 			}
-			if _, ok := web.arena.AllianceStations[station]; !ok {
+			if !slices.Contains(web.arena.AllianceStationNames(), station) {
 				ws.WriteError(fmt.Sprintf("Invalid alliance station '%s'.", station))
 				continue
 			}
@@ -293,6 +304,32 @@ func (web *Web) matchPlayWebsocketHandler(w http.ResponseWriter, r *http.Request
 				ws.WriteError(err.Error())
 				continue
 			}
+		case "pauseMatch":
+			args := struct {
+				Reason string
+			}{}
+			err = mapstructure.Decode(data, &args)
+			if err != nil {
+				ws.WriteError(err.Error())
+				continue
+			}
+			err = web.arena.PauseMatch(args.Reason)
+			if err != nil {
+				ws.WriteError(err.Error())
+				continue
+			}
+		case "resumeMatch":
+			err = web.arena.ResumeMatch()
+			if err != nil {
+				ws.WriteError(err.Error())
+				continue
+			}
+		case "overrideInspection":
+			err = web.arena.OverrideInspection()
+			if err != nil {
+				ws.WriteError(err.Error())
+				continue
+			}
 		case "signalVolunteers":
 			if web.arena.MatchState != field.PostMatch && web.arena.MatchState != field.PreMatch {
 				// Don't allow clearing the field until the match is over.
@@ -422,8 +459,11 @@ func (web *Web) commitMatchScore(match *model.Match, matchResult *model.MatchRes
 		matchResult.CorrectPlayoffScore()
 	}
 
-	// Update the match record.
-	match.ScoreCommittedAt = time.Now()
+	// Update the match record. Keep the original commit time when the score is edited later so that the match's cycle
+	// time history isn't disturbed.
+	if !isMatchReviewEdit || match.ScoreCommittedAt.IsZero() {
+		match.ScoreCommittedAt = time.Now()
+	}
 	redScoreSummary := matchResult.RedScoreSummary()
 	blueScoreSummary := matchResult.BlueScoreSummary()
 	match.Status = game.DetermineMatchStatus(redScoreSummary, blueScoreSummary, match.UseTiebreakCriteria)
@@ -476,19 +516,25 @@ func (web *Web) commitMatchScore(match *model.Match, matchResult *model.MatchRes
 		}
 
 		if match.ShouldUpdatePlayoffMatches() {
+			teamsPerAlliance := web.arena.EventSettings.TeamsPerAlliance
 			if err = web.arena.Database.UpdateAllianceFromMatch(
-				match.PlayoffRedAlliance, [3]int{match.Red1, match.Red2, match.Red3},
+				match.PlayoffRedAlliance, match.RedTeamIds()[:teamsPerAlliance],
 			); err != nil {
 				return err
 			}
 			if err = web.arena.Database.UpdateAllianceFromMatch(
-				match.PlayoffBlueAlliance, [3]int{match.Blue1, match.Blue2, match.Blue3},
+				match.PlayoffBlueAlliance, match.BlueTeamIds()[:teamsPerAlliance],
 			); err != nil {
 				return err
 			}
 
 			// Populate any subsequent playoff matches.
-			if err = web.arena.UpdatePlayoffTournament(); err != nil {
+			if err = web.arena.ForEachField((*field.Arena).UpdatePlayoffTournament); err != nil {
+				return err
+			}
+
+			// Re-time the remaining playoff matches to account for any delays.
+			if err = web.arena.UpdatePlayoffProjectedTimes(); err != nil {
 				return err
 			}
 
@@ -533,6 +579,9 @@ func (web *Web) commitMatchScore(match *model.Match, matchResult *model.MatchRes
 		web.arena.SavedMatchResult = matchResult
 		web.arena.SavedRankings = updatedRankings
 		web.arena.ScorePostedNotifier.Notify()
+
+		// Let any external automation know that the score has been posted.
+		web.arena.FireScoreCommittedHooks(match, matchResult)
 	}
 
 	return nil
@@ -581,6 +630,7 @@ func (web *Web) buildMatchPlayList(matchType model.MatchType) (MatchPlayList, er
 		matchPlayList[i].Id = match.Id
 		matchPlayList[i].ShortName = match.ShortName
 		matchPlayList[i].Time = match.Time.Local().Format("3:04 PM")
+		matchPlayList[i].FieldNumber = match.FieldNumber
 		matchPlayList[i].Status = match.Status
 		switch match.Status {
 		case game.RedWonMatch:
@@ -71,7 +71,7 @@ func TestCommitMatch(t *testing.T) {
 	assert.Nil(t, web.arena.Database.CreateMatch(match))
 	matchResult = model.NewMatchResult()
 	matchResult.MatchId = match.Id
-	matchResult.BlueScore = &game.Score{LeaveStatuses: [3]bool{true, false, false}}
+	matchResult.BlueScore = &game.Score{LeaveStatuses: [4]bool{true, false, false}}
 	err = web.commitMatchScore(match, matchResult, true)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, matchResult.PlayNumber)
@@ -80,7 +80,7 @@ func TestCommitMatch(t *testing.T) {
 
 	matchResult = model.NewMatchResult()
 	matchResult.MatchId = match.Id
-	matchResult.RedScore = &game.Score{LeaveStatuses: [3]bool{true, false, true}}
+	matchResult.RedScore = &game.Score{LeaveStatuses: [4]bool{true, false, true}}
 	err = web.commitMatchScore(match, matchResult, true)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, matchResult.PlayNumber)
@@ -286,6 +286,7 @@ func TestMatchPlayWebsocketCommands(t *testing.T) {
 	readWebsocketType(t, ws, "eventStatus")
 	readWebsocketType(t, ws, "matchLoad")
 	readWebsocketType(t, ws, "matchTime")
+	readWebsocketType(t, ws, "queueStatus")
 	readWebsocketType(t, ws, "realtimeScore")
 	readWebsocketType(t, ws, "scorePosted")
 	readWebsocketType(t, ws, "scoringStatus")
@@ -336,12 +337,12 @@ func TestMatchPlayWebsocketCommands(t *testing.T) {
 	readWebsocketType(t, ws, "audienceDisplayMode")
 	assert.Equal(t, field.PostMatch, web.arena.MatchState)
 	web.arena.RedRealtimeScore.CurrentScore.BargeAlgae = 6
-	web.arena.BlueRealtimeScore.CurrentScore.LeaveStatuses = [3]bool{true, false, true}
+	web.arena.BlueRealtimeScore.CurrentScore.LeaveStatuses = [4]bool{true, false, true}
 	matchIdBeforeCommit := web.arena.CurrentMatch.Id
 	ws.Write("commitResults", nil)
 	readWebsocketMultiple(t, ws, 5) // scorePosted, matchLoad, realtimeScore, allianceStationDisplayMode, scoringStatus
 	assert.Equal(t, 6, web.arena.SavedMatchResult.RedScore.BargeAlgae)
-	assert.Equal(t, [3]bool{true, false, true}, web.arena.SavedMatchResult.BlueScore.LeaveStatuses)
+	assert.Equal(t, [4]bool{true, false, true}, web.arena.SavedMatchResult.BlueScore.LeaveStatuses)
 	assert.Equal(t, matchIdBeforeCommit, web.arena.CurrentMatch.Id)
 	assert.Equal(t, field.PreMatch, web.arena.MatchState)
 	matchIdBeforeDiscard := web.arena.CurrentMatch.Id
@@ -372,7 +373,7 @@ func TestMatchPlayWebsocketLoadMatch(t *testing.T) {
 	ws := websocket.NewTestWebsocket(conn)
 
 	// Should get a few status updates right after connection.
-	readWebsocketMultiple(t, ws, 10)
+	readWebsocketMultiple(t, ws, 11)
 
 	web.arena.Database.CreateTeam(&model.Team{Id: 101})
 	web.arena.Database.CreateTeam(&model.Team{Id: 102})
@@ -396,7 +397,7 @@ func TestMatchPlayWebsocketLoadMatch(t *testing.T) {
 	matchIdMessage := struct{ MatchId int }{match.Id}
 	ws.Write("loadMatch", matchIdMessage)
 	readWebsocketType(t, ws, "matchLoad")
-	readWebsocketMultiple(t, ws, 3)
+	readWebsocketMultiple(t, ws, 4)
 	assert.Equal(t, 101, web.arena.CurrentMatch.Red1)
 	assert.Equal(t, 102, web.arena.CurrentMatch.Red2)
 	assert.Equal(t, 103, web.arena.CurrentMatch.Red3)
@@ -408,7 +409,7 @@ func TestMatchPlayWebsocketLoadMatch(t *testing.T) {
 	matchIdMessage.MatchId = 0
 	ws.Write("loadMatch", matchIdMessage)
 	readWebsocketType(t, ws, "matchLoad")
-	readWebsocketMultiple(t, ws, 3)
+	readWebsocketMultiple(t, ws, 4)
 	assert.Equal(t, 0, web.arena.CurrentMatch.Red1)
 	assert.Equal(t, 0, web.arena.CurrentMatch.Red2)
 	assert.Equal(t, 0, web.arena.CurrentMatch.Red3)
@@ -422,6 +423,37 @@ func TestMatchPlayWebsocketLoadMatch(t *testing.T) {
 	assert.Contains(t, readWebsocketError(t, ws), "invalid match ID 254")
 }
 
+func TestMatchPlayWebsocketOverrideInspection(t *testing.T) {
+	web := setupTestWeb(t)
+	web.arena.EventSettings.InspectionRequired = true
+	match := model.Match{Type: model.Qualification, ShortName: "Q1", Red1: 254}
+	web.arena.Database.CreateMatch(&match)
+	assert.Nil(t, web.arena.LoadMatch(&match))
+
+	server, wsUrl := web.startTestServer()
+	defer server.Close()
+	conn, _, err := gorillawebsocket.DefaultDialer.Dial(wsUrl+"/match_play/websocket", nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+	ws := websocket.NewTestWebsocket(conn)
+	readWebsocketMultiple(t, ws, 11)
+
+	for _, allianceStation := range web.arena.AllianceStations {
+		allianceStation.Bypass = true
+	}
+	web.arena.AllianceStations["R1"].Bypass = false
+	web.arena.AllianceStations["R1"].DsConn = &field.DriverStationConnection{TeamId: 254, RobotLinked: true}
+	ws.Write("startMatch", nil)
+	assert.Contains(t, readWebsocketError(t, ws), "cannot start match until team 254 has passed inspection")
+
+	ws.Write("overrideInspection", nil)
+	readWebsocketType(t, ws, "arenaStatus")
+	assert.True(t, web.arena.InspectionOverride)
+	ws.Write("startMatch", nil)
+	readWebsocketType(t, ws, "eventStatus")
+	assert.Equal(t, field.StartMatch, web.arena.MatchState)
+}
+
 func TestMatchPlayWebsocketShowAndClearResult(t *testing.T) {
 	web := setupTestWeb(t)
 
@@ -433,7 +465,7 @@ func TestMatchPlayWebsocketShowAndClearResult(t *testing.T) {
 	ws := websocket.NewTestWebsocket(conn)
 
 	// Should get a few status updates right after connection.
-	readWebsocketMultiple(t, ws, 10)
+	readWebsocketMultiple(t, ws, 11)
 
 	matchIdMessage := struct{ MatchId int }{1}
 	ws.Write("showResult", matchIdMessage)
@@ -470,7 +502,7 @@ func TestMatchPlayWebsocketNotifications(t *testing.T) {
 	ws := websocket.NewTestWebsocket(conn)
 
 	// Should get a few status updates right after connection.
-	readWebsocketMultiple(t, ws, 10)
+	readWebsocketMultiple(t, ws, 11)
 
 	web.arena.AllianceStations["R1"].Bypass = true
 	web.arena.AllianceStations["R2"].Bypass = true
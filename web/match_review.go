@@ -10,20 +10,26 @@ import (
 	"fmt"
 	"github.com/Team254/cheesy-arena/game"
 	"github.com/Team254/cheesy-arena/model"
+	"github.com/Team254/cheesy-arena/tournament"
+	"log"
 	"net/http"
 	"strconv"
 )
 
 type MatchReviewListItem struct {
-	Id         int
-	ShortName  string
-	Time       string
-	RedTeams   []int
-	BlueTeams  []int
-	RedScore   int
-	BlueScore  int
-	ColorClass string
-	IsComplete bool
+	Id           int
+	ShortName    string
+	Time         string
+	RedTeams     []int
+	BlueTeams    []int
+	RedScore     int
+	BlueScore    int
+	ColorClass   string
+	IsComplete   bool
+	IsReplay     bool
+	IsSuperseded bool
+	ReplayReason string
+	CanReplay    bool
 }
 
 // Shows the match review interface.
@@ -97,8 +103,16 @@ func (web *Web) matchReviewEditGetHandler(w http.ResponseWriter, r *http.Request
 		Match           *model.Match
 		MatchResultJson string
 		IsCurrentMatch  bool
+		NumRobots       int
 		Rules           map[int]*game.Rule
-	}{web.arena.EventSettings, match, string(matchResultJson), isCurrent, game.GetAllRules()}
+	}{
+		web.arena.EventSettings,
+		match,
+		string(matchResultJson),
+		isCurrent,
+		matchResult.RedScore.RobotCount(),
+		game.GetAllRules(),
+	}
 	err = template.ExecuteTemplate(w, "base", data)
 	if err != nil {
 		handleWebErr(w, err)
@@ -149,6 +163,112 @@ func (web *Web) matchReviewEditPostHandler(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// Shows the page to mark a committed qualification match as requiring a replay.
+func (web *Web) matchReviewReplayGetHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	match, _, _, err := web.getMatchResultFromRequest(r)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	web.renderReplayMatch(w, r, match, "")
+}
+
+// Marks a committed qualification match as requiring a replay and schedules the replay.
+func (web *Web) matchReviewReplayPostHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	match, _, _, err := web.getMatchResultFromRequest(r)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	order, _ := strconv.Atoi(r.PostFormValue("order"))
+	replay, err := web.arena.ScheduleQualificationReplay(match.Id, r.PostFormValue("reason"), order)
+	if err != nil {
+		web.renderReplayMatch(w, r, match, err.Error())
+		return
+	}
+
+	// Recalculate the rankings to drop the superseded result, keeping the previous ranks as they were.
+	if _, err = tournament.CalculateRankings(web.arena.Database, true); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	if web.arena.EventSettings.TbaPublishingEnabled {
+		// Publish asynchronously to The Blue Alliance.
+		go func() {
+			if err := web.arena.TbaClient.PublishMatches(web.arena.Database); err != nil {
+				log.Printf("Failed to publish matches: %s", err.Error())
+			}
+			if err := web.arena.TbaClient.PublishRankings(web.arena.Database); err != nil {
+				log.Printf("Failed to publish rankings: %s", err.Error())
+			}
+		}()
+	}
+
+	// Back up the database, but don't error out if it fails.
+	err = web.arena.Database.Backup(
+		web.arena.EventSettings.Name, fmt.Sprintf("post_%s_replay_%s", replay.Type, replay.ShortName),
+	)
+	if err != nil {
+		log.Println(err)
+	}
+
+	http.Redirect(w, r, "/match_review", 303)
+}
+
+func (web *Web) renderReplayMatch(w http.ResponseWriter, r *http.Request, match *model.Match, errorMessage string) {
+	canReplay := match.Type == model.Qualification
+	if !canReplay && errorMessage == "" {
+		errorMessage = "Only qualification matches can be replayed."
+	}
+
+	// Offer to insert the replay before any of the unplayed matches, or at the end of the schedule.
+	matches, err := web.arena.Database.GetMatchesByType(model.Qualification, true)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	var unplayedMatches []model.Match
+	for _, qualificationMatch := range matches {
+		if qualificationMatch.IsComplete() {
+			unplayedMatches = nil
+		} else {
+			unplayedMatches = append(unplayedMatches, qualificationMatch)
+		}
+	}
+	endOrder := 1
+	if len(matches) > 0 {
+		endOrder = matches[len(matches)-1].TypeOrder + 1
+	}
+
+	template, err := web.parseFiles("templates/replay_match.html", "templates/base.html")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	data := struct {
+		*model.EventSettings
+		Match           *model.Match
+		CanReplay       bool
+		UnplayedMatches []model.Match
+		EndOrder        int
+		ErrorMessage    string
+	}{web.arena.EventSettings, match, canReplay, unplayedMatches, endOrder, errorMessage}
+	err = template.ExecuteTemplate(w, "base", data)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+}
+
 // Load the match result for the match referenced in the HTTP query string.
 func (web *Web) getMatchResultFromRequest(r *http.Request) (*model.Match, *model.MatchResult, bool, error) {
 	// If editing the current match, get it from memory instead of the DB.
@@ -173,6 +293,8 @@ func (web *Web) getMatchResultFromRequest(r *http.Request) (*model.Match, *model
 		matchResult = model.NewMatchResult()
 		matchResult.MatchId = matchId
 		matchResult.MatchType = match.Type
+		matchResult.RedScore.NumRobots = web.arena.EventSettings.TeamsPerAlliance
+		matchResult.BlueScore.NumRobots = web.arena.EventSettings.TeamsPerAlliance
 	}
 
 	return match, matchResult, false, nil
@@ -190,8 +312,12 @@ func (web *Web) buildMatchReviewList(matchType model.MatchType) ([]MatchReviewLi
 		matchReviewList[i].Id = match.Id
 		matchReviewList[i].ShortName = match.ShortName
 		matchReviewList[i].Time = match.Time.Local().Format("Mon 1/02 03:04 PM")
-		matchReviewList[i].RedTeams = []int{match.Red1, match.Red2, match.Red3}
-		matchReviewList[i].BlueTeams = []int{match.Blue1, match.Blue2, match.Blue3}
+		matchReviewList[i].RedTeams = match.RedTeamIds()[:web.arena.EventSettings.TeamsPerAlliance]
+		matchReviewList[i].BlueTeams = match.BlueTeamIds()[:web.arena.EventSettings.TeamsPerAlliance]
+		matchReviewList[i].IsReplay = match.IsReplay
+		matchReviewList[i].IsSuperseded = match.IsSuperseded()
+		matchReviewList[i].ReplayReason = match.ReplayReason
+		matchReviewList[i].CanReplay = match.Type == model.Qualification && match.IsComplete() && !match.IsSuperseded()
 		matchResult, err := web.arena.Database.GetMatchResultForMatch(match.Id)
 		if err != nil {
 			return []MatchReviewListItem{}, err
@@ -164,7 +164,7 @@ func TestMatchReviewEditCurrentMatch(t *testing.T) {
 	assert.Equal(t, game.MatchScheduled, match2.Status)
 	assert.Equal(
 		t,
-		[3]game.EndgameStatus{game.EndgameNone, game.EndgameShallowCage, game.EndgameParked},
+		[4]game.EndgameStatus{game.EndgameNone, game.EndgameShallowCage, game.EndgameParked},
 		web.arena.RedRealtimeScore.CurrentScore.EndgameStatuses,
 	)
 	assert.Equal(t, 21, web.arena.BlueRealtimeScore.CurrentScore.Reef.TroughFar)
@@ -173,3 +173,69 @@ func TestMatchReviewEditCurrentMatch(t *testing.T) {
 	assert.Equal(t, 1, len(web.arena.RedRealtimeScore.Cards))
 	assert.Equal(t, 0, len(web.arena.BlueRealtimeScore.Cards))
 }
+
+func TestMatchReviewReplay(t *testing.T) {
+	web := setupTestWeb(t)
+
+	for i := 1; i <= 3; i++ {
+		match := model.Match{
+			Type:      model.Qualification,
+			TypeOrder: i,
+			LongName:  fmt.Sprintf("Qualification %d", i),
+			ShortName: fmt.Sprintf("Q%d", i),
+			Red1:      1,
+			Red2:      2,
+			Red3:      3,
+			Blue1:     4,
+			Blue2:     5,
+			Blue3:     6,
+		}
+		if i == 1 {
+			match.Status = game.BlueWonMatch
+		}
+		web.arena.Database.CreateMatch(&match)
+	}
+	web.arena.Database.CreateMatchResult(model.BuildTestMatchResult(1, 1))
+	tournament.CalculateRankings(web.arena.Database, false)
+
+	recorder := web.getHttpResponse("/match_review")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "/match_review/1/replay")
+	assert.NotContains(t, recorder.Body.String(), "/match_review/2/replay")
+
+	recorder = web.getHttpResponse("/match_review/1/replay")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Replay Qualification 1")
+	assert.Contains(t, recorder.Body.String(), "<option value=\"2\">Q2</option>")
+	assert.Contains(t, recorder.Body.String(), "<option value=\"4\">End of schedule</option>")
+
+	recorder = web.postHttpResponse("/match_review/1/replay", "reason=&order=3")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "a reason must be given for replaying match Q1")
+	assert.Contains(t, recorder.Body.String(), "Replay Qualification 1")
+	recorder = web.postHttpResponse("/match_review/2/replay", "reason=Field+fault&order=3")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "match Q2 cannot be replayed because it has not been committed")
+
+	recorder = web.postHttpResponse("/match_review/1/replay", "reason=Field+fault&order=3")
+	assert.Equal(t, 303, recorder.Code, recorder.Body.String())
+	replay, _ := web.arena.Database.GetMatchByTypeOrder(model.Qualification, 3)
+	if assert.NotNil(t, replay) {
+		assert.Equal(t, "Q1R", replay.ShortName)
+	}
+	rankings, _ := web.arena.Database.GetAllRankings()
+	assert.Empty(t, rankings)
+
+	recorder = web.getHttpResponse("/match_review")
+	assert.Contains(t, recorder.Body.String(), "Replay Required")
+	assert.Contains(t, recorder.Body.String(), "Field fault")
+	assert.NotContains(t, recorder.Body.String(), "/match_review/1/replay")
+
+	// Check that only qualification matches can be replayed.
+	practiceMatch := model.Match{Type: model.Practice, TypeOrder: 1, LongName: "Practice 1", ShortName: "P1"}
+	assert.Nil(t, web.arena.Database.CreateMatch(&practiceMatch))
+	recorder = web.getHttpResponse(fmt.Sprintf("/match_review/%d/replay", practiceMatch.Id))
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Only qualification matches can be replayed.")
+	assert.NotContains(t, recorder.Body.String(), "Schedule Replay")
+}
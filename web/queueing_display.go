@@ -10,6 +10,7 @@ import (
 	"github.com/Team254/cheesy-arena/model"
 	"github.com/Team254/cheesy-arena/websocket"
 	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -65,6 +66,10 @@ func (web *Web) queueingDisplayMatchLoadHandler(w http.ResponseWriter, r *http.R
 		if match.IsComplete() || match.TypeOrder < web.arena.CurrentMatch.TypeOrder {
 			continue
 		}
+		if !match.IsForField(web.arena.FieldNumber) {
+			// Matches assigned to another field are shown on that field's own queueing display.
+			continue
+		}
 		upcomingMatches = append(upcomingMatches, match)
 		redOffFieldTeams, blueOffFieldTeams, err := web.arena.Database.GetOffFieldTeamIds(&match)
 		if err != nil {
@@ -84,6 +89,26 @@ func (web *Web) queueingDisplayMatchLoadHandler(w http.ResponseWriter, r *http.R
 		}
 	}
 
+	var practiceQueue []field.PracticeQueueTeam
+	if web.arena.EventSettings.PracticeOpenQueueEnabled {
+		if practiceQueue, err = web.arena.GetPracticeQueue(); err != nil {
+			handleWebErr(w, err)
+			return
+		}
+	}
+
+	// Flag the teams that have yet to pass inspection so that they can be sent to the inspection station.
+	uninspectedTeams := make(map[int]bool)
+	if web.arena.EventSettings.InspectionRequired {
+		for _, match := range upcomingMatches {
+			for _, teamId := range match.TeamIds() {
+				if teamId > 0 && !web.arena.IsTeamInspectionPassed(teamId) {
+					uninspectedTeams[teamId] = true
+				}
+			}
+		}
+	}
+
 	template, err := web.parseFiles("templates/queueing_display_match_load.html")
 	if err != nil {
 		handleWebErr(w, err)
@@ -91,13 +116,23 @@ func (web *Web) queueingDisplayMatchLoadHandler(w http.ResponseWriter, r *http.R
 	}
 
 	data := struct {
-		Matches           []model.Match
-		RedOffFieldTeams  [][]int
-		BlueOffFieldTeams [][]int
+		Matches              []model.Match
+		RedOffFieldTeams     [][]int
+		BlueOffFieldTeams    [][]int
+		PracticeQueueEnabled bool
+		PracticeQueue        []field.PracticeQueueTeam
+		NumFields            int
+		FieldNumber          int
+		UninspectedTeams     map[int]bool
 	}{
 		upcomingMatches,
 		redOffFieldTeamsByMatch,
 		blueOffFieldTeamsByMatch,
+		web.arena.EventSettings.PracticeOpenQueueEnabled,
+		practiceQueue,
+		web.arena.EventSettings.NumFields,
+		web.arena.FieldNumber,
+		uninspectedTeams,
 	}
 	err = template.ExecuteTemplate(w, "queueing_display_match_load.html", data)
 	if err != nil {
@@ -106,6 +141,22 @@ func (web *Web) queueingDisplayMatchLoadHandler(w http.ResponseWriter, r *http.R
 	}
 }
 
+// Adds the given team to the back of the open practice queue, on behalf of a team at the queueing display.
+func (web *Web) queueingDisplayPracticeQueueJoinHandler(w http.ResponseWriter, r *http.Request) {
+	// This route doesn't require a login, so refuse it outright unless teams are meant to be joining the queue.
+	if !web.arena.EventSettings.PracticeOpenQueueEnabled {
+		http.Error(w, "The open practice queue is not enabled.", 403)
+		return
+	}
+
+	teamId, _ := strconv.Atoi(r.PostFormValue("teamId"))
+	if err := web.arena.JoinPracticeQueue(teamId); err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+	w.WriteHeader(200)
+}
+
 // The websocket endpoint for the queueing display to receive updates.
 func (web *Web) queueingDisplayWebsocketHandler(w http.ResponseWriter, r *http.Request) {
 	display, err := web.registerDisplay(r)
@@ -129,6 +180,7 @@ func (web *Web) queueingDisplayWebsocketHandler(w http.ResponseWriter, r *http.R
 		web.arena.MatchLoadNotifier,
 		web.arena.MatchTimeNotifier,
 		web.arena.EventStatusNotifier,
+		web.arena.PracticeQueueNotifier,
 		web.arena.ReloadDisplaysNotifier,
 	)
 }
@@ -4,6 +4,7 @@
 package web
 
 import (
+	"github.com/Team254/cheesy-arena/model"
 	"github.com/Team254/cheesy-arena/websocket"
 	gorillawebsocket "github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
@@ -35,3 +36,25 @@ func TestQueueingDisplayWebsocket(t *testing.T) {
 	readWebsocketType(t, ws, "matchTime")
 	readWebsocketType(t, ws, "eventStatus")
 }
+
+func TestQueueingDisplayUninspectedTeams(t *testing.T) {
+	web := setupTestWeb(t)
+	match := model.Match{Type: model.Qualification, TypeOrder: 1, ShortName: "Q1", Red1: 254, Blue1: 1114}
+	web.arena.Database.CreateMatch(&match)
+	web.arena.Database.CreateTeamInspection(&model.TeamInspection{Id: 254, PassedItems: []string{"Weight"}})
+	web.arena.EventSettings.InspectionChecklist = "Weight"
+	assert.Nil(t, web.arena.LoadInspections())
+	assert.Nil(t, web.arena.LoadMatch(&match))
+
+	recorder := web.getHttpResponse("/displays/queueing/match_load")
+	assert.Equal(t, 200, recorder.Code)
+	assert.NotContains(t, recorder.Body.String(), "uninspected")
+
+	// Check that teams yet to pass inspection are highlighted once it is required.
+	web.arena.EventSettings.InspectionRequired = true
+	recorder = web.getHttpResponse("/displays/queueing/match_load")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "<span class=\"uninspected\">1114</span>")
+	assert.NotContains(t, recorder.Body.String(), "<span class=\"uninspected\">254</span>")
+	assert.Contains(t, recorder.Body.String(), "teams must pass inspection before playing")
+}
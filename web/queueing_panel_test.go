@@ -0,0 +1,61 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package web
+
+import (
+	"testing"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/Team254/cheesy-arena/websocket"
+	gorillawebsocket "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueingPanel(t *testing.T) {
+	web := setupTestWeb(t)
+
+	recorder := web.getHttpResponse("/panels/queueing")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Queueing Panel - Untitled Event - Cheesy Arena")
+}
+
+func TestQueueingPanelWebsocket(t *testing.T) {
+	web := setupTestWeb(t)
+	for i := 101; i <= 106; i++ {
+		web.arena.Database.CreateTeam(&model.Team{Id: i})
+	}
+	match := model.Match{
+		Type:      model.Qualification,
+		TypeOrder: 1,
+		ShortName: "Q1",
+		Red1:      101,
+		Red2:      102,
+		Red3:      103,
+		Blue1:     104,
+		Blue2:     105,
+		Blue3:     106,
+	}
+	web.arena.Database.CreateMatch(&match)
+	assert.Nil(t, web.arena.LoadMatch(&match))
+
+	server, wsUrl := web.startTestServer()
+	defer server.Close()
+	conn, _, err := gorillawebsocket.DefaultDialer.Dial(wsUrl+"/panels/queueing/websocket", nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+	ws := websocket.NewTestWebsocket(conn)
+
+	// Should get the current queue status right after connection.
+	readWebsocketType(t, ws, "queueStatus")
+
+	ws.Write("setQueueStatus", map[string]any{"MatchId": match.Id, "TeamId": 104, "Status": model.QueueArrived})
+	readWebsocketType(t, ws, "queueStatus")
+	queueStatus, err := web.arena.Database.GetTeamQueueStatus(match.Id, 104)
+	assert.Nil(t, err)
+	assert.Equal(t, model.QueueArrived, queueStatus.Status)
+
+	ws.Write("setQueueStatus", map[string]any{"MatchId": match.Id, "TeamId": 254, "Status": model.QueueArrived})
+	assert.Equal(t, "Team 254 is not in match Q1.", readWebsocketError(t, ws))
+	ws.Write("nonexistenttype", nil)
+	assert.Contains(t, readWebsocketError(t, ws), "Invalid message type")
+}
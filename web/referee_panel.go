@@ -49,12 +49,14 @@ func (web *Web) refereePanelFoulListHandler(w http.ResponseWriter, r *http.Reque
 	}
 
 	data := struct {
-		Match     *model.Match
-		RedFouls  []game.Foul
-		BlueFouls []game.Foul
-		Rules     map[int]*game.Rule
+		RedTeamIds  []int
+		BlueTeamIds []int
+		RedFouls    []game.Foul
+		BlueFouls   []game.Foul
+		Rules       map[int]*game.Rule
 	}{
-		web.arena.CurrentMatch,
+		web.arena.CurrentMatch.RedTeamIds()[:web.arena.EventSettings.TeamsPerAlliance],
+		web.arena.CurrentMatch.BlueTeamIds()[:web.arena.EventSettings.TeamsPerAlliance],
 		web.arena.RedRealtimeScore.CurrentScore.Fouls,
 		web.arena.BlueRealtimeScore.CurrentScore.Fouls,
 		game.GetAllRules(),
@@ -181,19 +183,27 @@ func (web *Web) refereePanelWebsocketHandler(w http.ResponseWriter, r *http.Requ
 			}
 			if web.arena.CurrentMatch.Type == model.Playoff {
 				// Cards apply to the whole alliance in playoffs.
+				teamIds := web.arena.CurrentMatch.BlueTeamIds()
 				if args.Alliance == "red" {
-					cards[strconv.Itoa(web.arena.CurrentMatch.Red1)] = args.Card
-					cards[strconv.Itoa(web.arena.CurrentMatch.Red2)] = args.Card
-					cards[strconv.Itoa(web.arena.CurrentMatch.Red3)] = args.Card
-				} else {
-					cards[strconv.Itoa(web.arena.CurrentMatch.Blue1)] = args.Card
-					cards[strconv.Itoa(web.arena.CurrentMatch.Blue2)] = args.Card
-					cards[strconv.Itoa(web.arena.CurrentMatch.Blue3)] = args.Card
+					teamIds = web.arena.CurrentMatch.RedTeamIds()
+				}
+				for _, teamId := range teamIds[:web.arena.EventSettings.TeamsPerAlliance] {
+					cards[strconv.Itoa(teamId)] = args.Card
 				}
 			} else {
 				cards[strconv.Itoa(args.TeamId)] = args.Card
 			}
 			web.arena.RealtimeScoreNotifier.Notify()
+		case "toggleFieldFaultPause":
+			if web.arena.IsFieldFaultPaused() {
+				err = web.arena.ResumeMatch()
+			} else {
+				err = web.arena.PauseMatch("Head referee")
+			}
+			if err != nil {
+				ws.WriteError(err.Error())
+				continue
+			}
 		case "signalVolunteers":
 			if web.arena.MatchState != field.PostMatch {
 				// Don't allow clearing the field until the match is over.
@@ -175,3 +175,34 @@ func TestRefereePanelWebsocket(t *testing.T) {
 	web.arena.MatchLoadNotifier.Notify()
 	readWebsocketType(t, ws, "matchLoad")
 }
+
+func TestRefereePanelFieldFaultPause(t *testing.T) {
+	web := setupTestWeb(t)
+
+	server, wsUrl := web.startTestServer()
+	defer server.Close()
+	conn, _, err := gorillawebsocket.DefaultDialer.Dial(wsUrl+"/panels/referee/websocket", nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+	ws := websocket.NewTestWebsocket(conn)
+	readWebsocketType(t, ws, "matchLoad")
+	readWebsocketType(t, ws, "matchTime")
+	readWebsocketType(t, ws, "realtimeScore")
+	readWebsocketType(t, ws, "scoringStatus")
+
+	ws.Write("toggleFieldFaultPause", nil)
+	assert.Contains(t, readWebsocketError(t, ws), "cannot pause match when it is not in progress")
+
+	web.arena.MatchState = field.TeleopPeriod
+	web.arena.MatchStartTime = time.Now().Add(-30 * time.Second)
+	ws.Write("toggleFieldFaultPause", nil)
+	readWebsocketType(t, ws, "matchTime")
+	assert.True(t, web.arena.IsFieldFaultPaused())
+	if assert.Equal(t, 1, len(web.arena.CurrentMatch.FieldFaultPauses)) {
+		assert.Equal(t, "Head referee", web.arena.CurrentMatch.FieldFaultPauses[0].Reason)
+	}
+	ws.Write("toggleFieldFaultPause", nil)
+	readWebsocketType(t, ws, "matchTime")
+	assert.False(t, web.arena.IsFieldFaultPaused())
+	assert.Equal(t, field.TeleopPeriod, web.arena.MatchState)
+}
@@ -13,8 +13,10 @@ import (
 	"net/http"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/Team254/cheesy-arena/field"
 	"github.com/Team254/cheesy-arena/game"
 	"github.com/Team254/cheesy-arena/model"
 	"github.com/Team254/cheesy-arena/playoff"
@@ -414,7 +416,17 @@ func (web *Web) scheduleCsvReportHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 	var buf bytes.Buffer
-	err = template.ExecuteTemplate(&buf, "schedule.csv", matches)
+	stations := web.arena.AllianceStationNames()
+	stationColumns := make([]string, len(stations))
+	for i, station := range stations {
+		stationColumns[i] = strings.ReplaceAll(allianceStationDisplayName(station), " ", "")
+	}
+	data := struct {
+		Stations       []string
+		StationColumns []string
+		Matches        []model.Match
+	}{stations, stationColumns, matches}
+	err = template.ExecuteTemplate(&buf, "schedule.csv", data)
 	if err != nil {
 		handleWebErr(w, err)
 		return
@@ -449,13 +461,14 @@ func (web *Web) schedulePdfReportHandler(w http.ResponseWriter, r *http.Request)
 		handleWebErr(w, err)
 		return
 	}
+	stations := web.arena.AllianceStationNames()
 	matchesPerTeam := 0
 	if len(teams) > 0 {
-		matchesPerTeam = len(matches) * tournament.TeamsPerMatch / len(teams)
+		matchesPerTeam = len(matches) * len(stations) / len(teams)
 	}
 
 	// The widths of the table columns in mm, stored here so that they can be referenced for each row.
-	colWidths := map[string]float64{"Time": 35, "Match": 40, "Team": 20}
+	colWidths := map[string]float64{"Time": 35, "Match": 40, "Team": 120 / float64(len(stations))}
 	rowHeight := 6.5
 
 	pdf := gofpdf.New("P", "mm", "Letter", "font")
@@ -467,21 +480,32 @@ func (web *Web) schedulePdfReportHandler(w http.ResponseWriter, r *http.Request)
 	pdf.CellFormat(195, rowHeight, "Match Schedule - "+web.arena.EventSettings.Name, "", 1, "C", false, 0, "")
 	pdf.CellFormat(colWidths["Time"], rowHeight, "Time", "1", 0, "C", true, 0, "")
 	pdf.CellFormat(colWidths["Match"], rowHeight, "Match", "1", 0, "C", true, 0, "")
-	pdf.CellFormat(colWidths["Team"], rowHeight, "Red 1", "1", 0, "C", true, 0, "")
-	pdf.CellFormat(colWidths["Team"], rowHeight, "Red 2", "1", 0, "C", true, 0, "")
-	pdf.CellFormat(colWidths["Team"], rowHeight, "Red 3", "1", 0, "C", true, 0, "")
-	pdf.CellFormat(colWidths["Team"], rowHeight, "Blue 1", "1", 0, "C", true, 0, "")
-	pdf.CellFormat(colWidths["Team"], rowHeight, "Blue 2", "1", 0, "C", true, 0, "")
-	pdf.CellFormat(colWidths["Team"], rowHeight, "Blue 3", "1", 1, "C", true, 0, "")
+	for i, station := range stations {
+		pdf.CellFormat(
+			colWidths["Team"],
+			rowHeight,
+			allianceStationDisplayName(station),
+			"1",
+			lineBreakAfterLast(i, stations),
+			"C",
+			true,
+			0,
+			"",
+		)
+	}
 	pdf.SetFont("Arial", "", 10)
 	for _, match := range matches {
 		// Render break if there is one before this match.
 		if breakIndex < len(scheduledBreaks) && scheduledBreaks[breakIndex].TypeOrderBefore == match.TypeOrder {
 			scheduledBreak := scheduledBreaks[breakIndex]
 			formattedTime := scheduledBreak.Time.Local().Format("Mon 1/02 03:04 PM")
-			description := fmt.Sprintf("%s (%d minutes)", scheduledBreak.Description, scheduledBreak.DurationSec/60)
+			description := scheduledBreak.Description
+			if scheduledBreak.DurationSec > 0 {
+				description = fmt.Sprintf("%s (%d minutes)", scheduledBreak.Description, scheduledBreak.DurationSec/60)
+			}
 			pdf.CellFormat(colWidths["Time"], rowHeight, formattedTime, "1", 0, "C", false, 0, "")
-			pdf.CellFormat(colWidths["Match"]+6*colWidths["Team"], rowHeight, description, "1", 1, "C", false, 0, "")
+			breakWidth := colWidths["Match"] + float64(len(stations))*colWidths["Team"]
+			pdf.CellFormat(breakWidth, rowHeight, description, "1", 1, "C", false, 0, "")
 			breakIndex++
 		}
 
@@ -489,13 +513,15 @@ func (web *Web) schedulePdfReportHandler(w http.ResponseWriter, r *http.Request)
 		borderStr := "1"
 		alignStr := "CM"
 		surrogate := false
-		if match.Red1IsSurrogate || match.Red2IsSurrogate || match.Red3IsSurrogate ||
-			match.Blue1IsSurrogate || match.Blue2IsSurrogate || match.Blue3IsSurrogate {
-			// If the match contains surrogates, the row needs to be taller to fit some text beneath team numbers.
+		for _, station := range stations {
+			surrogate = surrogate || match.IsSurrogateForStation(station)
+		}
+		delayed := !match.IsComplete() && match.ProjectedTime.After(match.Time)
+		if surrogate || delayed {
+			// If the match contains surrogates or is running late, the row needs to be taller to fit some text beneath.
 			height = 5.0
 			borderStr = "LTR"
 			alignStr = "CB"
-			surrogate = true
 		}
 
 		formatTeam := func(teamId int) string {
@@ -519,36 +545,42 @@ func (web *Web) schedulePdfReportHandler(w http.ResponseWriter, r *http.Request)
 			"",
 		)
 		pdf.CellFormat(colWidths["Match"], height, match.LongName, borderStr, 0, alignStr, false, 0, "")
-		pdf.CellFormat(colWidths["Team"], height, formatTeam(match.Red1), borderStr, 0, alignStr, false, 0, "")
-		pdf.CellFormat(colWidths["Team"], height, formatTeam(match.Red2), borderStr, 0, alignStr, false, 0, "")
-		pdf.CellFormat(colWidths["Team"], height, formatTeam(match.Red3), borderStr, 0, alignStr, false, 0, "")
-		pdf.CellFormat(colWidths["Team"], height, formatTeam(match.Blue1), borderStr, 0, alignStr, false, 0, "")
-		pdf.CellFormat(colWidths["Team"], height, formatTeam(match.Blue2), borderStr, 0, alignStr, false, 0, "")
-		pdf.CellFormat(colWidths["Team"], height, formatTeam(match.Blue3), borderStr, 1, alignStr, false, 0, "")
-		if surrogate {
-			// Render the text that indicates which teams are surrogates.
+		for i, station := range stations {
+			pdf.CellFormat(
+				colWidths["Team"],
+				height,
+				formatTeam(match.TeamIdForStation(station)),
+				borderStr,
+				lineBreakAfterLast(i, stations),
+				alignStr,
+				false,
+				0,
+				"",
+			)
+		}
+		if surrogate || delayed {
+			// Render the text that indicates the projected time and which teams are surrogates.
 			height := 4.0
 			pdf.SetFont("Arial", "", 8)
-			pdf.CellFormat(colWidths["Time"], height, "", "LBR", 0, "C", false, 0, "")
+			projectedTimeText := ""
+			if delayed {
+				projectedTimeText = "(projected " + match.ProjectedTime.Local().Format("03:04 PM") + ")"
+			}
+			pdf.CellFormat(colWidths["Time"], height, projectedTimeText, "LBR", 0, "CT", false, 0, "")
 			pdf.CellFormat(colWidths["Match"], height, "", "LBR", 0, "C", false, 0, "")
-			pdf.CellFormat(
-				colWidths["Team"], height, surrogateText(match.Red1IsSurrogate), "LBR", 0, "CT", false, 0, "",
-			)
-			pdf.CellFormat(
-				colWidths["Team"], height, surrogateText(match.Red2IsSurrogate), "LBR", 0, "CT", false, 0, "",
-			)
-			pdf.CellFormat(
-				colWidths["Team"], height, surrogateText(match.Red3IsSurrogate), "LBR", 0, "CT", false, 0, "",
-			)
-			pdf.CellFormat(
-				colWidths["Team"], height, surrogateText(match.Blue1IsSurrogate), "LBR", 0, "CT", false, 0, "",
-			)
-			pdf.CellFormat(
-				colWidths["Team"], height, surrogateText(match.Blue2IsSurrogate), "LBR", 0, "CT", false, 0, "",
-			)
-			pdf.CellFormat(
-				colWidths["Team"], height, surrogateText(match.Blue3IsSurrogate), "LBR", 1, "CT", false, 0, "",
-			)
+			for i, station := range stations {
+				pdf.CellFormat(
+					colWidths["Team"],
+					height,
+					surrogateText(match.IsSurrogateForStation(station)),
+					"LBR",
+					lineBreakAfterLast(i, stations),
+					"CT",
+					false,
+					0,
+					"",
+				)
+			}
 			pdf.SetFont("Arial", "", 10)
 		}
 	}
@@ -673,6 +705,82 @@ func (web *Web) teamsPdfReportHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Generates a PDF-formatted report of the progress of each team through robot inspection.
+func (web *Web) inspectionPdfReportHandler(w http.ResponseWriter, r *http.Request) {
+	inspectionStatuses, err := web.getTeamInspectionStatuses()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	numPassed := 0
+	for _, status := range inspectionStatuses {
+		if status.IsPassed {
+			numPassed++
+		}
+	}
+
+	// The widths of the table columns in mm, stored here so that they can be referenced for each row.
+	colWidths := map[string]float64{"Id": 12, "Name": 55, "Status": 20, "Outstanding": 78, "Inspector": 30}
+	rowHeight := 6.5
+	lineHeight := 5.0
+
+	pdf := gofpdf.New("P", "mm", "Letter", "font")
+	pdf.AddPage()
+	pdf.SetFont("Arial", "B", 10)
+	pdf.SetFillColor(220, 220, 220)
+
+	// Render table header row.
+	pdf.CellFormat(195, rowHeight, "Inspection Summary - "+web.arena.EventSettings.Name, "", 1, "C", false, 0, "")
+	pdf.SetFont("Arial", "", 10)
+	pdf.CellFormat(
+		195, rowHeight, fmt.Sprintf("%d of %d teams passed", numPassed, len(inspectionStatuses)), "", 1, "C", false, 0,
+		"",
+	)
+	pdf.SetFont("Arial", "B", 10)
+	pdf.CellFormat(colWidths["Id"], rowHeight, "Team", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(colWidths["Name"], rowHeight, "Name", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(colWidths["Status"], rowHeight, "Status", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(colWidths["Outstanding"], rowHeight, "Outstanding Items", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(colWidths["Inspector"], rowHeight, "Inspector", "1", 1, "C", true, 0, "")
+	pdf.SetFont("Arial", "", 10)
+	for _, status := range inspectionStatuses {
+		// Render team inspection row.
+		outstanding := strings.Join(status.MissingItems, ", ")
+		numNicknameRows := len(pdf.SplitLines([]byte(status.Team.Nickname), colWidths["Name"]))
+		numOutstandingRows := len(pdf.SplitLines([]byte(outstanding), colWidths["Outstanding"]))
+		teamRowHeight := rowHeight
+		numRows := max(numNicknameRows, numOutstandingRows)
+		if numRows > 1 {
+			teamRowHeight = lineHeight * float64(numRows)
+		}
+		statusText := "Not Passed"
+		if status.IsPassed {
+			statusText = "Passed"
+		}
+		pdf.CellFormat(colWidths["Id"], teamRowHeight, strconv.Itoa(status.Team.Id), "1", 0, "L", false, 0, "")
+		drawMultiLineCell(
+			pdf, colWidths["Name"], teamRowHeight, lineHeight, status.Team.Nickname, "L", numNicknameRows,
+		)
+		pdf.CellFormat(colWidths["Status"], teamRowHeight, statusText, "1", 0, "C", !status.IsPassed, 0, "")
+		drawMultiLineCell(
+			pdf, colWidths["Outstanding"], teamRowHeight, lineHeight, outstanding, "L", numOutstandingRows,
+		)
+		pdf.CellFormat(
+			colWidths["Inspector"], teamRowHeight, status.Inspection.Inspector, "1", 1, "L", false, 0, "",
+		)
+	}
+
+	addTimeGeneratedFooter(pdf)
+
+	// Write out the PDF file as the HTTP response.
+	w.Header().Set("Content-Type", "application/pdf")
+	err = pdf.Output(w)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+}
+
 // Generates a CSV-formatted report of the WPA keys, for import into the radio kiosk.
 func (web *Web) wpaKeysCsvReportHandler(w http.ResponseWriter, r *http.Request) {
 	if !web.userIsAdmin(w, r) {
@@ -844,6 +952,263 @@ func (web *Web) bracketPdfReportHandler(w http.ResponseWriter, r *http.Request)
 	w.Write(cleaned)
 }
 
+// Generates a print-friendly HTML rendering of the playoff bracket that lays out the matchups in rounds, for any
+// bracket type and size.
+func (web *Web) bracketHtmlReportHandler(w http.ResponseWriter, r *http.Request) {
+	bracket, err := web.getBracketApiResponse()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Group the match groups into columns by round; they are already sorted by round.
+	var rounds [][]bracketApiMatchGroup
+	for _, matchGroup := range bracket.MatchGroups {
+		for len(rounds) < matchGroup.Round {
+			rounds = append(rounds, nil)
+		}
+		rounds[matchGroup.Round-1] = append(rounds[matchGroup.Round-1], matchGroup)
+	}
+
+	template, err := web.parseFiles("templates/bracket_html_report.html")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	data := struct {
+		*model.EventSettings
+		Bracket *bracketApiResponse
+		Rounds  [][]bracketApiMatchGroup
+	}{web.arena.EventSettings, bracket, rounds}
+	var buf bytes.Buffer
+	err = template.ExecuteTemplate(&buf, "bracket_html_report.html", data)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Strip out carriage returns to ensure consistent behavior across platforms.
+	cleaned := bytes.ReplaceAll(buf.Bytes(), []byte("\r"), []byte(""))
+	w.Write(cleaned)
+}
+
+// Generates an HTML report of each team's schedule statistics, highlighting any teams whose schedule exceeds the
+// warning thresholds.
+func (web *Web) scheduleQualityHtmlReportHandler(w http.ResponseWriter, r *http.Request) {
+	report, err := web.getScheduleQualityReport(r)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	template, err := web.parseFiles("templates/schedule_quality_report.html")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	data := struct {
+		*model.EventSettings
+		*scheduleQualityReport
+	}{web.arena.EventSettings, report}
+	var buf bytes.Buffer
+	err = template.ExecuteTemplate(&buf, "schedule_quality_report.html", data)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Strip out carriage returns to ensure consistent behavior across platforms.
+	cleaned := bytes.ReplaceAll(buf.Bytes(), []byte("\r"), []byte(""))
+	w.Write(cleaned)
+}
+
+// Generates a CSV-formatted report of each team's schedule statistics.
+func (web *Web) scheduleQualityCsvReportHandler(w http.ResponseWriter, r *http.Request) {
+	report, err := web.getScheduleQualityReport(r)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Don't set the content type as "text/csv", as that will trigger an automatic download in the browser.
+	w.Header().Set("Content-Type", "text/plain")
+	template, err := web.parseFiles("templates/schedule_quality.csv")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	var buf bytes.Buffer
+	err = template.ExecuteTemplate(&buf, "schedule_quality.csv", report)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Strip out carriage returns to ensure consistent behavior across platforms.
+	cleaned := bytes.ReplaceAll(buf.Bytes(), []byte("\r"), []byte(""))
+	w.Write(cleaned)
+}
+
+// Generates a PDF-formatted report of each team's schedule statistics.
+func (web *Web) scheduleQualityPdfReportHandler(w http.ResponseWriter, r *http.Request) {
+	report, err := web.getScheduleQualityReport(r)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// The widths of the table columns in mm, stored here so that they can be referenced for each row.
+	colWidths := map[string]float64{
+		"Team": 15, "Matches": 16, "Gap": 16, "Repeats": 18, "Stations": 30, "Match": 35, "Warnings": 65,
+	}
+	rowHeight := 6.5
+
+	pdf := gofpdf.New("L", "mm", "Letter", "font")
+	pdf.AddPage()
+
+	// Render table header row.
+	pdf.SetFont("Arial", "B", 10)
+	pdf.SetFillColor(220, 220, 220)
+	title := fmt.Sprintf("%s Schedule Quality - %s", report.MatchType, web.arena.EventSettings.Name)
+	if report.IsPending {
+		title += " (not yet saved)"
+	}
+	pdf.CellFormat(259, rowHeight, title, "", 1, "C", false, 0, "")
+	pdf.CellFormat(colWidths["Team"], rowHeight, "Team", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(colWidths["Matches"], rowHeight, "Matches", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(colWidths["Gap"], rowHeight, "Min Gap", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(colWidths["Gap"], rowHeight, "Avg Gap", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(colWidths["Repeats"], rowHeight, "Partners", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(colWidths["Repeats"], rowHeight, "Opponents", "1", 0, "C", true, 0, "")
+	stations := web.arena.AllianceStationNames()
+	stationsHeader := strings.Join(stations[:len(stations)/2], "/") + " " + strings.Join(stations[len(stations)/2:], "/")
+	pdf.CellFormat(colWidths["Stations"], rowHeight, stationsHeader, "1", 0, "C", true, 0, "")
+	pdf.CellFormat(colWidths["Match"], rowHeight, "First Match", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(colWidths["Match"], rowHeight, "Last Match", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(colWidths["Warnings"], rowHeight, "Warnings", "1", 1, "C", true, 0, "")
+	pdf.SetFont("Arial", "", 9)
+	pdf.SetFillColor(255, 200, 200)
+	for _, stats := range report.TeamStats {
+		hasWarnings := len(stats.Warnings) > 0
+		numMatches := strconv.Itoa(stats.NumMatches)
+		if len(stats.SurrogateMatches) > 0 {
+			numMatches += fmt.Sprintf(" (%dS)", len(stats.SurrogateMatches))
+		}
+		minGap, averageGap := "", ""
+		if stats.NumMatches > 1 {
+			minGap = strconv.Itoa(stats.MinMatchGap)
+			averageGap = fmt.Sprintf("%.1f", stats.AverageMatchGap)
+		}
+		stationCounts := make([]string, len(stats.StationCounts))
+		for i, count := range stats.StationCounts {
+			stationCounts[i] = strconv.Itoa(count)
+		}
+		stationCountsText := strings.Join(stationCounts[:len(stationCounts)/2], "/") + " " +
+			strings.Join(stationCounts[len(stationCounts)/2:], "/")
+		firstMatch := fmt.Sprintf("%s %s", stats.FirstMatch, stats.FirstMatchTime.Local().Format("Mon 03:04 PM"))
+		lastMatch := fmt.Sprintf("%s %s", stats.LastMatch, stats.LastMatchTime.Local().Format("Mon 03:04 PM"))
+
+		pdf.CellFormat(colWidths["Team"], rowHeight, strconv.Itoa(stats.TeamId), "1", 0, "C", hasWarnings, 0, "")
+		pdf.CellFormat(colWidths["Matches"], rowHeight, numMatches, "1", 0, "C", hasWarnings, 0, "")
+		pdf.CellFormat(colWidths["Gap"], rowHeight, minGap, "1", 0, "C", hasWarnings, 0, "")
+		pdf.CellFormat(colWidths["Gap"], rowHeight, averageGap, "1", 0, "C", hasWarnings, 0, "")
+		pdf.CellFormat(
+			colWidths["Repeats"], rowHeight, strconv.Itoa(stats.PartnerRepeats), "1", 0, "C", hasWarnings, 0, "",
+		)
+		pdf.CellFormat(
+			colWidths["Repeats"], rowHeight, strconv.Itoa(stats.OpponentRepeats), "1", 0, "C", hasWarnings, 0, "",
+		)
+		pdf.CellFormat(colWidths["Stations"], rowHeight, stationCountsText, "1", 0, "C", hasWarnings, 0, "")
+		pdf.CellFormat(colWidths["Match"], rowHeight, firstMatch, "1", 0, "C", hasWarnings, 0, "")
+		pdf.CellFormat(colWidths["Match"], rowHeight, lastMatch, "1", 0, "C", hasWarnings, 0, "")
+		pdf.CellFormat(
+			colWidths["Warnings"], rowHeight, strings.Join(stats.Warnings, "; "), "1", 1, "L", hasWarnings, 0, "",
+		)
+	}
+
+	// Write out the PDF file as the HTTP response.
+	w.Header().Set("Content-Type", "application/pdf")
+	err = pdf.Output(w)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+}
+
+// scheduleQualityReport holds the data shared by the different formats of the schedule quality report.
+type scheduleQualityReport struct {
+	MatchType       model.MatchType
+	IsPending       bool
+	Thresholds      tournament.ScheduleWarningThresholds
+	StationNames    []string
+	TeamStats       []tournament.TeamScheduleStats
+	NumTeamWarnings int
+}
+
+// Assembles the schedule quality report for the match type given in the request path. Uses the schedule that has been
+// generated but not yet saved if the "pending" query parameter is set, and allows the default warning thresholds to be
+// overridden by query parameters.
+func (web *Web) getScheduleQualityReport(r *http.Request) (*scheduleQualityReport, error) {
+	matchType, err := model.MatchTypeFromString(r.PathValue("type"))
+	if err != nil {
+		return nil, err
+	}
+
+	report := scheduleQualityReport{
+		MatchType:    matchType,
+		IsPending:    r.URL.Query().Get("pending") == "true",
+		Thresholds:   tournament.DefaultScheduleWarningThresholds,
+		StationNames: web.arena.AllianceStationNames(),
+	}
+	var matches []model.Match
+	if report.IsPending {
+		matches = cachedMatches[matchType]
+	} else {
+		matches, err = web.arena.Database.GetMatchesByType(matchType, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for param, threshold := range map[string]*int{
+		"minMatchGap":         &report.Thresholds.MinMatchGap,
+		"maxPartnerRepeats":   &report.Thresholds.MaxPartnerRepeats,
+		"maxOpponentRepeats":  &report.Thresholds.MaxOpponentRepeats,
+		"maxRedBlueImbalance": &report.Thresholds.MaxRedBlueImbalance,
+	} {
+		if value := r.URL.Query().Get(param); value != "" {
+			if *threshold, err = strconv.Atoi(value); err != nil {
+				return nil, fmt.Errorf("invalid value for %s: %s", param, value)
+			}
+		}
+	}
+
+	report.TeamStats = tournament.BuildTeamScheduleStats(
+		matches, web.arena.EventSettings.TeamsPerAlliance, report.Thresholds,
+	)
+	for _, stats := range report.TeamStats {
+		if len(stats.Warnings) > 0 {
+			report.NumTeamWarnings++
+		}
+	}
+	return &report, nil
+}
+
+// Returns the human-readable name of the given alliance station (e.g. "Red 1" for "R1").
+func allianceStationDisplayName(station string) string {
+	if station[0] == 'R' {
+		return "Red " + station[1:]
+	}
+	return "Blue " + station[1:]
+}
+
+// Returns the PDF cell line break setting for the given cell in a row, such that the line only breaks after the last one.
+func lineBreakAfterLast(index int, cells []string) int {
+	if index == len(cells)-1 {
+		return 1
+	}
+	return 0
+}
+
 // Returns the text to display if a team is a surrogate.
 func surrogateText(isSurrogate bool) string {
 	if isSurrogate {
@@ -957,6 +1322,54 @@ func (web *Web) cyclePdfReportHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Generates an HTML report of the cycle time analytics for the given match type, including the projected finish times
+// of the current schedule block and day.
+func (web *Web) cycleTimeHtmlReportHandler(w http.ResponseWriter, r *http.Request) {
+	matchType, analytics, err := web.getCycleTimeAnalytics(r)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	template, err := web.parseFiles("templates/cycle_time_report.html")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	data := struct {
+		*model.EventSettings
+		MatchType model.MatchType
+		*tournament.CycleTimeAnalytics
+	}{web.arena.EventSettings, matchType, analytics}
+	var buf bytes.Buffer
+	err = template.ExecuteTemplate(&buf, "cycle_time_report.html", data)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Strip out carriage returns to ensure consistent behavior across platforms.
+	cleaned := bytes.ReplaceAll(buf.Bytes(), []byte("\r"), []byte(""))
+	w.Write(cleaned)
+}
+
+// Returns the match type given in the request along with the cycle time analytics for its matches as of now.
+func (web *Web) getCycleTimeAnalytics(r *http.Request) (model.MatchType, *tournament.CycleTimeAnalytics, error) {
+	matchType, err := model.MatchTypeFromString(r.PathValue("type"))
+	if err != nil {
+		return 0, nil, err
+	}
+	matches, err := web.arena.Database.GetMatchesByType(matchType, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	scheduleBlocks, err := web.arena.Database.GetScheduleBlocksByMatchType(matchType)
+	if err != nil {
+		return 0, nil, err
+	}
+	return matchType, tournament.BuildCycleTimeAnalytics(matches, scheduleBlocks, time.Now()), nil
+}
+
 // Generates a CSV-formatted report of the FTA notes.
 func (web *Web) ftaCsvReportHandler(w http.ResponseWriter, r *http.Request) {
 	teams, err := web.arena.Database.GetAllTeams()
@@ -994,9 +1407,11 @@ func (web *Web) judgingSchedulePdfReportHandler(w http.ResponseWriter, r *http.R
 
 	// The widths of the table columns in mm, stored here so that they can be referenced for each row.
 	teamColWidths := map[string]float64{
-		"Team":      25,
-		"Time":      50,
-		"MatchInfo": 60,
+		"Team":      18,
+		"Time":      37,
+		"Panel":     40,
+		"Room":      30,
+		"MatchInfo": 35,
 	}
 	rowHeight := 6.5
 
@@ -1013,6 +1428,8 @@ func (web *Web) judgingSchedulePdfReportHandler(w http.ResponseWriter, r *http.R
 	pdf.SetFillColor(220, 220, 220)
 	pdf.CellFormat(teamColWidths["Team"], rowHeight, "Team", "1", 0, "C", true, 0, "")
 	pdf.CellFormat(teamColWidths["Time"], rowHeight, "Judging Time", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(teamColWidths["Panel"], rowHeight, "Panel", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(teamColWidths["Room"], rowHeight, "Room", "1", 0, "C", true, 0, "")
 	pdf.CellFormat(teamColWidths["MatchInfo"], rowHeight, "Previous Match", "1", 0, "C", true, 0, "")
 	pdf.CellFormat(teamColWidths["MatchInfo"], rowHeight, "Next Match", "1", 1, "C", true, 0, "")
 
@@ -1033,6 +1450,8 @@ func (web *Web) judgingSchedulePdfReportHandler(w http.ResponseWriter, r *http.R
 		pdf.CellFormat(
 			teamColWidths["Time"], rowHeight, slot.Time.Local().Format("Mon 1/02 03:04 PM"), "1", 0, "C", false, 0, "",
 		)
+		pdf.CellFormat(teamColWidths["Panel"], rowHeight, judgingPanelDescription(slot), "1", 0, "C", false, 0, "")
+		pdf.CellFormat(teamColWidths["Room"], rowHeight, slot.Room, "1", 0, "C", false, 0, "")
 		pdf.CellFormat(teamColWidths["MatchInfo"], rowHeight, previousMatchInfo, "1", 0, "C", false, 0, "")
 		pdf.CellFormat(teamColWidths["MatchInfo"], rowHeight, nextMatchInfo, "1", 1, "C", false, 0, "")
 	}
@@ -1049,16 +1468,18 @@ func (web *Web) judgingSchedulePdfReportHandler(w http.ResponseWriter, r *http.R
 
 	// The widths of the table columns in mm, stored here so that they can be referenced for each row.
 	judgeColWidths := map[string]float64{
-		"Judge":     25,
-		"Team":      25,
-		"Time":      45,
-		"MatchInfo": 50,
+		"Judge":     40,
+		"Room":      30,
+		"Team":      18,
+		"Time":      37,
+		"MatchInfo": 35,
 	}
 
 	// Render judge table header row.
 	pdf.SetFont("Arial", "B", 10)
 	pdf.SetFillColor(220, 220, 220)
 	pdf.CellFormat(judgeColWidths["Judge"], rowHeight, "Judge Team", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(judgeColWidths["Room"], rowHeight, "Room", "1", 0, "C", true, 0, "")
 	pdf.CellFormat(judgeColWidths["Team"], rowHeight, "Team", "1", 0, "C", true, 0, "")
 	pdf.CellFormat(judgeColWidths["Time"], rowHeight, "Judging Time", "1", 0, "C", true, 0, "")
 	pdf.CellFormat(judgeColWidths["MatchInfo"], rowHeight, "Previous Match", "1", 0, "C", true, 0, "")
@@ -1088,7 +1509,8 @@ func (web *Web) judgingSchedulePdfReportHandler(w http.ResponseWriter, r *http.R
 			nextMatchInfo = fmt.Sprintf("Q%d at %s", slot.NextMatchNumber, slot.NextMatchTime.Format("03:04 PM"))
 		}
 
-		pdf.CellFormat(judgeColWidths["Judge"], rowHeight, strconv.Itoa(slot.JudgeNumber), "1", 0, "C", false, 0, "")
+		pdf.CellFormat(judgeColWidths["Judge"], rowHeight, judgingPanelDescription(slot), "1", 0, "C", false, 0, "")
+		pdf.CellFormat(judgeColWidths["Room"], rowHeight, slot.Room, "1", 0, "C", false, 0, "")
 		pdf.CellFormat(judgeColWidths["Team"], rowHeight, strconv.Itoa(slot.TeamId), "1", 0, "C", false, 0, "")
 		pdf.CellFormat(
 			judgeColWidths["Time"], rowHeight, slot.Time.Local().Format("Mon 1/02 03:04 PM"), "1", 0, "C", false, 0, "",
@@ -1108,6 +1530,18 @@ func (web *Web) judgingSchedulePdfReportHandler(w http.ResponseWriter, r *http.R
 	}
 }
 
+// Returns the name of the panel that the judging slot is assigned to, along with its award category if it has one.
+func judgingPanelDescription(slot model.JudgingSlot) string {
+	description := slot.PanelName
+	if description == "" {
+		description = strconv.Itoa(slot.JudgeNumber)
+	}
+	if slot.AwardCategory != "" {
+		description += " (" + slot.AwardCategory + ")"
+	}
+	return description
+}
+
 func addTimeGeneratedFooter(pdf *gofpdf.Fpdf) {
 	footerText := fmt.Sprintf(
 		"Report generated at %s on %s", time.Now().Format("3:04:05 PM"), time.Now().Format("Mon Jan 2 2006"),
@@ -1127,3 +1561,120 @@ func drawMultiLineCell(pdf *gofpdf.Fpdf, width, height, lineHeight float64, text
 
 	pdf.SetXY(startX+width, startY)
 }
+
+// Generates a CSV-formatted report of the driver station log analysis for each team and match.
+func (web *Web) teamHealthCsvReportHandler(w http.ResponseWriter, r *http.Request) {
+	teamHealths, err := field.AnalyzeTeamMatchLogs()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Don't set the content type as "text/csv", as that will trigger an automatic download in the browser.
+	w.Header().Set("Content-Type", "text/plain")
+	template, err := web.parseFiles("templates/team_health.csv")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	var buf bytes.Buffer
+	err = template.ExecuteTemplate(&buf, "team_health.csv", teamHealths)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Strip out carriage returns to ensure consistent behavior across platforms.
+	cleaned := bytes.ReplaceAll(buf.Bytes(), []byte("\r"), []byte(""))
+	w.Write(cleaned)
+}
+
+// Generates an HTML report summarizing the robot connection and power health of each team across the event, for the
+// FTA to spot teams that need help.
+func (web *Web) teamHealthHtmlReportHandler(w http.ResponseWriter, r *http.Request) {
+	teamHealths, err := field.AnalyzeTeamMatchLogs()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	template, err := web.parseFiles("templates/team_health_report.html")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	data := struct {
+		*model.EventSettings
+		TeamHealths []field.TeamHealth
+	}{web.arena.EventSettings, teamHealths}
+	var buf bytes.Buffer
+	err = template.ExecuteTemplate(&buf, "team_health_report.html", data)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Strip out carriage returns to ensure consistent behavior across platforms.
+	cleaned := bytes.ReplaceAll(buf.Bytes(), []byte("\r"), []byte(""))
+	w.Write(cleaned)
+}
+
+// Represents the most recently reported software versions of a team and any ways in which they don't comply with the
+// event's allowed versions.
+type teamVersionCompliance struct {
+	TeamId   int
+	Versions *model.TeamVersions
+	Issues   []string
+}
+
+// Generates an HTML report listing the DS, roboRIO image, and radio firmware versions last reported by each team, for
+// the FTA to follow up with teams that need to update.
+func (web *Web) versionComplianceHtmlReportHandler(w http.ResponseWriter, r *http.Request) {
+	teams, err := web.arena.Database.GetAllTeams()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	allVersions, err := web.arena.Database.GetAllTeamVersions()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	versionsByTeam := make(map[int]*model.TeamVersions)
+	for i := range allVersions {
+		versionsByTeam[allVersions[i].Id] = &allVersions[i]
+	}
+	compliances := make([]teamVersionCompliance, 0, len(teams))
+	numIssues := 0
+	for _, team := range teams {
+		compliance := teamVersionCompliance{TeamId: team.Id, Versions: versionsByTeam[team.Id]}
+		if compliance.Versions != nil {
+			compliance.Issues = compliance.Versions.ComplianceIssues(web.arena.EventSettings)
+		}
+		if len(compliance.Issues) > 0 {
+			numIssues++
+		}
+		compliances = append(compliances, compliance)
+	}
+
+	template, err := web.parseFiles("templates/version_compliance_report.html")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	data := struct {
+		*model.EventSettings
+		Compliances []teamVersionCompliance
+		NumIssues   int
+	}{web.arena.EventSettings, compliances, numIssues}
+	var buf bytes.Buffer
+	err = template.ExecuteTemplate(&buf, "version_compliance_report.html", data)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Strip out carriage returns to ensure consistent behavior across platforms.
+	cleaned := bytes.ReplaceAll(buf.Bytes(), []byte("\r"), []byte(""))
+	w.Write(cleaned)
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/Team254/cheesy-arena/model"
 	"github.com/Team254/cheesy-arena/tournament"
 	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 )
@@ -100,6 +102,30 @@ func TestScheduleCsvReport(t *testing.T) {
 	assert.Equal(t, expectedBody, recorder.Body.String())
 }
 
+func TestScheduleCsvReportTeamsPerAlliance(t *testing.T) {
+	web := setupTestWeb(t)
+	web.arena.EventSettings.TeamsPerAlliance = 2
+
+	matchTime := time.Unix(1000, 0)
+	match := model.Match{
+		Type:             model.Qualification,
+		ShortName:        "Q1",
+		Time:             matchTime,
+		Red1:             1,
+		Red2:             2,
+		Blue1:            3,
+		Blue2:            4,
+		Blue2IsSurrogate: true,
+	}
+	web.arena.Database.CreateMatch(&match)
+
+	recorder := web.getHttpResponse("/reports/csv/schedule/qualification")
+	assert.Equal(t, 200, recorder.Code)
+	expectedBody := "Match,Type,Time,Red1,Red1IsSurrogate,Red2,Red2IsSurrogate,Blue1,Blue1IsSurrogate,Blue2," +
+		"Blue2IsSurrogate\nQ1,Qualification," + matchTime.String() + ",1,false,2,false,3,false,4,true\n\n"
+	assert.Equal(t, expectedBody, recorder.Body.String())
+}
+
 func TestSchedulePdfReport(t *testing.T) {
 	web := setupTestWeb(t)
 
@@ -130,6 +156,101 @@ func TestSchedulePdfReport(t *testing.T) {
 	assert.Equal(t, "application/pdf", recorder.Header()["Content-Type"][0])
 }
 
+func TestScheduleQualityReports(t *testing.T) {
+	web := setupTestWeb(t)
+
+	matches := []model.Match{
+		{Type: model.Qualification, TypeOrder: 1, ShortName: "Q1", Time: time.Unix(0, 0), Red1: 1, Red2: 2, Red3: 3,
+			Blue1: 4, Blue2: 5, Blue3: 6},
+		{Type: model.Qualification, TypeOrder: 2, ShortName: "Q2", Time: time.Unix(600, 0), Red1: 1, Red2: 2,
+			Red3: 7, Blue1: 8, Blue2: 9, Blue3: 10, Blue3IsSurrogate: true},
+	}
+	for _, match := range matches {
+		web.arena.Database.CreateMatch(&match)
+	}
+
+	recorder := web.getHttpResponse("/reports/html/schedule_quality/qualification")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Qualification Schedule Quality")
+	assert.Contains(t, recorder.Body.String(), "2 of 10 teams have warnings.")
+	assert.Contains(t, recorder.Body.String(), "only 0 matches between appearances<")
+	assert.NotContains(t, recorder.Body.String(), "not yet saved")
+
+	// Check that the warning thresholds can be overridden.
+	recorder = web.getHttpResponse("/reports/html/schedule_quality/qualification?maxPartnerRepeats=0")
+	assert.Contains(t, recorder.Body.String(), "only 0 matches between appearances; 1 repeated partners")
+	recorder = web.getHttpResponse("/reports/html/schedule_quality/qualification?minMatchGap=0")
+	assert.Contains(t, recorder.Body.String(), "0 of 10 teams have warnings.")
+	recorder = web.getHttpResponse("/reports/html/schedule_quality/qualification?minMatchGap=abc")
+	assert.Equal(t, 500, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "invalid value for minMatchGap: abc")
+
+	recorder = web.getHttpResponse("/reports/csv/schedule_quality/qualification")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "text/plain", recorder.Header()["Content-Type"][0])
+	assert.Contains(
+		t,
+		recorder.Body.String(),
+		"1,2,,0,0.00,1,0,2,0,0,0,0,0,Q1,"+time.Unix(0, 0).Format("2006-01-02 15:04:05")+",Q2,"+
+			time.Unix(600, 0).Format("2006-01-02 15:04:05")+",only 0 matches between appearances\n",
+	)
+	assert.Contains(t, recorder.Body.String(), "\n10,1,Q2,0,0.00,0,0,0,0,0,0,0,1,Q2,")
+
+	recorder = web.getHttpResponse("/reports/pdf/schedule_quality/qualification")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "application/pdf", recorder.Header()["Content-Type"][0])
+
+	// Check that the pending schedule is used if requested.
+	cachedMatches[model.Practice] = []model.Match{matches[0]}
+	defer delete(cachedMatches, model.Practice)
+	recorder = web.getHttpResponse("/reports/html/schedule_quality/practice?pending=true")
+	assert.Contains(t, recorder.Body.String(), "not yet saved")
+	assert.Contains(t, recorder.Body.String(), "0 of 6 teams have warnings.")
+}
+
+func TestCycleTimeReport(t *testing.T) {
+	web := setupTestWeb(t)
+
+	web.arena.Database.CreateScheduleBlock(
+		&model.ScheduleBlock{
+			MatchType: model.Qualification, StartTime: time.Unix(0, 0), NumMatches: 3, MatchSpacingSec: 360,
+		},
+	)
+	web.arena.Database.CreateMatch(
+		&model.Match{
+			Type: model.Qualification, TypeOrder: 1, ShortName: "Q1", Time: time.Unix(0, 0), StartedAt: time.Unix(0, 0),
+		},
+	)
+	web.arena.Database.CreateMatch(
+		&model.Match{
+			Type:      model.Qualification,
+			TypeOrder: 2,
+			ShortName: "Q2",
+			Time:      time.Unix(360, 0),
+			StartedAt: time.Unix(500, 0),
+		},
+	)
+	web.arena.Database.CreateMatch(
+		&model.Match{Type: model.Qualification, TypeOrder: 3, ShortName: "Q3", Time: time.Unix(720, 0)},
+	)
+
+	recorder := web.getHttpResponse("/reports/html/cycle_time/qualification")
+	assert.Equal(t, 200, recorder.Code)
+	body := recorder.Body.String()
+	assert.Contains(t, body, "Qualification Cycle Time Analytics")
+	assert.Contains(t, body, "Rolling average cycle time over recent matches: <b>8:20</b>")
+	assert.Contains(t, body, "Current block projected to finish")
+	assert.Contains(t, body, "<td>2:20</td>")
+
+	recorder = web.getHttpResponse("/reports/html/cycle_time/practice")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "No cycle times have been recorded yet")
+	assert.Contains(t, recorder.Body.String(), "All scheduled matches have been played.")
+
+	recorder = web.getHttpResponse("/reports/html/cycle_time/blorpy")
+	assert.Equal(t, 500, recorder.Code)
+}
+
 func TestTeamsCsvReport(t *testing.T) {
 	web := setupTestWeb(t)
 
@@ -223,6 +344,23 @@ func TestBracketPdfReport(t *testing.T) {
 	assert.Contains(t, recorder.Body.String(), "Finals")
 }
 
+func TestBracketHtmlReport(t *testing.T) {
+	web := setupTestWeb(t)
+	web.arena.EventSettings.PlayoffType = model.SingleEliminationPlayoff
+	web.arena.EventSettings.NumPlayoffAlliances = 5
+	tournament.CreateTestAlliances(web.arena.Database, 5)
+	web.arena.CreatePlayoffTournament()
+
+	recorder := web.getHttpResponse("/reports/html/bracket")
+	assert.Equal(t, 200, recorder.Code)
+	body := recorder.Body.String()
+	assert.Contains(t, body, "Round 3")
+	assert.NotContains(t, body, "Round 4")
+	assert.Contains(t, body, "QF2")
+	assert.Contains(t, body, "Alliance 5")
+	assert.Contains(t, body, "W SF1")
+}
+
 func TestJudgingSchedulePdfReport(t *testing.T) {
 	web := setupTestWeb(t)
 
@@ -231,3 +369,75 @@ func TestJudgingSchedulePdfReport(t *testing.T) {
 	assert.Equal(t, 200, recorder.Code)
 	assert.Equal(t, "application/pdf", recorder.Header()["Content-Type"][0])
 }
+
+func TestTeamHealthReport(t *testing.T) {
+	web := setupTestWeb(t)
+
+	logsPath := filepath.Join(model.BaseDir, "static", "logs")
+	assert.Nil(t, os.MkdirAll(logsPath, 0755))
+	logPath := filepath.Join(logsPath, "20260301100000_Qualification_Match_Q1_9254.csv")
+	log := "matchTimeSec,packetType,teamId,allianceStation,dsLinked,radioLinked,rioLinked,robotLinked,auto,enabled," +
+		"emergencyStop,autonomousStop,batteryVoltage,missedPacketCount,dsRobotTripTimeMs,rxRate,txRate," +
+		"signalNoiseRatio\n" +
+		"0.5,22,9254,R1,true,true,true,true,true,true,false,false,12.5,0,4,0,0,0\n" +
+		"1.0,22,9254,R1,true,true,true,false,true,true,false,false,0,0,0,0,0,0\n" +
+		"2.0,22,9254,R1,true,true,true,true,true,true,false,false,6.5,0,8,0,0,0\n"
+	assert.Nil(t, os.WriteFile(logPath, []byte(log), 0644))
+	defer os.Remove(logPath)
+
+	recorder := web.getHttpResponse("/reports/html/team_health")
+	assert.Equal(t, 200, recorder.Code)
+	body := recorder.Body.String()
+	assert.Contains(t, body, "Untitled Event &ndash; Team Health")
+	assert.Contains(t, body, "<h2 id=\"team9254\">Team 9254</h2>")
+	assert.Contains(t, body, "<td>1.0s</td>")
+	assert.Contains(t, body, "<td>6.50V</td>")
+
+	recorder = web.getHttpResponse("/reports/csv/team_health")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "text/plain", recorder.Header()["Content-Type"][0])
+	assert.Contains(t, recorder.Body.String(), "9254,Qualification,Q1,2026-03-01 10:00:00,3,1,1.0,1,6.50,4,8,8,8,0,0\n")
+}
+
+func TestInspectionPdfReport(t *testing.T) {
+	web := setupTestWeb(t)
+
+	web.arena.Database.CreateTeam(&model.Team{Id: 254, Nickname: "The Cheesy Poofs"})
+	web.arena.Database.CreateTeam(&model.Team{Id: 1114, Nickname: "Simbotics"})
+	web.arena.Database.CreateTeamInspection(&model.TeamInspection{Id: 254, PassedItems: []string{"Weight"}})
+
+	// Can't really parse the PDF content and check it, so just check that what's sent back is a PDF.
+	recorder := web.getHttpResponse("/reports/pdf/inspection")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "application/pdf", recorder.Header()["Content-Type"][0])
+}
+
+func TestVersionComplianceReport(t *testing.T) {
+	web := setupTestWeb(t)
+	web.arena.EventSettings.AllowedDsVersions = "25.0"
+	assert.Nil(t, web.arena.Database.CreateTeam(&model.Team{Id: 254}))
+	assert.Nil(t, web.arena.Database.CreateTeam(&model.Team{Id: 1114}))
+	assert.Nil(t, web.arena.Database.CreateTeam(&model.Team{Id: 2056}))
+	assert.Nil(
+		t,
+		web.arena.Database.CreateTeamVersions(
+			&model.TeamVersions{Id: 254, DsVersion: "24.0", RioVersion: "FRC_roboRIO2_2025_v2.0", UpdatedAt: time.Now()},
+		),
+	)
+	assert.Nil(
+		t,
+		web.arena.Database.CreateTeamVersions(
+			&model.TeamVersions{Id: 1114, DsVersion: "25.0", RadioFirmwareVersion: "1.2.0", UpdatedAt: time.Now()},
+		),
+	)
+
+	recorder := web.getHttpResponse("/reports/html/version_compliance")
+	assert.Equal(t, 200, recorder.Code)
+	body := recorder.Body.String()
+	assert.Contains(t, body, "Untitled Event &ndash; Version Compliance")
+	assert.Contains(t, body, "1 team(s) are")
+	assert.Contains(t, body, "FRC_roboRIO2_2025_v2.0")
+	assert.Contains(t, body, "DS version 24.0 is not allowed")
+	assert.Contains(t, body, "<td>1.2.0</td>")
+	assert.Contains(t, body, "Not yet reported")
+}
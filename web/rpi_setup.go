@@ -564,7 +564,7 @@ type stationRpiStatusView struct {
 
 func (web *Web) buildStationRpiStatusView() []stationRpiStatusView {
 	statusMap := web.arena.StationRpiStatuses()
-	order := []string{"R1", "R2", "R3", "B1", "B2", "B3"}
+	order := web.arena.AllianceStationNames()
 	result := make([]stationRpiStatusView, 0, len(order))
 	for _, station := range order {
 		status, ok := statusMap[station]
@@ -583,7 +583,7 @@ func (web *Web) buildStationRpiStatusView() []stationRpiStatusView {
 
 func isValidStationId(station string) bool {
 	switch station {
-	case "R1", "R2", "R3", "B1", "B2", "B3":
+	case "R1", "R2", "R3", "R4", "B1", "B2", "B3", "B4":
 		return true
 	default:
 		return false
@@ -61,7 +61,7 @@ func TestScoringPanelWebsocket(t *testing.T) {
 	readWebsocketType(t, blueWs, "realtimeScore")
 
 	// Send some autonomous period scoring commands.
-	assert.Equal(t, [3]bool{false, false, false}, web.arena.RedRealtimeScore.CurrentScore.LeaveStatuses)
+	assert.Equal(t, [4]bool{false, false, false}, web.arena.RedRealtimeScore.CurrentScore.LeaveStatuses)
 	leaveData := struct {
 		TeamPosition int
 	}{}
@@ -74,11 +74,11 @@ func TestScoringPanelWebsocket(t *testing.T) {
 		readWebsocketType(t, redWs, "realtimeScore")
 		readWebsocketType(t, blueWs, "realtimeScore")
 	}
-	assert.Equal(t, [3]bool{true, false, true}, web.arena.RedRealtimeScore.CurrentScore.LeaveStatuses)
+	assert.Equal(t, [4]bool{true, false, true}, web.arena.RedRealtimeScore.CurrentScore.LeaveStatuses)
 	redWs.Write("leave", leaveData)
 	readWebsocketType(t, redWs, "realtimeScore")
 	readWebsocketType(t, blueWs, "realtimeScore")
-	assert.Equal(t, [3]bool{true, false, false}, web.arena.RedRealtimeScore.CurrentScore.LeaveStatuses)
+	assert.Equal(t, [4]bool{true, false, false}, web.arena.RedRealtimeScore.CurrentScore.LeaveStatuses)
 
 	// Send some counter scoring commands
 	counterData := struct {
@@ -267,12 +267,12 @@ func TestScoringPanelWebsocket(t *testing.T) {
 	}{}
 	assert.Equal(
 		t,
-		[3]game.EndgameStatus{game.EndgameNone, game.EndgameNone, game.EndgameNone},
+		[4]game.EndgameStatus{game.EndgameNone, game.EndgameNone, game.EndgameNone},
 		web.arena.RedRealtimeScore.CurrentScore.EndgameStatuses,
 	)
 	assert.Equal(
 		t,
-		[3]game.EndgameStatus{game.EndgameNone, game.EndgameNone, game.EndgameNone},
+		[4]game.EndgameStatus{game.EndgameNone, game.EndgameNone, game.EndgameNone},
 		web.arena.BlueRealtimeScore.CurrentScore.EndgameStatuses,
 	)
 	endgameData.TeamPosition = 1
@@ -299,12 +299,12 @@ func TestScoringPanelWebsocket(t *testing.T) {
 	}
 	assert.Equal(
 		t,
-		[3]game.EndgameStatus{game.EndgameShallowCage, game.EndgameNone, game.EndgameDeepCage},
+		[4]game.EndgameStatus{game.EndgameShallowCage, game.EndgameNone, game.EndgameDeepCage},
 		web.arena.RedRealtimeScore.CurrentScore.EndgameStatuses,
 	)
 	assert.Equal(
 		t,
-		[3]game.EndgameStatus{game.EndgameNone, game.EndgameDeepCage, game.EndgameParked},
+		[4]game.EndgameStatus{game.EndgameNone, game.EndgameDeepCage, game.EndgameParked},
 		web.arena.BlueRealtimeScore.CurrentScore.EndgameStatuses,
 	)
 
@@ -22,10 +22,14 @@ func (web *Web) breaksGetHandler(w http.ResponseWriter, r *http.Request) {
 		handleWebErr(w, err)
 		return
 	}
-	breaks, err := web.arena.Database.GetScheduledBreaksByMatchType(model.Playoff)
-	if err != nil {
-		handleWebErr(w, err)
-		return
+	var breaks []model.ScheduledBreak
+	for _, matchType := range []model.MatchType{model.Practice, model.Qualification, model.Playoff} {
+		matchTypeBreaks, err := web.arena.Database.GetScheduledBreaksByMatchType(matchType)
+		if err != nil {
+			handleWebErr(w, err)
+			return
+		}
+		breaks = append(breaks, matchTypeBreaks...)
 	}
 	data := struct {
 		*model.EventSettings
@@ -0,0 +1,189 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Web routes for seeding a championship playoff tournament with the winning alliances of several divisions.
+
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/Team254/cheesy-arena/playoff"
+	"github.com/Team254/cheesy-arena/tournament"
+)
+
+// Shows the championship division winner import page.
+func (web *Web) championshipGetHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	web.renderChampionship(w, r, "")
+}
+
+// Imports the uploaded division winners as this event's alliances and creates the playoff matches.
+func (web *Web) championshipPostHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	if !web.canModifyAllianceSelection() {
+		web.renderChampionship(w, r, "Alliances have already been finalized.")
+		return
+	}
+
+	location, _ := time.LoadLocation("Local")
+	startTime, err := time.ParseInLocation("2006-01-02 03:04:05 PM", r.PostFormValue("startTime"), location)
+	if err != nil {
+		web.renderChampionship(w, r, "Must specify a valid start time for the playoff rounds.")
+		return
+	}
+
+	// Read one division winner per seed, from either an exported JSON file or a division's database backup.
+	divisionWinners := make([]tournament.DivisionWinner, web.arena.EventSettings.NumPlayoffAlliances)
+	for i := range divisionWinners {
+		file, header, err := r.FormFile(fmt.Sprintf("seed%d", i+1))
+		if err != nil {
+			web.renderChampionship(w, r, fmt.Sprintf("No division winner file was specified for seed %d.", i+1))
+			return
+		}
+		var divisionWinner *tournament.DivisionWinner
+		if strings.HasSuffix(strings.ToLower(header.Filename), ".db") {
+			divisionWinner, err = readDivisionWinnerFromDatabase(file)
+		} else {
+			divisionWinner = new(tournament.DivisionWinner)
+			err = json.NewDecoder(file).Decode(divisionWinner)
+		}
+		file.Close()
+		if err != nil {
+			web.renderChampionship(
+				w, r, fmt.Sprintf("Could not read division winner for seed %d from %s: %s", i+1, header.Filename, err),
+			)
+			return
+		}
+		divisionWinners[i] = *divisionWinner
+	}
+
+	if err = tournament.CreateChampionshipAlliances(
+		web.arena.Database,
+		divisionWinners,
+		web.arena.EventSettings.NumPlayoffAlliances,
+		web.arena.EventSettings.TeamsPerAlliance,
+	); err != nil {
+		web.renderChampionship(w, r, err.Error())
+		return
+	}
+
+	// Generate the first round of playoff matches.
+	if err = web.arena.CreatePlayoffMatches(startTime); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Reset yellow cards.
+	err = tournament.CalculateTeamCards(web.arena.Database, model.Playoff)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Back up the database.
+	err = web.arena.Database.Backup(web.arena.EventSettings.Name, "post_championship_import")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	if web.arena.EventSettings.TbaPublishingEnabled {
+		// Publish alliances and schedule to The Blue Alliance.
+		err = web.arena.TbaClient.PublishAlliances(web.arena.Database)
+		if err != nil {
+			web.renderChampionship(w, r, fmt.Sprintf("Failed to publish alliances: %s", err.Error()))
+			return
+		}
+		err = web.arena.TbaClient.PublishMatches(web.arena.Database)
+		if err != nil {
+			web.renderChampionship(w, r, fmt.Sprintf("Failed to publish matches: %s", err.Error()))
+			return
+		}
+	}
+
+	// Signal displays of the bracket to update themselves.
+	web.arena.ScorePostedNotifier.Notify()
+
+	// Load the first playoff match.
+	matches, err := web.arena.Database.GetMatchesByType(model.Playoff, false)
+	if err == nil && len(matches) > 0 {
+		_ = web.arena.LoadMatch(&matches[0])
+	}
+
+	http.Redirect(w, r, "/match_play", 303)
+}
+
+func (web *Web) renderChampionship(w http.ResponseWriter, r *http.Request, errorMessage string) {
+	template, err := web.parseFiles("templates/setup_championship.html", "templates/base.html")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	alliances, err := web.arena.Database.GetAllAlliances()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	data := struct {
+		*model.EventSettings
+		Alliances    []model.Alliance
+		ErrorMessage string
+	}{web.arena.EventSettings, alliances, errorMessage}
+	err = template.ExecuteTemplate(w, "base", data)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+}
+
+// Determines the winning alliance of the playoff tournament stored in the given division database backup.
+func readDivisionWinnerFromDatabase(file io.Reader) (*tournament.DivisionWinner, error) {
+	// Write the file to a temporary location on disk so that it can be opened as a database.
+	tempFile, err := os.CreateTemp("", "uploaded-division-db-")
+	if err != nil {
+		return nil, err
+	}
+	defer tempFile.Close()
+	tempFilePath := tempFile.Name()
+	defer os.Remove(tempFilePath)
+	if _, err = io.Copy(tempFile, file); err != nil {
+		return nil, err
+	}
+	tempFile.Close()
+	database, err := model.OpenDatabase(tempFilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer database.Close()
+
+	eventSettings, err := database.GetEventSettings()
+	if err != nil {
+		return nil, err
+	}
+	playoffTournament, err := playoff.NewPlayoffTournament(
+		eventSettings.PlayoffType, eventSettings.NumPlayoffAlliances,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = playoffTournament.UpdateMatches(database); err != nil {
+		return nil, err
+	}
+	if !playoffTournament.IsComplete() {
+		return nil, fmt.Errorf("the playoff tournament of division %s is not yet complete", eventSettings.Name)
+	}
+	return tournament.GetDivisionWinner(database, eventSettings.Name, playoffTournament.WinningAllianceId())
+}
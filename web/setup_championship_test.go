@@ -0,0 +1,160 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Team254/cheesy-arena/game"
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/Team254/cheesy-arena/tournament"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDivisionWinnerApi(t *testing.T) {
+	web := setupTestDivisionWeb(t, "Archimedes", 101, false)
+
+	recorder := web.getHttpResponse("/api/division_winner")
+	assert.Equal(t, 404, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "playoff tournament is not yet complete")
+
+	completeTestDivisionPlayoffs(t, web)
+	recorder = web.getHttpResponse("/api/division_winner")
+	assert.Equal(t, 200, recorder.Code, recorder.Body.String())
+	assert.Equal(t, "application/json", recorder.Header()["Content-Type"][0])
+	var divisionWinner tournament.DivisionWinner
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &divisionWinner))
+	assert.Equal(t, "Archimedes", divisionWinner.DivisionName)
+	assert.Equal(t, []int{101, 102, 103}, divisionWinner.Alliance.TeamIds)
+	if assert.Equal(t, 3, len(divisionWinner.Teams)) {
+		assert.Equal(t, "Team 101", divisionWinner.Teams[0].Nickname)
+	}
+}
+
+func TestSetupChampionship(t *testing.T) {
+	web := setupTestWeb(t)
+	web.arena.EventSettings.PlayoffType = model.SingleEliminationPlayoff
+	web.arena.EventSettings.NumPlayoffAlliances = 2
+	assert.Nil(t, web.arena.CreatePlayoffTournament())
+
+	recorder := web.getHttpResponse("/setup/championship")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "seed1")
+	assert.Contains(t, recorder.Body.String(), "seed2")
+	assert.NotContains(t, recorder.Body.String(), "seed3")
+
+	// Export one division winner as JSON and take the other from a database backup.
+	archimedesWeb := setupTestDivisionWeb(t, "Archimedes", 101, true)
+	archimedesJson := archimedesWeb.getHttpResponse("/api/division_winner").Body
+	curieWeb := setupTestDivisionWeb(t, "Curie", 201, true)
+	curieDb := new(bytes.Buffer)
+	assert.Nil(t, curieWeb.arena.Database.WriteBackup(curieDb))
+	galileoWeb := setupTestDivisionWeb(t, "Galileo", 301, false)
+	galileoDb := new(bytes.Buffer)
+	assert.Nil(t, galileoWeb.arena.Database.WriteBackup(galileoDb))
+
+	// Check that missing and incomplete divisions are rejected.
+	recorder = web.postChampionshipHttpResponse(map[string]*bytes.Buffer{"seed1.json": archimedesJson})
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "No division winner file was specified for seed 2.")
+	recorder = web.postChampionshipHttpResponse(
+		map[string]*bytes.Buffer{
+			"seed1.json": bytes.NewBuffer(archimedesJson.Bytes()), "seed2.db": bytes.NewBuffer(galileoDb.Bytes()),
+		},
+	)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "the playoff tournament of division Galileo is not yet complete")
+	alliances, _ := web.arena.Database.GetAllAlliances()
+	assert.Empty(t, alliances)
+
+	recorder = web.postChampionshipHttpResponse(
+		map[string]*bytes.Buffer{"seed1.json": archimedesJson, "seed2.db": curieDb},
+	)
+	assert.Equal(t, 303, recorder.Code, recorder.Body.String())
+	alliances, _ = web.arena.Database.GetAllAlliances()
+	if assert.Equal(t, 2, len(alliances)) {
+		assert.Equal(t, "Archimedes", alliances[0].DivisionName)
+		assert.Equal(t, []int{101, 102, 103}, alliances[0].TeamIds)
+		assert.Equal(t, "Curie", alliances[1].DivisionName)
+		assert.Equal(t, []int{201, 202, 203}, alliances[1].TeamIds)
+	}
+	team, _ := web.arena.Database.GetTeamById(201)
+	if assert.NotNil(t, team) {
+		assert.Equal(t, "Team 201", team.Nickname)
+	}
+	matches, _ := web.arena.Database.GetMatchesByType(model.Playoff, false)
+	if assert.NotEmpty(t, matches) {
+		assert.Equal(t, 102, matches[0].Red1)
+		assert.Equal(t, 202, matches[0].Blue1)
+	}
+
+	recorder = web.getHttpResponse("/setup/championship")
+	assert.Contains(t, recorder.Body.String(), "Archimedes")
+	assert.Contains(t, recorder.Body.String(), "Curie")
+	recorder = web.getHttpResponse("/api/bracket/svg?activeMatch=current")
+	assert.Contains(t, recorder.Body.String(), "Archimedes")
+
+	// Check that a second import is rejected once the playoff matches exist.
+	recorder = web.postChampionshipHttpResponse(map[string]*bytes.Buffer{})
+	assert.Contains(t, recorder.Body.String(), "Alliances have already been finalized.")
+}
+
+// Sets up a division event with two alliances in a single-elimination playoff tournament, optionally played out such
+// that the first alliance wins.
+func setupTestDivisionWeb(t *testing.T, divisionName string, firstTeamId int, complete bool) *Web {
+	web := setupTestWeb(t)
+	web.arena.EventSettings.Name = divisionName
+	web.arena.EventSettings.PlayoffType = model.SingleEliminationPlayoff
+	web.arena.EventSettings.NumPlayoffAlliances = 2
+	assert.Nil(t, web.arena.Database.UpdateEventSettings(web.arena.EventSettings))
+	assert.Nil(t, web.arena.CreatePlayoffTournament())
+	for i := 0; i < 2; i++ {
+		teamIds := make([]int, 3)
+		for j := range teamIds {
+			teamIds[j] = firstTeamId + 3*i + j
+			team := model.Team{Id: teamIds[j], Nickname: fmt.Sprintf("Team %d", teamIds[j])}
+			assert.Nil(t, web.arena.Database.CreateTeam(&team))
+		}
+		alliance := model.Alliance{Id: i + 1, TeamIds: teamIds, Lineup: []int{teamIds[1], teamIds[0], teamIds[2]}}
+		assert.Nil(t, web.arena.Database.CreateAlliance(&alliance))
+	}
+	assert.Nil(t, web.arena.CreatePlayoffMatches(time.Unix(1000, 0)))
+	assert.Nil(t, web.arena.UpdatePlayoffTournament())
+	if complete {
+		completeTestDivisionPlayoffs(t, web)
+	}
+	return web
+}
+
+// Marks the first two playoff matches as won by the red alliance, which completes a two-alliance tournament.
+func completeTestDivisionPlayoffs(t *testing.T, web *Web) {
+	matches, _ := web.arena.Database.GetMatchesByType(model.Playoff, false)
+	for i := 0; i < 2; i++ {
+		matches[i].Status = game.RedWonMatch
+		assert.Nil(t, web.arena.Database.UpdateMatch(&matches[i]))
+	}
+	assert.Nil(t, web.arena.UpdatePlayoffTournament())
+}
+
+func (web *Web) postChampionshipHttpResponse(files map[string]*bytes.Buffer) *httptest.ResponseRecorder {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("startTime", "2026-10-18 01:00:00 PM")
+	for filename, file := range files {
+		part, _ := writer.CreateFormFile(filename[:5], filename)
+		part.Write(file.Bytes())
+	}
+	writer.Close()
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/setup/championship", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	web.newHandler().ServeHTTP(recorder, req)
+	return recorder
+}
@@ -1,15 +1,17 @@
 // Copyright 2018 Team 254. All Rights Reserved.
 // Author: pat@patfairbank.com (Patrick Fairbank)
 //
-// Web routes for testing the field sounds, LEDs, and PLC.
+// Web routes for testing the field sounds, LEDs, and PLC, and for enabling single stations during a test match.
 
 package web
 
 import (
 	"fmt"
+	"github.com/Team254/cheesy-arena/field"
 	"github.com/Team254/cheesy-arena/game"
 	"github.com/Team254/cheesy-arena/model"
 	"github.com/Team254/cheesy-arena/websocket"
+	"github.com/mitchellh/mapstructure"
 	"io"
 	"log"
 	"net/http"
@@ -29,11 +31,21 @@ func (web *Web) fieldTestingGetHandler(w http.ResponseWriter, r *http.Request) {
 	plc := web.arena.Plc
 	data := struct {
 		*model.EventSettings
-		MatchSounds   []*game.MatchSound
-		InputNames    []string
-		RegisterNames []string
-		CoilNames     []string
-	}{web.arena.EventSettings, game.MatchSounds, plc.GetInputNames(), plc.GetRegisterNames(), plc.GetCoilNames()}
+		MatchSounds             []*game.MatchSound
+		InputNames              []string
+		RegisterNames           []string
+		CoilNames               []string
+		StationIds              []string
+		MaxMiniMatchDurationSec int
+	}{
+		web.arena.EventSettings,
+		game.MatchSounds,
+		plc.GetInputNames(),
+		plc.GetRegisterNames(),
+		plc.GetCoilNames(),
+		web.arena.AllianceStationNames(),
+		field.MaxStationMiniMatchDurationSec,
+	}
 	err = template.ExecuteTemplate(w, "base", data)
 	if err != nil {
 		handleWebErr(w, err)
@@ -55,7 +67,7 @@ func (web *Web) fieldTestingWebsocketHandler(w http.ResponseWriter, r *http.Requ
 	defer ws.Close()
 
 	// Subscribe the websocket to the notifiers whose messages will be passed on to the client, in a separate goroutine.
-	go ws.HandleNotifiers(web.arena.Plc.IoChangeNotifier())
+	go ws.HandleNotifiers(web.arena.Plc.IoChangeNotifier(), web.arena.ArenaStatusNotifier)
 
 	// Loop, waiting for commands and responding to them, until the client closes the connection.
 	for {
@@ -77,6 +89,31 @@ func (web *Web) fieldTestingWebsocketHandler(w http.ResponseWriter, r *http.Requ
 				continue
 			}
 			web.arena.PlaySoundNotifier.NotifyWithMessage(sound)
+		case "startStationMiniMatch":
+			args := struct {
+				Station     string
+				Auto        bool
+				DurationSec int
+			}{}
+			err = mapstructure.Decode(data, &args)
+			if err != nil {
+				ws.WriteError(err.Error())
+				continue
+			}
+			if err = web.arena.StartStationMiniMatch(args.Station, args.Auto, args.DurationSec); err != nil {
+				ws.WriteError(err.Error())
+				continue
+			}
+		case "stopStationMiniMatch":
+			station, ok := data.(string)
+			if !ok {
+				ws.WriteError(fmt.Sprintf("Failed to parse '%s' message.", messageType))
+				continue
+			}
+			if err = web.arena.StopStationMiniMatch(station); err != nil {
+				ws.WriteError(err.Error())
+				continue
+			}
 		default:
 			ws.WriteError(fmt.Sprintf("Invalid message type '%s'.", messageType))
 			continue
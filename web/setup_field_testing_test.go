@@ -4,6 +4,7 @@
 package web
 
 import (
+	"github.com/Team254/cheesy-arena/field"
 	"github.com/Team254/cheesy-arena/game"
 	"github.com/Team254/cheesy-arena/websocket"
 	gorillawebsocket "github.com/gorilla/websocket"
@@ -33,6 +34,7 @@ func TestSetupFieldTestingWebsocket(t *testing.T) {
 
 	// Should get a few status updates right after connection.
 	readWebsocketType(t, ws, "plcIoChange")
+	readWebsocketType(t, ws, "arenaStatus")
 
 	// Also create a websocket to the audience display to check that it plays the requested game sound.
 	audienceConn, _, err := gorillawebsocket.DefaultDialer.Dial(wsUrl+"/displays/audience/websocket?displayId=1", nil)
@@ -43,4 +45,20 @@ func TestSetupFieldTestingWebsocket(t *testing.T) {
 
 	ws.Write("playSound", "resume")
 	assert.Equal(t, "resume", readWebsocketType(t, audienceWs, "playSound"))
+
+	// Check starting and stopping a single-station mini-match.
+	ws.Write("startStationMiniMatch", map[string]any{"Station": "R2", "Auto": false, "DurationSec": 20})
+	assert.Contains(t, readWebsocketError(t, ws), "no driver station is connected in station R2")
+	web.arena.AllianceStations["R2"].DsConn = &field.DriverStationConnection{TeamId: 254, AllianceStation: "R2"}
+	ws.Write("startStationMiniMatch", map[string]any{"Station": "R2", "Auto": false, "DurationSec": 20})
+	ws.Write("stopStationMiniMatch", "R3")
+	assert.Contains(t, readWebsocketError(t, ws), "no mini-match is running in station R3")
+	if assert.NotNil(t, web.arena.AllianceStations["R2"].MiniMatch) {
+		assert.Equal(t, false, web.arena.AllianceStations["R2"].MiniMatch.Auto)
+		assert.Equal(t, 20, web.arena.AllianceStations["R2"].MiniMatch.DurationSec)
+	}
+	ws.Write("stopStationMiniMatch", "R2")
+	ws.Write("stopStationMiniMatch", "R2")
+	assert.Contains(t, readWebsocketError(t, ws), "no mini-match is running in station R2")
+	assert.Nil(t, web.arena.AllianceStations["R2"].MiniMatch)
 }
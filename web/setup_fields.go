@@ -0,0 +1,122 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Web routes for configuring the hardware of the additional fields in a multi-field event.
+
+package web
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Team254/cheesy-arena/field"
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// Shows the hardware configuration page for the additional fields.
+func (web *Web) fieldsGetHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	web.renderFields(w, r, "")
+}
+
+// Saves the hardware settings of the additional field given in the form.
+func (web *Web) fieldsPostHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	fieldNumber, _ := strconv.Atoi(r.PostFormValue("id"))
+	if fieldNumber < 2 || fieldNumber > web.arena.EventSettings.NumFields {
+		web.renderFields(w, r, fmt.Sprintf("Invalid field number %d.", fieldNumber))
+		return
+	}
+	fieldSettings, err := web.arena.Database.GetFieldSettingsById(fieldNumber)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	isNew := fieldSettings == nil
+	if isNew {
+		fieldSettings = &model.FieldSettings{Id: fieldNumber}
+	}
+	fieldSettings.DriverStationAddress = strings.TrimSpace(r.PostFormValue("driverStationAddress"))
+	if net.ParseIP(fieldSettings.DriverStationAddress) == nil {
+		web.renderFields(w, r, fmt.Sprintf("Field %d needs a valid driver station address.", fieldNumber))
+		return
+	}
+	fieldSettings.PlcAddress = r.PostFormValue("plcAddress")
+	fieldSettings.ApAddress = r.PostFormValue("apAddress")
+	fieldSettings.ApPassword = r.PostFormValue("apPassword")
+	fieldSettings.ApChannel, _ = strconv.Atoi(r.PostFormValue("apChannel"))
+	fieldSettings.SwitchAddress = r.PostFormValue("switchAddress")
+	fieldSettings.SwitchPassword = r.PostFormValue("switchPassword")
+	fieldSettings.TeamSignRed1Id, _ = strconv.Atoi(r.PostFormValue("teamSignRed1Id"))
+	fieldSettings.TeamSignRed2Id, _ = strconv.Atoi(r.PostFormValue("teamSignRed2Id"))
+	fieldSettings.TeamSignRed3Id, _ = strconv.Atoi(r.PostFormValue("teamSignRed3Id"))
+	fieldSettings.TeamSignRedTimerId, _ = strconv.Atoi(r.PostFormValue("teamSignRedTimerId"))
+	fieldSettings.TeamSignBlue1Id, _ = strconv.Atoi(r.PostFormValue("teamSignBlue1Id"))
+	fieldSettings.TeamSignBlue2Id, _ = strconv.Atoi(r.PostFormValue("teamSignBlue2Id"))
+	fieldSettings.TeamSignBlue3Id, _ = strconv.Atoi(r.PostFormValue("teamSignBlue3Id"))
+	fieldSettings.TeamSignBlueTimerId, _ = strconv.Atoi(r.PostFormValue("teamSignBlueTimerId"))
+	if isNew {
+		err = web.arena.Database.CreateFieldSettings(fieldSettings)
+	} else {
+		err = web.arena.Database.UpdateFieldSettings(fieldSettings)
+	}
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Apply the new settings to the field's arena if it is running.
+	err = web.arena.ForEachField(
+		func(fieldArena *field.Arena) error {
+			if fieldArena.FieldNumber == fieldNumber {
+				return fieldArena.LoadSettings()
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/setup/fields", 303)
+}
+
+func (web *Web) renderFields(w http.ResponseWriter, r *http.Request, errorMessage string) {
+	var allFieldSettings []model.FieldSettings
+	for fieldNumber := 2; fieldNumber <= web.arena.EventSettings.NumFields; fieldNumber++ {
+		fieldSettings, err := web.arena.Database.GetFieldSettingsById(fieldNumber)
+		if err != nil {
+			handleWebErr(w, err)
+			return
+		}
+		if fieldSettings == nil {
+			fieldSettings = &model.FieldSettings{Id: fieldNumber}
+		}
+		allFieldSettings = append(allFieldSettings, *fieldSettings)
+	}
+
+	template, err := web.parseFiles("templates/setup_fields.html", "templates/base.html")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	data := struct {
+		*model.EventSettings
+		FieldSettings []model.FieldSettings
+		ErrorMessage  string
+	}{web.arena.EventSettings, allFieldSettings, errorMessage}
+	err = template.ExecuteTemplate(w, "base", data)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package web
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetupFields(t *testing.T) {
+	web := setupTestWeb(t)
+
+	recorder := web.getHttpResponse("/setup/fields")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "This event runs a single field")
+	recorder = web.postHttpResponse("/setup/fields", "id=2&plcAddress=10.0.200.10")
+	assert.Contains(t, recorder.Body.String(), "Invalid field number 2")
+
+	web.arena.EventSettings.NumFields = 3
+	recorder = web.postHttpResponse(
+		"/setup/fields", "id=2&driverStationAddress=10.0.200.5&plcAddress=10.0.200.10&apChannel=149&teamSignRed1Id=21",
+	)
+	assert.Equal(t, 303, recorder.Code)
+	fieldSettings, _ := web.arena.Database.GetFieldSettingsById(2)
+	if assert.NotNil(t, fieldSettings) {
+		assert.Equal(t, "10.0.200.5", fieldSettings.DriverStationAddress)
+		assert.Equal(t, "10.0.200.10", fieldSettings.PlcAddress)
+		assert.Equal(t, 149, fieldSettings.ApChannel)
+		assert.Equal(t, 21, fieldSettings.TeamSignRed1Id)
+	}
+	recorder = web.postHttpResponse("/setup/fields", "id=2&plcAddress=10.0.200.11")
+	assert.Contains(t, recorder.Body.String(), "Field 2 needs a valid driver station address")
+	recorder = web.postHttpResponse("/setup/fields", "id=2&driverStationAddress=10.0.200.5&plcAddress=10.0.200.11")
+	assert.Equal(t, 303, recorder.Code)
+	fieldSettings, _ = web.arena.Database.GetFieldSettingsById(2)
+	assert.Equal(t, "10.0.200.11", fieldSettings.PlcAddress)
+
+	recorder = web.getHttpResponse("/setup/fields")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Field 2")
+	assert.Contains(t, recorder.Body.String(), "10.0.200.11")
+	assert.Contains(t, recorder.Body.String(), "Field 3")
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Web routes for configuring the hooks that notify external automation of arena events.
+
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/Team254/cheesy-arena/field"
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// Shows the hooks configuration page.
+func (web *Web) hooksGetHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	web.renderHooks(w, r, "", "")
+}
+
+// Saves, deletes or tests the hook given in the form.
+func (web *Web) hooksPostHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	hookId, _ := strconv.Atoi(r.PostFormValue("id"))
+	if r.PostFormValue("action") == "delete" {
+		if err := web.arena.Database.DeleteArenaHook(hookId); err != nil {
+			handleWebErr(w, err)
+			return
+		}
+	} else {
+		arenaHook := model.ArenaHook{
+			Id:         hookId,
+			Name:       r.PostFormValue("name"),
+			Trigger:    r.PostFormValue("trigger"),
+			Type:       r.PostFormValue("type"),
+			Target:     r.PostFormValue("target"),
+			OscAddress: r.PostFormValue("oscAddress"),
+			Enabled:    r.PostFormValue("enabled") == "on",
+		}
+		if !slices.ContainsFunc(
+			field.HookTriggers, func(trigger field.HookTrigger) bool { return trigger.Name == arenaHook.Trigger },
+		) {
+			web.renderHooks(w, r, fmt.Sprintf("Invalid hook trigger '%s'.", arenaHook.Trigger), "")
+			return
+		}
+		if !slices.Contains([]string{model.ArenaHookCommand, model.ArenaHookHttp, model.ArenaHookOsc}, arenaHook.Type) {
+			web.renderHooks(w, r, fmt.Sprintf("Invalid hook type '%s'.", arenaHook.Type), "")
+			return
+		}
+		if arenaHook.Type == model.ArenaHookCommand && web.arena.EventSettings.AdminPassword == "" {
+			// Without a password, anyone on the network could run arbitrary programs on this computer.
+			web.renderHooks(
+				w, r, "Command hooks can't be saved or tested until an admin password has been set in the settings.", "",
+			)
+			return
+		}
+
+		if r.PostFormValue("action") == "test" {
+			// Deliver a sample payload right away using the values in the form, without saving them.
+			if err := web.arena.TestArenaHook(arenaHook); err != nil {
+				web.renderHooks(w, r, fmt.Sprintf("Hook '%s' failed: %s.", arenaHook.Name, err.Error()), "")
+			} else {
+				web.renderHooks(w, r, "", fmt.Sprintf("Hook '%s' ran successfully.", arenaHook.Name))
+			}
+			return
+		}
+
+		var err error
+		if arenaHook.Id == 0 {
+			err = web.arena.Database.CreateArenaHook(&arenaHook)
+		} else {
+			err = web.arena.Database.UpdateArenaHook(&arenaHook)
+		}
+		if err != nil {
+			handleWebErr(w, err)
+			return
+		}
+	}
+
+	// Apply the changes to the running arenas.
+	if err := web.arena.ForEachField((*field.Arena).LoadArenaHooks); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/setup/hooks", 303)
+}
+
+func (web *Web) renderHooks(w http.ResponseWriter, r *http.Request, errorMessage, successMessage string) {
+	arenaHooks, err := web.arena.Database.GetAllArenaHooks()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Append a blank hook to the end that can be used to add a new one.
+	arenaHooks = append(arenaHooks, model.ArenaHook{Enabled: true})
+
+	template, err := web.parseFiles("templates/setup_hooks.html", "templates/base.html")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	data := struct {
+		*model.EventSettings
+		ArenaHooks     []model.ArenaHook
+		Triggers       []field.HookTrigger
+		ErrorMessage   string
+		SuccessMessage string
+	}{web.arena.EventSettings, arenaHooks, field.HookTriggers, errorMessage, successMessage}
+	err = template.ExecuteTemplate(w, "base", data)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package web
+
+import (
+	"testing"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetupHooks(t *testing.T) {
+	web := setupTestWeb(t)
+
+	recorder := web.getHttpResponse("/setup/hooks")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Automation Hooks")
+
+	recorder = web.postHttpResponse(
+		"/setup/hooks", "name=Lights&trigger=autoStart&type=osc&target=10.0.100.50:8000&enabled=on",
+	)
+	assert.Equal(t, 303, recorder.Code)
+	arenaHooks, _ := web.arena.Database.GetAllArenaHooks()
+	if assert.Equal(t, 1, len(arenaHooks)) {
+		assert.Equal(
+			t,
+			model.ArenaHook{
+				Id: 1, Name: "Lights", Trigger: "autoStart", Type: "osc", Target: "10.0.100.50:8000", Enabled: true,
+			},
+			arenaHooks[0],
+		)
+	}
+	recorder = web.getHttpResponse("/setup/hooks")
+	assert.Contains(t, recorder.Body.String(), "10.0.100.50:8000")
+
+	// Check validation of the trigger and type.
+	recorder = web.postHttpResponse("/setup/hooks", "name=Bad&trigger=halftime&type=osc&target=x")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Invalid hook trigger 'halftime'.")
+	recorder = web.postHttpResponse("/setup/hooks", "name=Bad&trigger=matchEnd&type=email&target=x")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Invalid hook type 'email'.")
+
+	// Check that command hooks can't be saved or tested without an admin password.
+	recorder = web.postHttpResponse("/setup/hooks", "action=test&name=Script&trigger=any&type=command&target=true")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Command hooks can't be saved or tested until an admin password")
+	recorder = web.postHttpResponse("/setup/hooks", "name=Script&trigger=any&type=command&target=true")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Command hooks can't be saved or tested until an admin password")
+	arenaHooks, _ = web.arena.Database.GetAllArenaHooks()
+	assert.Equal(t, 1, len(arenaHooks))
+
+	// Check that testing a hook reports its outcome without saving it.
+	web.arena.EventSettings.AdminPassword = "admin"
+	recorder = web.postHttpResponse("/login", "username=admin&password=admin")
+	headers := map[string]string{"Cookie": recorder.Header().Get("Set-Cookie")}
+	recorder = web.postHttpResponseWithHeaders(
+		"/setup/hooks", "action=test&name=Script&trigger=any&type=command&target=true", headers,
+	)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Hook 'Script' ran successfully.")
+	recorder = web.postHttpResponseWithHeaders(
+		"/setup/hooks", "action=test&name=Script&trigger=any&type=command&target=+", headers,
+	)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Hook 'Script' failed: no target is configured.")
+	web.arena.EventSettings.AdminPassword = ""
+
+	recorder = web.postHttpResponse("/setup/hooks", "action=delete&id=1")
+	assert.Equal(t, 303, recorder.Code)
+	arenaHooks, _ = web.arena.Database.GetAllArenaHooks()
+	assert.Empty(t, arenaHooks)
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Web routes for recording the progress of each team through robot inspection.
+
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Team254/cheesy-arena/field"
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// Inspection progress of a single team against the configured checklist.
+type teamInspectionStatus struct {
+	Team         model.Team
+	Inspection   model.TeamInspection
+	PassedItems  map[string]bool
+	MissingItems []string
+	IsPassed     bool
+}
+
+// Shows the robot inspection page.
+func (web *Web) inspectionGetHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	web.renderInspection(w, r, "")
+}
+
+// Saves the inspection checklist items that the given team has passed.
+func (web *Web) inspectionPostHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	teamId, _ := strconv.Atoi(r.PostFormValue("teamId"))
+	team, err := web.arena.Database.GetTeamById(teamId)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	if team == nil {
+		web.renderInspection(w, r, fmt.Sprintf("Team %d is not registered for this event.", teamId))
+		return
+	}
+
+	// Only keep the items that are on the current checklist.
+	checklistItems := web.arena.EventSettings.InspectionChecklistItems()
+	var passedItems []string
+	for _, item := range r.PostForm["passedItems"] {
+		if slices.Contains(checklistItems, item) {
+			passedItems = append(passedItems, item)
+		}
+	}
+	inspection := model.TeamInspection{
+		Id:          teamId,
+		PassedItems: passedItems,
+		Inspector:   strings.TrimSpace(r.PostFormValue("inspector")),
+		Notes:       strings.TrimSpace(r.PostFormValue("notes")),
+		UpdatedAt:   time.Now(),
+	}
+	existingInspection, err := web.arena.Database.GetTeamInspectionById(teamId)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	if existingInspection == nil {
+		err = web.arena.Database.CreateTeamInspection(&inspection)
+	} else {
+		err = web.arena.Database.UpdateTeamInspection(&inspection)
+	}
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	// Apply the change to the match start gating of the running arenas.
+	if err = web.arena.ForEachField((*field.Arena).LoadInspections); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/setup/inspection#team%d", teamId), 303)
+}
+
+func (web *Web) renderInspection(w http.ResponseWriter, r *http.Request, errorMessage string) {
+	inspectionStatuses, err := web.getTeamInspectionStatuses()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	numPassed := 0
+	for _, status := range inspectionStatuses {
+		if status.IsPassed {
+			numPassed++
+		}
+	}
+
+	template, err := web.parseFiles("templates/setup_inspection.html", "templates/base.html")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	data := struct {
+		*model.EventSettings
+		ChecklistItems     []string
+		InspectionStatuses []teamInspectionStatus
+		NumPassed          int
+		ErrorMessage       string
+	}{
+		web.arena.EventSettings,
+		web.arena.EventSettings.InspectionChecklistItems(),
+		inspectionStatuses,
+		numPassed,
+		errorMessage,
+	}
+	err = template.ExecuteTemplate(w, "base", data)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+}
+
+// Returns the inspection progress of every team at the event, sorted by team number.
+func (web *Web) getTeamInspectionStatuses() ([]teamInspectionStatus, error) {
+	teams, err := web.arena.Database.GetAllTeams()
+	if err != nil {
+		return nil, err
+	}
+	inspections, err := web.arena.Database.GetAllTeamInspections()
+	if err != nil {
+		return nil, err
+	}
+	inspectionsByTeam := make(map[int]model.TeamInspection)
+	for _, inspection := range inspections {
+		inspectionsByTeam[inspection.Id] = inspection
+	}
+
+	checklistItems := web.arena.EventSettings.InspectionChecklistItems()
+	inspectionStatuses := make([]teamInspectionStatus, len(teams))
+	for i, team := range teams {
+		inspection, ok := inspectionsByTeam[team.Id]
+		if !ok {
+			inspection = model.TeamInspection{Id: team.Id}
+		}
+		passedItems := make(map[string]bool)
+		for _, item := range inspection.PassedItems {
+			passedItems[item] = true
+		}
+		inspectionStatuses[i] = teamInspectionStatus{
+			Team:         team,
+			Inspection:   inspection,
+			PassedItems:  passedItems,
+			MissingItems: inspection.MissingItems(checklistItems),
+			IsPassed:     inspection.IsPassed(checklistItems),
+		}
+	}
+	return inspectionStatuses, nil
+}
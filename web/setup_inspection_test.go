@@ -0,0 +1,45 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package web
+
+import (
+	"testing"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetupInspection(t *testing.T) {
+	web := setupTestWeb(t)
+	web.arena.EventSettings.InspectionRequired = true
+	web.arena.EventSettings.InspectionChecklist = "Weight\nBumpers"
+	web.arena.Database.CreateTeam(&model.Team{Id: 254})
+	web.arena.Database.CreateTeam(&model.Team{Id: 1114})
+
+	recorder := web.getHttpResponse("/setup/inspection")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Robot Inspection (0 of 2 teams passed)")
+	assert.Contains(t, recorder.Body.String(), "2 remaining")
+
+	// Check that only the items on the checklist are saved and that the arena picks up the change.
+	recorder = web.postHttpResponse(
+		"/setup/inspection", "teamId=254&passedItems=Weight&passedItems=Paint&inspector=Bob&notes=Heavy",
+	)
+	assert.Equal(t, 303, recorder.Code)
+	inspection, _ := web.arena.Database.GetTeamInspectionById(254)
+	if assert.NotNil(t, inspection) {
+		assert.Equal(t, []string{"Weight"}, inspection.PassedItems)
+		assert.Equal(t, "Bob", inspection.Inspector)
+		assert.Equal(t, "Heavy", inspection.Notes)
+	}
+	assert.False(t, web.arena.IsTeamInspectionPassed(254))
+	recorder = web.postHttpResponse("/setup/inspection", "teamId=254&passedItems=Weight&passedItems=Bumpers")
+	assert.Equal(t, 303, recorder.Code)
+	assert.True(t, web.arena.IsTeamInspectionPassed(254))
+	recorder = web.getHttpResponse("/setup/inspection")
+	assert.Contains(t, recorder.Body.String(), "Robot Inspection (1 of 2 teams passed)")
+
+	recorder = web.postHttpResponse("/setup/inspection", "teamId=9999&passedItems=Weight")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Team 9999 is not registered for this event.")
+}
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"sort"
 	"strconv"
+	"strings"
 )
 
 var judgingScheduleParams = tournament.JudgingScheduleParams{
@@ -102,6 +103,110 @@ func (web *Web) judgingClearPostHandler(w http.ResponseWriter, r *http.Request)
 	http.Redirect(w, r, "/setup/judging", 303)
 }
 
+// Adds a judging panel with the given name, room, award category and availability window.
+func (web *Web) judgingPanelPostHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	panel := model.JudgingPanel{
+		Name:          strings.TrimSpace(r.PostFormValue("name")),
+		Room:          strings.TrimSpace(r.PostFormValue("room")),
+		AwardCategory: strings.TrimSpace(r.PostFormValue("awardCategory")),
+	}
+	if panel.Name == "" {
+		web.renderJudging(w, r, "Judging panel name must not be blank.")
+		return
+	}
+	var err error
+	if panel.AvailableFrom, err = parseAvailabilityTime(r.PostFormValue("availableFrom")); err != nil {
+		web.renderJudging(w, r, err.Error())
+		return
+	}
+	if panel.AvailableUntil, err = parseAvailabilityTime(r.PostFormValue("availableUntil")); err != nil {
+		web.renderJudging(w, r, err.Error())
+		return
+	}
+	if !panel.AvailableFrom.IsZero() && !panel.AvailableUntil.IsZero() &&
+		!panel.AvailableUntil.After(panel.AvailableFrom) {
+		web.renderJudging(w, r, "Judging panel must be available until a time later than it is available from.")
+		return
+	}
+	if err = web.arena.Database.CreateJudgingPanel(&panel); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/setup/judging", 303)
+}
+
+// Deletes the given judging panel.
+func (web *Web) judgingPanelDeletePostHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	panelId, _ := strconv.Atoi(r.PathValue("id"))
+	if err := web.arena.Database.DeleteJudgingPanel(panelId); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/setup/judging", 303)
+}
+
+// Adds a window of time during which the given team cannot be judged.
+func (web *Web) judgingBlackoutPostHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	teamId, _ := strconv.Atoi(r.PostFormValue("teamId"))
+	team, err := web.arena.Database.GetTeamById(teamId)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	if team == nil {
+		web.renderJudging(w, r, fmt.Sprintf("Team %d is not present at the event.", teamId))
+		return
+	}
+	blackout := model.JudgingBlackout{TeamId: teamId, Reason: strings.TrimSpace(r.PostFormValue("reason"))}
+	if blackout.StartTime, err = parseAvailabilityTime(r.PostFormValue("startTime")); err != nil {
+		web.renderJudging(w, r, err.Error())
+		return
+	}
+	if blackout.EndTime, err = parseAvailabilityTime(r.PostFormValue("endTime")); err != nil {
+		web.renderJudging(w, r, err.Error())
+		return
+	}
+	if blackout.StartTime.IsZero() || !blackout.EndTime.After(blackout.StartTime) {
+		web.renderJudging(w, r, "Blackout must have a start time and an end time later than it.")
+		return
+	}
+	if err = web.arena.Database.CreateJudgingBlackout(&blackout); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/setup/judging", 303)
+}
+
+// Deletes the given judging blackout.
+func (web *Web) judgingBlackoutDeletePostHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	blackoutId, _ := strconv.Atoi(r.PathValue("id"))
+	if err := web.arena.Database.DeleteJudgingBlackout(blackoutId); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/setup/judging", 303)
+}
+
 // Renders the judging setup page with an optional error message.
 func (web *Web) renderJudging(w http.ResponseWriter, r *http.Request, errorMessage string) {
 	slots, err := web.arena.Database.GetAllJudgingSlots()
@@ -121,6 +226,17 @@ func (web *Web) renderJudging(w http.ResponseWriter, r *http.Request, errorMessa
 		},
 	)
 
+	panels, err := web.arena.Database.GetAllJudgingPanels()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	blackouts, err := web.arena.Database.GetAllJudgingBlackouts()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
 	template, err := web.parseFiles("templates/setup_judging.html", "templates/base.html")
 	if err != nil {
 		handleWebErr(w, err)
@@ -129,9 +245,11 @@ func (web *Web) renderJudging(w http.ResponseWriter, r *http.Request, errorMessa
 	data := struct {
 		*model.EventSettings
 		JudgingScheduleParams tournament.JudgingScheduleParams
+		JudgingPanels         []model.JudgingPanel
+		JudgingBlackouts      []model.JudgingBlackout
 		JudgingSlots          []model.JudgingSlot
 		ErrorMessage          string
-	}{web.arena.EventSettings, judgingScheduleParams, slots, errorMessage}
+	}{web.arena.EventSettings, judgingScheduleParams, panels, blackouts, slots, errorMessage}
 	err = template.ExecuteTemplate(w, "base", data)
 	if err != nil {
 		handleWebErr(w, err)
@@ -4,6 +4,7 @@
 package web
 
 import (
+	"fmt"
 	"github.com/Team254/cheesy-arena/model"
 	"github.com/stretchr/testify/assert"
 	"net/url"
@@ -114,3 +115,71 @@ func TestSetupJudging(t *testing.T) {
 	assert.Equal(t, 200, recorder.Code)
 	assert.Contains(t, recorder.Body.String(), "No qualification matches found")
 }
+
+func TestSetupJudgingPanelsAndBlackouts(t *testing.T) {
+	web := setupTestWeb(t)
+
+	assert.Nil(t, web.arena.Database.CreateTeam(&model.Team{Id: 254}))
+
+	// Add and delete judging panels.
+	recorder := web.postHttpResponse("/setup/judging/panels", "name=&room=Room+1")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Judging panel name must not be blank.")
+	recorder = web.postHttpResponse(
+		"/setup/judging/panels",
+		"name=Impact+Panel&room=Room+1&awardCategory=Impact&availableFrom=2025-04-01+09:00:00+AM&"+
+			"availableUntil=2025-04-01+08:00:00+AM",
+	)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "must be available until a time later than it is available from")
+	recorder = web.postHttpResponse(
+		"/setup/judging/panels",
+		"name=Impact+Panel&room=Room+1&awardCategory=Impact&availableFrom=2025-04-01+09:00:00+AM&"+
+			"availableUntil=2025-04-01+05:00:00+PM",
+	)
+	assert.Equal(t, 303, recorder.Code)
+	recorder = web.postHttpResponse("/setup/judging/panels", "name=Panel+B")
+	assert.Equal(t, 303, recorder.Code)
+	panels, err := web.arena.Database.GetAllJudgingPanels()
+	assert.Nil(t, err)
+	if assert.Equal(t, 2, len(panels)) {
+		assert.Equal(t, "Impact Panel", panels[0].Name)
+		assert.Equal(t, "Room 1", panels[0].Room)
+		assert.Equal(t, "Impact", panels[0].AwardCategory)
+		assert.Equal(t, 17, panels[0].AvailableUntil.Hour())
+		assert.True(t, panels[1].AvailableFrom.IsZero())
+	}
+	recorder = web.getHttpResponse("/setup/judging")
+	assert.Contains(t, recorder.Body.String(), "Impact Panel")
+	recorder = web.postHttpResponse(fmt.Sprintf("/setup/judging/panels/%d/delete", panels[1].Id), "")
+	assert.Equal(t, 303, recorder.Code)
+	panels, _ = web.arena.Database.GetAllJudgingPanels()
+	assert.Equal(t, 1, len(panels))
+
+	// Add and delete team blackouts.
+	recorder = web.postHttpResponse(
+		"/setup/judging/blackouts", "teamId=1114&startTime=2025-04-01+09:00:00+AM&endTime=2025-04-01+10:00:00+AM",
+	)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Team 1114 is not present at the event.")
+	recorder = web.postHttpResponse("/setup/judging/blackouts", "teamId=254&startTime=2025-04-01+09:00:00+AM")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Blackout must have a start time and an end time later than it.")
+	recorder = web.postHttpResponse(
+		"/setup/judging/blackouts",
+		"teamId=254&startTime=2025-04-01+09:00:00+AM&endTime=2025-04-01+10:00:00+AM&reason=Volunteering",
+	)
+	assert.Equal(t, 303, recorder.Code)
+	blackouts, err := web.arena.Database.GetAllJudgingBlackouts()
+	assert.Nil(t, err)
+	if assert.Equal(t, 1, len(blackouts)) {
+		assert.Equal(t, 254, blackouts[0].TeamId)
+		assert.Equal(t, "Volunteering", blackouts[0].Reason)
+	}
+	recorder = web.getHttpResponse("/setup/judging")
+	assert.Contains(t, recorder.Body.String(), "Volunteering")
+	recorder = web.postHttpResponse(fmt.Sprintf("/setup/judging/blackouts/%d/delete", blackouts[0].Id), "")
+	assert.Equal(t, 303, recorder.Code)
+	blackouts, _ = web.arena.Database.GetAllJudgingBlackouts()
+	assert.Empty(t, blackouts)
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+//
+// Web routes for managing the open practice match queue.
+
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Team254/cheesy-arena/field"
+	"github.com/Team254/cheesy-arena/model"
+)
+
+// Shows the practice queue management page.
+func (web *Web) practiceQueueGetHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	web.renderPracticeQueue(w, r, "")
+}
+
+// Adds a team to the back of the practice queue on its behalf.
+func (web *Web) practiceQueueJoinPostHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	teamId, _ := strconv.Atoi(r.PostFormValue("teamId"))
+	if err := web.arena.JoinPracticeQueue(teamId); err != nil {
+		web.renderPracticeQueue(w, r, err.Error())
+		return
+	}
+	http.Redirect(w, r, "/setup/practice_queue", 303)
+}
+
+// Removes a team from the practice queue.
+func (web *Web) practiceQueueLeavePostHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	teamId, _ := strconv.Atoi(r.PostFormValue("teamId"))
+	if err := web.arena.LeavePracticeQueue(teamId); err != nil {
+		web.renderPracticeQueue(w, r, err.Error())
+		return
+	}
+	http.Redirect(w, r, "/setup/practice_queue", 303)
+}
+
+// Removes all teams from the practice queue.
+func (web *Web) practiceQueueClearPostHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	if err := web.arena.ClearPracticeQueue(); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	http.Redirect(w, r, "/setup/practice_queue", 303)
+}
+
+// Creates and loads a practice match from the front of the queue.
+func (web *Web) practiceQueueLoadNextPostHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
+	}
+
+	if err := web.arena.LoadNextQueuedPracticeMatch(); err != nil {
+		web.renderPracticeQueue(w, r, err.Error())
+		return
+	}
+	http.Redirect(w, r, "/match_play", 303)
+}
+
+func (web *Web) renderPracticeQueue(w http.ResponseWriter, r *http.Request, errorMessage string) {
+	queue, err := web.arena.GetPracticeQueue()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	template, err := web.parseFiles("templates/setup_practice_queue.html", "templates/base.html")
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	data := struct {
+		*model.EventSettings
+		Queue        []field.PracticeQueueTeam
+		ErrorMessage string
+	}{web.arena.EventSettings, queue, errorMessage}
+	err = template.ExecuteTemplate(w, "base", data)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Team 254. All Rights Reserved.
+
+package web
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Team254/cheesy-arena/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetupPracticeQueue(t *testing.T) {
+	web := setupTestWeb(t)
+	for i := 101; i <= 107; i++ {
+		web.arena.Database.CreateTeam(&model.Team{Id: i})
+	}
+
+	recorder := web.postHttpResponse("/setup/practice_queue/join", "teamId=101")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "The open practice queue is not enabled.")
+	recorder = web.postHttpResponse("/displays/queueing/practice_queue/join", "teamId=101")
+	assert.Equal(t, 403, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "The open practice queue is not enabled.")
+	recorder = web.postHttpResponse("/setup/practice_queue/load_next", "")
+	assert.Contains(t, recorder.Body.String(), "The open practice queue is not enabled.")
+	queue, _ := web.arena.GetPracticeQueue()
+	assert.Empty(t, queue)
+
+	web.arena.EventSettings.PracticeOpenQueueEnabled = true
+	for i := 101; i <= 106; i++ {
+		recorder = web.postHttpResponse("/setup/practice_queue/join", fmt.Sprintf("teamId=%d", i))
+		assert.Equal(t, 303, recorder.Code)
+	}
+	recorder = web.postHttpResponse("/displays/queueing/practice_queue/join", "teamId=107")
+	assert.Equal(t, 200, recorder.Code)
+	recorder = web.postHttpResponse("/displays/queueing/practice_queue/join", "teamId=107")
+	assert.Equal(t, 400, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Team 107 is already in the practice queue.")
+	recorder = web.getHttpResponse("/setup/practice_queue")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "107")
+	recorder = web.getHttpResponse("/displays/queueing/match_load")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Practice Queue")
+
+	recorder = web.postHttpResponse("/setup/practice_queue/leave", "teamId=103")
+	assert.Equal(t, 303, recorder.Code)
+	recorder = web.postHttpResponse("/setup/practice_queue/leave", "teamId=103")
+	assert.Contains(t, recorder.Body.String(), "Team 103 is not in the practice queue.")
+
+	recorder = web.postHttpResponse("/setup/practice_queue/load_next", "")
+	assert.Equal(t, 303, recorder.Code)
+	assert.Equal(t, "P1", web.arena.CurrentMatch.ShortName)
+	assert.Equal(t, 107, web.arena.CurrentMatch.Blue3)
+
+	recorder = web.postHttpResponse("/setup/practice_queue/load_next", "")
+	assert.Contains(t, recorder.Body.String(), "There are no teams in the practice queue.")
+
+	web.postHttpResponse("/setup/practice_queue/join", "teamId=103")
+	recorder = web.postHttpResponse("/setup/practice_queue/clear", "")
+	assert.Equal(t, 303, recorder.Code)
+	queue, _ = web.arena.GetPracticeQueue()
+	assert.Empty(t, queue)
+}
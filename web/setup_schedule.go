@@ -11,12 +11,19 @@ import (
 	"github.com/Team254/cheesy-arena/tournament"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // Global vars to hold schedules that are in the process of being generated.
 var cachedMatches = make(map[model.MatchType][]model.Match)
 var cachedTeamFirstMatches = make(map[model.MatchType]map[int]string)
+var cachedScheduleQualities = make(map[model.MatchType]*tournament.ScheduleQuality)
+var cachedScheduledBreaks = make(map[model.MatchType][]model.ScheduledBreak)
+
+// Global var to hold the number of existing matches to keep when the cached schedule replaces only the unplayed part of
+// an existing one; absent for a match type when the cached schedule is a whole new one.
+var cachedRescheduleKeptMatches = make(map[model.MatchType]int)
 
 // Shows the schedule editing page.
 func (web *Web) scheduleGetHandler(w http.ResponseWriter, r *http.Request) {
@@ -81,39 +88,107 @@ func (web *Web) scheduleGeneratePostHandler(w http.ResponseWriter, r *http.Reque
 		)
 		return
 	}
-	if len(teams) < 6 {
+	teamsPerAlliance := web.arena.EventSettings.TeamsPerAlliance
+	if len(teams) < 2*teamsPerAlliance {
 		web.renderSchedule(
 			w,
 			r,
-			fmt.Sprintf("There are only %d teams. There must be at least 6 teams to generate a schedule.", len(teams)),
+			fmt.Sprintf(
+				"There are only %d teams. There must be at least %d teams to generate a schedule.",
+				len(teams),
+				2*teamsPerAlliance,
+			),
 		)
 		return
 	}
 
-	matches, err := tournament.BuildRandomSchedule(teams, scheduleBlocks, matchType)
+	scheduledBreaks, err := tournament.BuildScheduledBreaks(scheduleBlocks, matchType)
+	if err != nil {
+		web.renderSchedule(w, r, fmt.Sprintf("Error generating schedule: %s.", err.Error()))
+		return
+	}
+	var matches []model.Match
+	if r.PostFormValue("generator") == "native" {
+		matches, err = tournament.BuildGeneratedSchedule(teams, scheduleBlocks, matchType, teamsPerAlliance)
+	} else {
+		matches, err = tournament.BuildRandomSchedule(teams, scheduleBlocks, matchType, teamsPerAlliance)
+	}
 	if err != nil {
 		web.renderSchedule(w, r, fmt.Sprintf("Error generating schedule: %s.", err.Error()))
 		return
 	}
+	tournament.AssignMatchFields(matches, web.arena.EventSettings.NumFields)
 	cachedMatches[matchType] = matches
+	scheduleQuality := tournament.EvaluateSchedule(matches, web.arena.EventSettings.TeamsPerAlliance)
+	cachedScheduleQualities[matchType] = &scheduleQuality
+	cachedTeamFirstMatches[matchType] = getTeamFirstMatches(matches)
+	cachedScheduledBreaks[matchType] = scheduledBreaks
+	delete(cachedRescheduleKeptMatches, matchType)
 
-	// Determine each team's first match.
-	teamFirstMatches := make(map[int]string)
-	for _, match := range matches {
-		checkTeam := func(team int) {
-			_, ok := teamFirstMatches[team]
-			if !ok {
-				teamFirstMatches[team] = match.ShortName
-			}
-		}
-		checkTeam(match.Red1)
-		checkTeam(match.Red2)
-		checkTeam(match.Red3)
-		checkTeam(match.Blue1)
-		checkTeam(match.Blue2)
-		checkTeam(match.Blue3)
+	http.Redirect(w, r, "/setup/schedule?matchType="+matchTypeString, 303)
+}
+
+// Regenerates the unplayed part of the existing schedule for the current team list and presents it for review without
+// saving it, keeping the existing schedule blocks.
+func (web *Web) scheduleReschedulePostHandler(w http.ResponseWriter, r *http.Request) {
+	if !web.userIsAdmin(w, r) {
+		return
 	}
-	cachedTeamFirstMatches[matchType] = teamFirstMatches
+
+	matchTypeString := getMatchType(r)
+	matchType, err := model.MatchTypeFromString(matchTypeString)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	existingMatches, err := web.arena.Database.GetMatchesByType(matchType, true)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	if len(existingMatches) == 0 {
+		web.renderSchedule(
+			w,
+			r,
+			fmt.Sprintf(
+				"There is no existing %s schedule to reschedule. Generate one instead.",
+				strings.ToLower(matchType.String()),
+			),
+		)
+		return
+	}
+	scheduleBlocks, err := web.arena.Database.GetScheduleBlocksByMatchType(matchType)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	teams, err := web.arena.Database.GetAllTeams()
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	scheduledBreaks, err := tournament.BuildScheduledBreaks(scheduleBlocks, matchType)
+	if err != nil {
+		web.renderSchedule(w, r, fmt.Sprintf("Error rescheduling remaining matches: %s.", err.Error()))
+		return
+	}
+	keptMatches, newMatches, err := tournament.BuildRemainingSchedule(
+		teams, scheduleBlocks, existingMatches, matchType, web.arena.EventSettings.TeamsPerAlliance,
+	)
+	if err != nil {
+		web.renderSchedule(w, r, fmt.Sprintf("Error rescheduling remaining matches: %s.", err.Error()))
+		return
+	}
+	matches := append(keptMatches, newMatches...)
+	tournament.AssignMatchFields(matches, web.arena.EventSettings.NumFields)
+	cachedMatches[matchType] = matches
+	scheduleQuality := tournament.EvaluateSchedule(matches, web.arena.EventSettings.TeamsPerAlliance)
+	cachedScheduleQualities[matchType] = &scheduleQuality
+	cachedTeamFirstMatches[matchType] = getTeamFirstMatches(newMatches)
+	cachedScheduledBreaks[matchType] = scheduledBreaks
+	cachedRescheduleKeptMatches[matchType] = len(keptMatches)
 
 	http.Redirect(w, r, "/setup/schedule?matchType="+matchTypeString, 303)
 }
@@ -136,6 +211,10 @@ func (web *Web) scheduleSavePostHandler(w http.ResponseWriter, r *http.Request)
 		handleWebErr(w, err)
 		return
 	}
+	if numKeptMatches, ok := cachedRescheduleKeptMatches[matchType]; ok {
+		web.saveRescheduledMatches(w, r, matchType, existingMatches, numKeptMatches)
+		return
+	}
 	if len(existingMatches) > 0 {
 		web.renderSchedule(
 			w,
@@ -157,6 +236,10 @@ func (web *Web) scheduleSavePostHandler(w http.ResponseWriter, r *http.Request)
 			return
 		}
 	}
+	if err = web.saveScheduledBreaks(matchType); err != nil {
+		handleWebErr(w, err)
+		return
+	}
 
 	// Back up the database.
 	err = web.arena.Database.Backup(web.arena.EventSettings.Name, "post_scheduling")
@@ -168,6 +251,85 @@ func (web *Web) scheduleSavePostHandler(w http.ResponseWriter, r *http.Request)
 	http.Redirect(w, r, "/setup/schedule?matchType="+matchTypeString, 303)
 }
 
+// Replaces the unplayed part of the existing schedule with the cached rescheduled matches.
+func (web *Web) saveRescheduledMatches(
+	w http.ResponseWriter,
+	r *http.Request,
+	matchType model.MatchType,
+	existingMatches []model.Match,
+	numKeptMatches int,
+) {
+	// Make sure that no further matches have been played or otherwise changed since the rescheduling was previewed.
+	rescheduledMatches := cachedMatches[matchType]
+	isStale := len(existingMatches) < numKeptMatches || len(rescheduledMatches) < numKeptMatches
+	for i := 0; !isStale && i < len(existingMatches); i++ {
+		if i < numKeptMatches {
+			isStale = existingMatches[i].Id != rescheduledMatches[i].Id
+		} else {
+			isStale = existingMatches[i].IsComplete()
+		}
+	}
+	if isStale {
+		web.renderSchedule(
+			w,
+			r,
+			"Can't save the rescheduled matches because the existing schedule has changed since they were generated. "+
+				"Reschedule the remaining matches again.",
+		)
+		return
+	}
+
+	// Unload any match being replaced from whichever field has it first, so that nothing is deleted if that isn't
+	// possible.
+	var replacedMatchIds []int
+	for _, match := range existingMatches[numKeptMatches:] {
+		replacedMatchIds = append(replacedMatchIds, match.Id)
+	}
+	if err := web.arena.UnloadMatchesFromAllFields(replacedMatchIds); err != nil {
+		web.renderSchedule(w, r, fmt.Sprintf("Can't save the rescheduled matches: %s", err.Error()))
+		return
+	}
+
+	for _, match := range existingMatches[numKeptMatches:] {
+		if err := web.deleteMatchAndResults(match.Id); err != nil {
+			handleWebErr(w, err)
+			return
+		}
+	}
+	for _, match := range rescheduledMatches[numKeptMatches:] {
+		if err := web.arena.Database.CreateMatch(&match); err != nil {
+			handleWebErr(w, err)
+			return
+		}
+	}
+	if err := web.saveScheduledBreaks(matchType); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	delete(cachedRescheduleKeptMatches, matchType)
+
+	// Back up the database.
+	if err := web.arena.Database.Backup(web.arena.EventSettings.Name, "post_rescheduling"); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/setup/schedule?matchType="+getMatchType(r), 303)
+}
+
+// Replaces the saved breaks for the given match type with the ones derived from the cached schedule's blocks.
+func (web *Web) saveScheduledBreaks(matchType model.MatchType) error {
+	if err := web.arena.Database.DeleteScheduledBreaksByMatchType(matchType); err != nil {
+		return err
+	}
+	for _, scheduledBreak := range cachedScheduledBreaks[matchType] {
+		if err := web.arena.Database.CreateScheduledBreak(&scheduledBreak); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (web *Web) renderSchedule(w http.ResponseWriter, r *http.Request, errorMessage string) {
 	matchTypeString := getMatchType(r)
 	matchType, err := model.MatchTypeFromString(matchTypeString)
@@ -187,11 +349,29 @@ func (web *Web) renderSchedule(w http.ResponseWriter, r *http.Request, errorMess
 		handleWebErr(w, err)
 		return
 	}
+	existingMatches, err := web.arena.Database.GetMatchesByType(matchType, true)
+	if err != nil {
+		handleWebErr(w, err)
+		return
+	}
 	template, err := web.parseFiles("templates/setup_schedule.html", "templates/base.html")
 	if err != nil {
 		handleWebErr(w, err)
 		return
 	}
+	numTeamScheduleWarnings := 0
+	for _, stats := range tournament.BuildTeamScheduleStats(
+		cachedMatches[matchType], web.arena.EventSettings.TeamsPerAlliance, tournament.DefaultScheduleWarningThresholds,
+	) {
+		if len(stats.Warnings) > 0 {
+			numTeamScheduleWarnings++
+		}
+	}
+	numKeptMatches, isReschedule := cachedRescheduleKeptMatches[matchType]
+	breaksBefore := make(map[int]model.ScheduledBreak)
+	for _, scheduledBreak := range cachedScheduledBreaks[matchType] {
+		breaksBefore[scheduledBreak.TypeOrderBefore] = scheduledBreak
+	}
 	data := struct {
 		*model.EventSettings
 		MatchType        model.MatchType
@@ -199,6 +379,12 @@ func (web *Web) renderSchedule(w http.ResponseWriter, r *http.Request, errorMess
 		NumTeams         int
 		Matches          []model.Match
 		TeamFirstMatches map[int]string
+		BreaksBefore     map[int]model.ScheduledBreak
+		ScheduleQuality  *tournament.ScheduleQuality
+		NumTeamWarnings  int
+		NumExisting      int
+		NumKeptMatches   int
+		IsReschedule     bool
 		ErrorMessage     string
 	}{
 		web.arena.EventSettings,
@@ -207,6 +393,12 @@ func (web *Web) renderSchedule(w http.ResponseWriter, r *http.Request, errorMess
 		len(teams),
 		cachedMatches[matchType],
 		cachedTeamFirstMatches[matchType],
+		breaksBefore,
+		cachedScheduleQualities[matchType],
+		numTeamScheduleWarnings,
+		len(existingMatches),
+		numKeptMatches,
+		isReschedule,
 		errorMessage,
 	}
 	err = template.ExecuteTemplate(w, "base", data)
@@ -216,6 +408,19 @@ func (web *Web) renderSchedule(w http.ResponseWriter, r *http.Request, errorMess
 	}
 }
 
+// Returns the short name of the first of the given matches in which each team plays.
+func getTeamFirstMatches(matches []model.Match) map[int]string {
+	teamFirstMatches := make(map[int]string)
+	for _, match := range matches {
+		for _, team := range match.TeamIds() {
+			if _, ok := teamFirstMatches[team]; !ok && team != 0 {
+				teamFirstMatches[team] = match.ShortName
+			}
+		}
+	}
+	return teamFirstMatches
+}
+
 // Converts the post form variables into a slice of schedule blocks.
 func getScheduleBlocks(r *http.Request) ([]model.ScheduleBlock, error) {
 	numScheduleBlocks, err := strconv.Atoi(r.PostFormValue("numScheduleBlocks"))
@@ -240,6 +445,13 @@ func getScheduleBlocks(r *http.Request) ([]model.ScheduleBlock, error) {
 		if err != nil {
 			returnErr = err
 		}
+		if dayIndex := r.PostFormValue(fmt.Sprintf("dayIndex%d", i)); dayIndex != "" {
+			scheduleBlocks[i].DayIndex, err = strconv.Atoi(dayIndex)
+			if err != nil || scheduleBlocks[i].DayIndex < 0 {
+				returnErr = fmt.Errorf("invalid day index: %s", dayIndex)
+			}
+		}
+		scheduleBlocks[i].BreakDescription = strings.TrimSpace(r.PostFormValue(fmt.Sprintf("breakDescription%d", i)))
 	}
 	return scheduleBlocks, returnErr
 }
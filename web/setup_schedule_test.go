@@ -4,6 +4,8 @@
 package web
 
 import (
+	"github.com/Team254/cheesy-arena/field"
+	"github.com/Team254/cheesy-arena/game"
 	"github.com/Team254/cheesy-arena/model"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -46,6 +48,92 @@ func TestSetupSchedule(t *testing.T) {
 	assert.Equal(t, time.Date(2014, 1, 3, 13, 0, 0, 0, location).Unix(), matches[24].Time.Unix())
 }
 
+func TestSetupScheduleMultipleFields(t *testing.T) {
+	web := setupTestWeb(t)
+	web.arena.EventSettings.NumFields = 2
+
+	for i := 0; i < 18; i++ {
+		web.arena.Database.CreateTeam(&model.Team{Id: i + 101})
+	}
+	postData := "numScheduleBlocks=1&startTime0=2014-01-01 09:00:00 AM&numMatches0=6&matchSpacingSec0=480&" +
+		"matchType=qualification"
+	recorder := web.postHttpResponse("/setup/schedule/generate", postData)
+	assert.Equal(t, 303, recorder.Code)
+	recorder = web.getHttpResponse("/setup/schedule?matchType=qualification")
+	assert.Contains(t, recorder.Body.String(), "<th>Field</th>")
+
+	recorder = web.postHttpResponse("/setup/schedule/save?matchType=qualification", "")
+	assert.Equal(t, 303, recorder.Code)
+	matches, err := web.arena.Database.GetMatchesByType(model.Qualification, true)
+	assert.Nil(t, err)
+	if assert.Equal(t, 6, len(matches)) {
+		for i, match := range matches {
+			assert.Equal(t, i%2+1, match.FieldNumber)
+		}
+	}
+}
+
+func TestSetupScheduleBreaks(t *testing.T) {
+	web := setupTestWeb(t)
+
+	for i := 0; i < 18; i++ {
+		web.arena.Database.CreateTeam(&model.Team{Id: i + 101})
+	}
+	postData := "numScheduleBlocks=3&startTime0=2014-01-01 09:00:00 AM&numMatches0=6&matchSpacingSec0=480&" +
+		"dayIndex0=0&startTime1=2014-01-01 01:00:00 PM&numMatches1=6&matchSpacingSec1=480&dayIndex1=0&" +
+		"breakDescription1=Lunch&startTime2=2014-01-02 09:00:00 AM&numMatches2=6&matchSpacingSec2=480&" +
+		"dayIndex2=1&matchType=qualification"
+	recorder := web.postHttpResponse("/setup/schedule/generate", postData)
+	assert.Equal(t, 303, recorder.Code)
+	recorder = web.getHttpResponse("/setup/schedule?matchType=qualification")
+	assert.Contains(t, recorder.Body.String(), "<td>Lunch</td>")
+	assert.Contains(t, recorder.Body.String(), "<td>End of Day 1</td>")
+	blocks, _ := web.arena.Database.GetScheduleBlocksByMatchType(model.Qualification)
+	if assert.Equal(t, 3, len(blocks)) {
+		assert.Equal(t, "Lunch", blocks[1].BreakDescription)
+		assert.Equal(t, 1, blocks[2].DayIndex)
+	}
+
+	// Save the schedule and check that the breaks were persisted.
+	recorder = web.postHttpResponse("/setup/schedule/save?matchType=qualification", "")
+	assert.Equal(t, 303, recorder.Code)
+	scheduledBreaks, err := web.arena.Database.GetScheduledBreaksByMatchType(model.Qualification)
+	assert.Nil(t, err)
+	if assert.Equal(t, 2, len(scheduledBreaks)) {
+		assert.Equal(t, 7, scheduledBreaks[0].TypeOrderBefore)
+		assert.Equal(t, "Lunch", scheduledBreaks[0].Description)
+		assert.Equal(t, 11520, scheduledBreaks[0].DurationSec)
+		assert.Equal(t, 13, scheduledBreaks[1].TypeOrderBefore)
+		assert.Equal(t, "End of Day 1", scheduledBreaks[1].Description)
+	}
+	recorder = web.getHttpResponse("/setup/breaks")
+	assert.Contains(t, recorder.Body.String(), "End of Day 1")
+
+	// Check that blocks out of day order are rejected.
+	postData = "numScheduleBlocks=2&startTime0=2014-01-01 09:00:00 AM&numMatches0=6&matchSpacingSec0=480&" +
+		"dayIndex0=1&startTime1=2014-01-01 01:00:00 PM&numMatches1=6&matchSpacingSec1=480&dayIndex1=0&" +
+		"matchType=qualification"
+	recorder = web.postHttpResponse("/setup/schedule/generate", postData)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "block 2 is on day 1 but comes after a block on day 2")
+}
+
+func TestSetupScheduleNativeGenerator(t *testing.T) {
+	web := setupTestWeb(t)
+
+	for i := 0; i < 18; i++ {
+		web.arena.Database.CreateTeam(&model.Team{Id: i + 101})
+	}
+	postData := "numScheduleBlocks=1&startTime0=2014-01-01 09:00:00 AM&numMatches0=18&matchSpacingSec0=480&" +
+		"matchType=qualification&generator=native"
+	recorder := web.postHttpResponse("/setup/schedule/generate", postData)
+	assert.Equal(t, 303, recorder.Code)
+	recorder = web.getHttpResponse("/setup/schedule?matchType=qualification")
+	assert.Contains(t, recorder.Body.String(), "Schedule Quality")
+	assert.Contains(t, recorder.Body.String(), "<td>Matches per team</td><td>6</td>")
+	assert.Contains(t, recorder.Body.String(), "2014-01-01 11:16:00") // Last match.
+}
+
 func TestSetupScheduleErrors(t *testing.T) {
 	web := setupTestWeb(t)
 
@@ -95,3 +183,76 @@ func TestSetupScheduleErrors(t *testing.T) {
 	assert.Equal(t, 200, recorder.Code)
 	assert.Contains(t, recorder.Body.String(), "schedule of 2 Practice matches already exists")
 }
+
+func TestSetupScheduleReschedule(t *testing.T) {
+	web := setupTestWeb(t)
+
+	recorder := web.postHttpResponse("/setup/schedule/reschedule?matchType=qualification", "")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "There is no existing qualification schedule to reschedule.")
+
+	for i := 0; i < 18; i++ {
+		web.arena.Database.CreateTeam(&model.Team{Id: i + 101})
+	}
+	postData := "numScheduleBlocks=1&startTime0=2014-01-01 09:00:00 AM&numMatches0=36&matchSpacingSec0=480&" +
+		"matchType=qualification"
+	recorder = web.postHttpResponse("/setup/schedule/generate", postData)
+	assert.Equal(t, 303, recorder.Code)
+	recorder = web.postHttpResponse("/setup/schedule/save?matchType=qualification", "")
+	assert.Equal(t, 303, recorder.Code)
+	matches, _ := web.arena.Database.GetMatchesByType(model.Qualification, true)
+	for i := 0; i < 6; i++ {
+		matches[i].Status = game.RedWonMatch
+		assert.Nil(t, web.arena.Database.UpdateMatch(&matches[i]))
+	}
+
+	// Withdraw a team and add a walk-on, then reschedule the remaining matches.
+	assert.Nil(t, web.arena.Database.DeleteTeam(101))
+	assert.Nil(t, web.arena.Database.CreateTeam(&model.Team{Id: 254}))
+	recorder = web.postHttpResponse("/setup/schedule/reschedule?matchType=qualification", "")
+	assert.Equal(t, 303, recorder.Code)
+	recorder = web.getHttpResponse("/setup/schedule?matchType=qualification")
+	assert.Contains(t, recorder.Body.String(), "keeps the first 6 of the 36 existing matches")
+
+	// Check that the rescheduled matches can't be saved once the existing schedule has moved on.
+	matches[6].Status = game.BlueWonMatch
+	assert.Nil(t, web.arena.Database.UpdateMatch(&matches[6]))
+	recorder = web.postHttpResponse("/setup/schedule/save?matchType=qualification", "")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "the existing schedule has changed since they were generated")
+
+	recorder = web.postHttpResponse("/setup/schedule/reschedule?matchType=qualification", "")
+	assert.Equal(t, 303, recorder.Code)
+
+	// Check that nothing is deleted if the loaded match is being replaced while it is in progress.
+	assert.Nil(t, web.arena.LoadMatch(&matches[7]))
+	web.arena.MatchState = field.AutoPeriod
+	recorder = web.postHttpResponse("/setup/schedule/save?matchType=qualification", "")
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Can't save the rescheduled matches: match Q8 is in progress on Field 1")
+	unchangedMatches, _ := web.arena.Database.GetMatchesByType(model.Qualification, true)
+	assert.Equal(t, matches, unchangedMatches)
+	web.arena.MatchState = field.PreMatch
+
+	recorder = web.postHttpResponse("/setup/schedule/save?matchType=qualification", "")
+	assert.Equal(t, 303, recorder.Code)
+	assert.Equal(t, model.Test, web.arena.CurrentMatch.Type)
+	newMatches, _ := web.arena.Database.GetMatchesByType(model.Qualification, true)
+	assert.Equal(t, matches[:7], newMatches[:7])
+	hasWalkOn := false
+	for i, match := range newMatches {
+		assert.Equal(t, i+1, match.TypeOrder)
+		assert.Equal(t, i+1, match.TbaMatchKey.MatchNumber)
+		if i >= 7 {
+			for _, teamId := range []int{match.Red1, match.Red2, match.Red3, match.Blue1, match.Blue2, match.Blue3} {
+				assert.NotEqual(t, 101, teamId)
+				hasWalkOn = hasWalkOn || teamId == 254
+			}
+		}
+	}
+	assert.True(t, hasWalkOn)
+	location, _ := time.LoadLocation("Local")
+	assert.Equal(t, time.Date(2014, 1, 1, 9, 56, 0, 0, location).Unix(), newMatches[7].Time.Unix())
+	recorder = web.getHttpResponse("/setup/schedule?matchType=qualification")
+	assert.NotContains(t, recorder.Body.String(), "existing matches. Saving it")
+}
@@ -15,6 +15,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Team254/cheesy-arena/field"
+	"github.com/Team254/cheesy-arena/game"
 	"github.com/Team254/cheesy-arena/model"
 	"github.com/Team254/cheesy-arena/network"
 )
@@ -43,6 +45,54 @@ func (web *Web) settingsPostHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	previousAdminPassword := eventSettings.AdminPassword
 
+	teamsPerAlliance := eventSettings.TeamsPerAlliance
+	if value := r.PostFormValue("teamsPerAlliance"); value != "" {
+		teamsPerAlliance, _ = strconv.Atoi(value)
+	}
+	if teamsPerAlliance < model.MinTeamsPerAlliance || teamsPerAlliance > model.MaxTeamsPerAlliance {
+		web.renderSettings(
+			w,
+			r,
+			fmt.Sprintf(
+				"Number of teams per alliance must be between %d and %d.",
+				model.MinTeamsPerAlliance,
+				model.MaxTeamsPerAlliance,
+			),
+		)
+		return
+	}
+	if eventSettings.TeamsPerAlliance != teamsPerAlliance {
+		matches, err := web.arena.Database.GetMatchesByType(model.Qualification, false)
+		if err != nil {
+			handleWebErr(w, err)
+			return
+		}
+		if len(matches) > 0 {
+			web.renderSettings(
+				w, r, "Cannot change the number of teams per alliance after the qualification schedule has been saved.",
+			)
+			return
+		}
+	}
+	eventSettings.TeamsPerAlliance = teamsPerAlliance
+
+	numFields := eventSettings.NumFields
+	if value := r.PostFormValue("numFields"); value != "" {
+		numFields, _ = strconv.Atoi(value)
+	}
+	if numFields < 1 || numFields > model.MaxNumFields {
+		web.renderSettings(w, r, fmt.Sprintf("Number of fields must be between 1 and %d.", model.MaxNumFields))
+		return
+	}
+	if numFields > 1 && strings.TrimSpace(r.PostFormValue("fieldNetworkAdapter")) == "" {
+		web.renderSettings(
+			w, r, "A field network adapter must be selected when running more than one field, so that each field "+
+				"listens for driver stations on its own address.",
+		)
+		return
+	}
+	eventSettings.NumFields = numFields
+
 	var playoffType model.PlayoffType
 	numAlliances := 0
 	if r.PostFormValue("playoffType") == "SingleEliminationPlayoff" {
@@ -75,6 +125,12 @@ func (web *Web) settingsPostHandler(w http.ResponseWriter, r *http.Request) {
 	eventSettings.SelectionRound2Order = r.PostFormValue("selectionRound2Order")
 	eventSettings.SelectionRound3Order = r.PostFormValue("selectionRound3Order")
 	eventSettings.SelectionShowUnpickedTeams = r.PostFormValue("selectionShowUnpickedTeams") == "on"
+	eventSettings.PlayoffMinTurnaroundSec, _ = strconv.Atoi(r.PostFormValue("playoffMinTurnaroundSec"))
+	eventSettings.PlayoffTiebreakers = strings.TrimSpace(r.PostFormValue("playoffTiebreakers"))
+	if _, err := game.ParsePlayoffTiebreakers(eventSettings.PlayoffTiebreakers); err != nil {
+		web.renderSettings(w, r, err.Error())
+		return
+	}
 	eventSettings.TbaDownloadEnabled = r.PostFormValue("tbaDownloadEnabled") == "on"
 	eventSettings.TbaPublishingEnabled = r.PostFormValue("tbaPublishingEnabled") == "on"
 	eventSettings.TbaEventCode = r.PostFormValue("tbaEventCode")
@@ -123,6 +179,15 @@ func (web *Web) settingsPostHandler(w http.ResponseWriter, r *http.Request) {
 	eventSettings.UseLiteUdpPort = r.PostFormValue("useLiteUdpPort") == "on"
 	eventSettings.BlackmagicAddresses = r.PostFormValue("blackmagicAddresses")
 	eventSettings.ManualMatchAdvance = r.PostFormValue("manualMatchAdvance") == "on"
+	eventSettings.PracticeOpenQueueEnabled = r.PostFormValue("practiceOpenQueueEnabled") == "on"
+	eventSettings.QueueCallLeadMatches, _ = strconv.Atoi(r.PostFormValue("queueCallLeadMatches"))
+	eventSettings.QueueLateLeadMatches, _ = strconv.Atoi(r.PostFormValue("queueLateLeadMatches"))
+	eventSettings.InspectionRequired = r.PostFormValue("inspectionRequired") == "on"
+	eventSettings.InspectionChecklist = r.PostFormValue("inspectionChecklist")
+	eventSettings.VersionComplianceRequired = r.PostFormValue("versionComplianceRequired") == "on"
+	eventSettings.AllowedDsVersions = r.PostFormValue("allowedDsVersions")
+	eventSettings.AllowedRioVersions = r.PostFormValue("allowedRioVersions")
+	eventSettings.AllowedRadioFirmwareVersions = r.PostFormValue("allowedRadioFirmwareVersions")
 	eventSettings.UseStationRpiStops = r.PostFormValue("useStationRpiStops") == "on"
 	eventSettings.StationRpiSecret = strings.TrimSpace(r.PostFormValue("stationRpiSecret"))
 	eventSettings.WarmupDurationSec, _ = strconv.Atoi(r.PostFormValue("warmupDurationSec"))
@@ -137,8 +202,8 @@ func (web *Web) settingsPostHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Refresh the arena in case any of the settings changed.
-	err = web.arena.LoadSettings()
+	// Refresh the arena of every field in case any of the settings changed.
+	err = web.arena.ForEachField((*field.Arena).LoadSettings)
 	if err != nil {
 		handleWebErr(w, err)
 		return
@@ -227,12 +292,17 @@ func (web *Web) restoreDbHandler(w http.ResponseWriter, r *http.Request) {
 		handleWebErr(w, err)
 		return
 	}
-	web.arena.Database, err = model.OpenDatabase(web.arena.Database.Path)
+	database, err := model.OpenDatabase(web.arena.Database.Path)
 	if err != nil {
 		handleWebErr(w, err)
 		return
 	}
-	err = web.arena.LoadSettings()
+	err = web.arena.ForEachField(
+		func(fieldArena *field.Arena) error {
+			fieldArena.Database = database
+			return fieldArena.LoadSettings()
+		},
+	)
 	if err != nil {
 		handleWebErr(w, err)
 		return
@@ -419,11 +489,21 @@ func (web *Web) renderSettings(w http.ResponseWriter, r *http.Request, errorMess
 	}
 	data := struct {
 		*model.EventSettings
-		ErrorMessage           string
-		NetworkAdapters        []network.NetworkAdapter
-		SelectedAdapterIp      string
-		SelectedAdapterMissing bool
-	}{web.arena.EventSettings, errorMessage, adapters, selectedAdapterIp, selectedAdapterMissing}
+		ErrorMessage              string
+		NetworkAdapters           []network.NetworkAdapter
+		SelectedAdapterIp         string
+		SelectedAdapterMissing    bool
+		DefaultPlayoffTiebreakers string
+		ValidPlayoffTiebreakers   string
+	}{
+		web.arena.EventSettings,
+		errorMessage,
+		adapters,
+		selectedAdapterIp,
+		selectedAdapterMissing,
+		strings.Join(game.DefaultPlayoffTiebreakers, ", "),
+		game.ValidPlayoffTiebreakers(),
+	}
 	err = template.ExecuteTemplate(w, "base", data)
 	if err != nil {
 		handleWebErr(w, err)
@@ -438,27 +518,37 @@ func (web *Web) deleteMatchDataForType(matchType model.MatchType) error {
 		return err
 	}
 	for _, match := range matches {
-		// Loop to delete all match results for the match before deleting the match itself.
-		matchResult, err := web.arena.Database.GetMatchResultForMatch(match.Id)
-		if err != nil {
+		if err = web.deleteMatchAndResults(match.Id); err != nil {
 			return err
 		}
-		for matchResult != nil {
-			if err = web.arena.Database.DeleteMatchResult(matchResult.Id); err != nil {
-				return err
-			}
-			matchResult, err = web.arena.Database.GetMatchResultForMatch(match.Id)
-			if err != nil {
-				return err
-			}
-		}
+	}
+	if err = web.arena.Database.DeleteScheduledBreaksByMatchType(matchType); err != nil {
+		return err
+	}
+	return nil
+}
 
-		if err = web.arena.Database.DeleteMatch(match.Id); err != nil {
+// Deletes the given match along with all of its results, field events and team queue statuses.
+func (web *Web) deleteMatchAndResults(matchId int) error {
+	// Loop to delete all match results for the match before deleting the match itself.
+	matchResult, err := web.arena.Database.GetMatchResultForMatch(matchId)
+	if err != nil {
+		return err
+	}
+	for matchResult != nil {
+		if err = web.arena.Database.DeleteMatchResult(matchResult.Id); err != nil {
+			return err
+		}
+		matchResult, err = web.arena.Database.GetMatchResultForMatch(matchId)
+		if err != nil {
 			return err
 		}
 	}
-	if err = web.arena.Database.DeleteScheduledBreaksByMatchType(matchType); err != nil {
+	if err = web.arena.Database.DeleteFieldEventsByMatchId(matchId); err != nil {
 		return err
 	}
-	return nil
+	if err = web.arena.Database.DeleteTeamQueueStatusesByMatchId(matchId); err != nil {
+		return err
+	}
+	return web.arena.Database.DeleteMatch(matchId)
 }
@@ -70,6 +70,40 @@ func TestSetupSettingsInvalidValues(t *testing.T) {
 	assert.Contains(t, recorder.Body.String(), "Cannot change playoff type or size after alliance selection")
 }
 
+func TestSetupSettingsTeamsPerAlliance(t *testing.T) {
+	web := setupTestWeb(t)
+
+	recorder := web.postHttpResponse("/setup/settings", "teamsPerAlliance=4")
+	assert.Equal(t, 303, recorder.Code)
+	assert.Equal(t, 4, web.arena.EventSettings.TeamsPerAlliance)
+	assert.Equal(t, []string{"R1", "R2", "R3", "R4", "B1", "B2", "B3", "B4"}, web.arena.AllianceStationNames())
+
+	recorder = web.postHttpResponse("/setup/settings", "teamsPerAlliance=5")
+	assert.Contains(t, recorder.Body.String(), "must be between 2 and 4")
+	assert.Equal(t, 4, web.arena.EventSettings.TeamsPerAlliance)
+
+	// Changing the number of teams after the qualification schedule is saved.
+	assert.Nil(t, web.arena.Database.CreateMatch(&model.Match{Type: model.Qualification}))
+	recorder = web.postHttpResponse("/setup/settings", "teamsPerAlliance=2")
+	assert.Contains(t, recorder.Body.String(), "Cannot change the number of teams per alliance")
+	assert.Equal(t, 4, web.arena.EventSettings.TeamsPerAlliance)
+}
+
+func TestSetupSettingsNumFields(t *testing.T) {
+	web := setupTestWeb(t)
+
+	recorder := web.postHttpResponse("/setup/settings", "numFields=2")
+	assert.Contains(t, recorder.Body.String(), "A field network adapter must be selected")
+	assert.Equal(t, 1, web.arena.EventSettings.NumFields)
+	recorder = web.postHttpResponse("/setup/settings", "numFields=2&fieldNetworkAdapter=eth1")
+	assert.Equal(t, 303, recorder.Code)
+	assert.Equal(t, 2, web.arena.EventSettings.NumFields)
+
+	recorder = web.postHttpResponse("/setup/settings", "numFields=0")
+	assert.Contains(t, recorder.Body.String(), "Number of fields must be between 1 and 4")
+	assert.Equal(t, 2, web.arena.EventSettings.NumFields)
+}
+
 func TestSetupSettingsClearDb(t *testing.T) {
 	createData := func(web *Web) {
 		assert.Nil(t, web.arena.Database.CreateTeam(&model.Team{Id: 254}))
@@ -80,6 +114,9 @@ func TestSetupSettingsClearDb(t *testing.T) {
 		assert.Nil(t, web.arena.Database.CreateMatchResult(&model.MatchResult{MatchId: 1, PlayNumber: 2}))
 		assert.Nil(t, web.arena.Database.CreateMatchResult(&model.MatchResult{MatchId: 2, PlayNumber: 1}))
 		assert.Nil(t, web.arena.Database.CreateMatchResult(&model.MatchResult{MatchId: 3, PlayNumber: 1}))
+		assert.Nil(t, web.arena.Database.AppendFieldEvents(1, []model.FieldEvent{{MatchId: 1, Description: "Auto"}}))
+		assert.Nil(t, web.arena.Database.AppendFieldEvents(2, []model.FieldEvent{{MatchId: 2, Description: "Auto"}}))
+		assert.Nil(t, web.arena.Database.CreateTeamQueueStatus(&model.TeamQueueStatus{MatchId: 1, TeamId: 254}))
 		assert.Nil(t, web.arena.Database.CreateRanking(&game.Ranking{TeamId: 254}))
 		assert.Nil(t, web.arena.Database.CreateAlliance(&model.Alliance{Id: 1}))
 		web.arena.AllianceSelectionAlliances = append(web.arena.AllianceSelectionAlliances, model.Alliance{Id: 1})
@@ -96,6 +133,12 @@ func TestSetupSettingsClearDb(t *testing.T) {
 	assert.Empty(t, matches)
 	matchResult, _ := web.arena.Database.GetMatchResultForMatch(1)
 	assert.Nil(t, matchResult)
+	fieldEvents, _ := web.arena.Database.GetFieldEventsByMatchId(1)
+	assert.Empty(t, fieldEvents)
+	queueStatuses, _ := web.arena.Database.GetTeamQueueStatusesByMatchId(1)
+	assert.Empty(t, queueStatuses)
+	fieldEvents, _ = web.arena.Database.GetFieldEventsByMatchId(2)
+	assert.NotEmpty(t, fieldEvents)
 	matches, _ = web.arena.Database.GetMatchesByType(model.Qualification, true)
 	assert.NotEmpty(t, matches)
 	matchResult, _ = web.arena.Database.GetMatchResultForMatch(2)
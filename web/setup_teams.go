@@ -14,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Team254/cheesy-arena/field"
 	"github.com/Team254/cheesy-arena/model"
 	"github.com/dchest/uniuri"
 )
@@ -121,6 +122,18 @@ func (web *Web) teamsClearHandler(w http.ResponseWriter, r *http.Request) {
 		handleWebErr(w, err)
 		return
 	}
+	if err = web.arena.Database.TruncateTeamInspections(); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	if err = web.arena.Database.TruncateTeamVersions(); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	if err = web.arena.ForEachField((*field.Arena).LoadInspections); err != nil {
+		handleWebErr(w, err)
+		return
+	}
 	http.Redirect(w, r, "/setup/teams", 303)
 }
 
@@ -191,6 +204,19 @@ func (web *Web) teamEditPostHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 	team.HasConnected = r.PostFormValue("hasConnected") == "on"
+	if team.NotAvailableBefore, err = parseAvailabilityTime(r.PostFormValue("notAvailableBefore")); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	if team.NotAvailableAfter, err = parseAvailabilityTime(r.PostFormValue("notAvailableAfter")); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	if !team.NotAvailableBefore.IsZero() && !team.NotAvailableAfter.IsZero() &&
+		!team.NotAvailableAfter.After(team.NotAvailableBefore) {
+		handleWebErr(w, fmt.Errorf("Not available after time must be later than not available before time."))
+		return
+	}
 	err = web.arena.Database.UpdateTeam(team)
 	if err != nil {
 		handleWebErr(w, err)
@@ -199,6 +225,19 @@ func (web *Web) teamEditPostHandler(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, "/setup/teams", 303)
 }
 
+// Parses the given availability time from the team edit form, treating a blank value as no constraint.
+func parseAvailabilityTime(value string) (time.Time, error) {
+	if strings.TrimSpace(value) == "" {
+		return time.Time{}, nil
+	}
+	location, _ := time.LoadLocation("Local")
+	availabilityTime, err := time.ParseInLocation("2006-01-02 03:04:05 PM", strings.TrimSpace(value), location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Invalid availability time: %s", value)
+	}
+	return availabilityTime, nil
+}
+
 // Removes a team from the team list.
 func (web *Web) teamDeletePostHandler(w http.ResponseWriter, r *http.Request) {
 	if !web.userIsAdmin(w, r) {
@@ -225,6 +264,14 @@ func (web *Web) teamDeletePostHandler(w http.ResponseWriter, r *http.Request) {
 		handleWebErr(w, err)
 		return
 	}
+	if err = web.deleteTeamInspection(team.Id); err != nil {
+		handleWebErr(w, err)
+		return
+	}
+	if err = web.deleteTeamVersions(team.Id); err != nil {
+		handleWebErr(w, err)
+		return
+	}
 	http.Redirect(w, r, "/setup/teams", 303)
 }
 
@@ -345,3 +392,25 @@ func (web *Web) populateOfficialTeamInfo(team *model.Team) error {
 
 	return nil
 }
+
+// Deletes the inspection record of the given team, if it has one, so that the team number doesn't carry over a passed
+// inspection if it is added again.
+func (web *Web) deleteTeamInspection(teamId int) error {
+	inspection, err := web.arena.Database.GetTeamInspectionById(teamId)
+	if err != nil || inspection == nil {
+		return err
+	}
+	if err = web.arena.Database.DeleteTeamInspection(teamId); err != nil {
+		return err
+	}
+	return web.arena.ForEachField((*field.Arena).LoadInspections)
+}
+
+// Deletes the versions last reported by the given team, if any, so that they aren't shown for it if it is added again.
+func (web *Web) deleteTeamVersions(teamId int) error {
+	versions, err := web.arena.Database.GetTeamVersionsById(teamId)
+	if err != nil || versions == nil {
+		return err
+	}
+	return web.arena.Database.DeleteTeamVersions(teamId)
+}
@@ -11,6 +11,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestSetupTeams(t *testing.T) {
@@ -126,17 +127,36 @@ func TestSetupTeams(t *testing.T) {
 	assert.Contains(t, recorder.Body.String(), "The Cheesy Poofs")
 	assert.NotContains(t, recorder.Body.String(), "Teh Chezy Pofs")
 
-	// Delete a team.
+	// Delete a team, which should also delete its inspection record and reported versions.
+	web.arena.EventSettings.InspectionRequired = true
+	passedItems := web.arena.EventSettings.InspectionChecklistItems()
+	assert.Nil(t, web.arena.Database.CreateTeamInspection(&model.TeamInspection{Id: 1114, PassedItems: passedItems}))
+	assert.Nil(t, web.arena.Database.CreateTeamInspection(&model.TeamInspection{Id: 254, PassedItems: passedItems}))
+	assert.Nil(t, web.arena.LoadInspections())
+	assert.True(t, web.arena.IsTeamInspectionPassed(1114))
+	assert.Nil(t, web.arena.Database.CreateTeamVersions(&model.TeamVersions{Id: 1114, DsVersion: "25.0"}))
+	assert.Nil(t, web.arena.Database.CreateTeamVersions(&model.TeamVersions{Id: 254, DsVersion: "25.0"}))
 	recorder = web.postHttpResponse("/setup/teams/1114/delete", "")
 	assert.Equal(t, 303, recorder.Code)
 	recorder = web.getHttpResponse("/setup/teams")
 	assert.Contains(t, recorder.Body.String(), "2 teams")
+	inspection, _ := web.arena.Database.GetTeamInspectionById(1114)
+	assert.Nil(t, inspection)
+	assert.False(t, web.arena.IsTeamInspectionPassed(1114))
+	assert.True(t, web.arena.IsTeamInspectionPassed(254))
+	versions, _ := web.arena.Database.GetTeamVersionsById(1114)
+	assert.Nil(t, versions)
 
 	// Test clearing all teams.
 	recorder = web.postHttpResponse("/setup/teams/clear", "")
 	assert.Equal(t, 303, recorder.Code)
 	recorder = web.getHttpResponse("/setup/teams")
 	assert.Contains(t, recorder.Body.String(), "0 teams")
+	inspections, _ := web.arena.Database.GetAllTeamInspections()
+	assert.Empty(t, inspections)
+	assert.False(t, web.arena.IsTeamInspectionPassed(254))
+	allVersions, _ := web.arena.Database.GetAllTeamVersions()
+	assert.Empty(t, allVersions)
 }
 
 func TestSetupTeamsDisallowModification(t *testing.T) {
@@ -226,3 +246,38 @@ func TestSetupTeamsProgress(t *testing.T) {
 	assert.Equal(t, 200, recorder.Code)
 	assert.Equal(t, "25", recorder.Body.String())
 }
+
+func TestSetupTeamsEditAvailability(t *testing.T) {
+	web := setupTestWeb(t)
+
+	assert.Nil(t, web.arena.Database.CreateTeam(&model.Team{Id: 254}))
+	recorder := web.postHttpResponse(
+		"/setup/teams/254/edit",
+		"notAvailableBefore=2026-03-14 10:30:00 AM&notAvailableAfter=2026-03-15 02:00:00 PM",
+	)
+	assert.Equal(t, 303, recorder.Code)
+	team, _ := web.arena.Database.GetTeamById(254)
+	location, _ := time.LoadLocation("Local")
+	assert.True(t, time.Date(2026, 3, 14, 10, 30, 0, 0, location).Equal(team.NotAvailableBefore))
+	assert.True(t, time.Date(2026, 3, 15, 14, 0, 0, 0, location).Equal(team.NotAvailableAfter))
+	recorder = web.getHttpResponse("/setup/teams/254/edit")
+	assert.Contains(t, recorder.Body.String(), "2026-03-14 10:30:00 AM")
+	assert.Contains(t, recorder.Body.String(), "2026-03-15 02:00:00 PM")
+
+	// Check that blank values clear the constraints.
+	recorder = web.postHttpResponse("/setup/teams/254/edit", "notAvailableBefore=&notAvailableAfter=")
+	assert.Equal(t, 303, recorder.Code)
+	team, _ = web.arena.Database.GetTeamById(254)
+	assert.False(t, team.HasAvailabilityConstraints())
+
+	// Check that invalid values are rejected.
+	recorder = web.postHttpResponse("/setup/teams/254/edit", "notAvailableBefore=tomorrow")
+	assert.Equal(t, 500, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Invalid availability time: tomorrow")
+	recorder = web.postHttpResponse(
+		"/setup/teams/254/edit",
+		"notAvailableBefore=2026-03-15 02:00:00 PM&notAvailableAfter=2026-03-14 10:30:00 AM",
+	)
+	assert.Equal(t, 500, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "must be later than")
+}
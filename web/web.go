@@ -53,6 +53,17 @@ func NewWeb(arena *field.Arena) *Web {
 		"add": func(a, b int) int {
 			return a + b
 		},
+		"formatDuration": func(sec int) string {
+			sign := ""
+			if sec < 0 {
+				sign = "-"
+				sec = -sec
+			}
+			if sec >= 3600 {
+				return fmt.Sprintf("%s%d:%02d:%02d", sign, sec/3600, sec%3600/60, sec%60)
+			}
+			return fmt.Sprintf("%s%d:%02d", sign, sec/60, sec%60)
+		},
 		"itoa": func(a int) string {
 			return strconv.Itoa(a)
 		},
@@ -89,12 +100,14 @@ func NewWeb(arena *field.Arena) *Web {
 
 // Starts the webserver and blocks, waiting on requests. Does not return until the application exits.
 func (web *Web) ServeWebInterface(port int) {
-	http.Handle("/static/", http.StripPrefix("/static/", addNoCacheHeader(http.FileServer(http.Dir("static/")))))
-	http.Handle("/", web.newHandler())
-	log.Printf("Serving HTTP requests on port %d", port)
+	// Each field's web interface gets its own handler, since several may be served from the same process.
+	handler := http.NewServeMux()
+	handler.Handle("/static/", http.StripPrefix("/static/", addNoCacheHeader(http.FileServer(http.Dir("static/")))))
+	handler.Handle("/", web.newHandler())
+	log.Printf("Serving HTTP requests for Field %d on port %d", web.arena.FieldNumber, port)
 
 	// Start Server
-	http.ListenAndServe(fmt.Sprintf(":%d", port), nil)
+	http.ListenAndServe(fmt.Sprintf(":%d", port), handler)
 }
 
 // Serves the root page of Cheesy Arena.
@@ -136,7 +149,10 @@ func (web *Web) newHandler() http.Handler {
 	mux.HandleFunc("POST /alliance_selection/start", web.allianceSelectionStartHandler)
 	mux.HandleFunc("GET /api/alliances", web.alliancesApiHandler)
 	mux.HandleFunc("GET /api/arena/websocket", web.arenaWebsocketApiHandler)
+	mux.HandleFunc("GET /api/bracket", web.bracketApiHandler)
 	mux.HandleFunc("GET /api/bracket/svg", web.bracketSvgApiHandler)
+	mux.HandleFunc("GET /api/cycle_time/{type}", web.cycleTimeApiHandler)
+	mux.HandleFunc("GET /api/division_winner", web.divisionWinnerApiHandler)
 	mux.HandleFunc("GET /api/matches/{type}", web.matchesApiHandler)
 	mux.HandleFunc("GET /api/rankings", web.rankingsApiHandler)
 	mux.HandleFunc("GET /api/sponsor_slides", web.sponsorSlidesApiHandler)
@@ -156,10 +172,14 @@ func (web *Web) newHandler() http.Handler {
 	mux.HandleFunc("GET /displays/bracket/websocket", web.bracketDisplayWebsocketHandler)
 	mux.HandleFunc("GET /displays/field_monitor", web.fieldMonitorDisplayHandler)
 	mux.HandleFunc("GET /displays/field_monitor/websocket", web.fieldMonitorDisplayWebsocketHandler)
+	mux.HandleFunc("GET /displays/judging_queue", web.judgingQueueDisplayHandler)
+	mux.HandleFunc("GET /displays/judging_queue/slots", web.judgingQueueDisplaySlotsHandler)
+	mux.HandleFunc("GET /displays/judging_queue/websocket", web.judgingQueueDisplayWebsocketHandler)
 	mux.HandleFunc("GET /displays/logo", web.logoDisplayHandler)
 	mux.HandleFunc("GET /displays/logo/websocket", web.logoDisplayWebsocketHandler)
 	mux.HandleFunc("GET /displays/queueing", web.queueingDisplayHandler)
 	mux.HandleFunc("GET /displays/queueing/match_load", web.queueingDisplayMatchLoadHandler)
+	mux.HandleFunc("POST /displays/queueing/practice_queue/join", web.queueingDisplayPracticeQueueJoinHandler)
 	mux.HandleFunc("GET /displays/queueing/websocket", web.queueingDisplayWebsocketHandler)
 	mux.HandleFunc("GET /displays/rankings", web.rankingsDisplayHandler)
 	mux.HandleFunc("GET /displays/rankings/websocket", web.rankingsDisplayWebsocketHandler)
@@ -175,12 +195,17 @@ func (web *Web) newHandler() http.Handler {
 	mux.HandleFunc("GET /match_play/match_load", web.matchPlayMatchLoadHandler)
 	mux.HandleFunc("GET /match_play/websocket", web.matchPlayWebsocketHandler)
 	mux.HandleFunc("GET /match_logs", web.matchLogsHandler)
+	mux.HandleFunc("GET /match_logs/{matchId}/events", web.matchLogsEventsGetHandler)
 	mux.HandleFunc("GET /match_logs/{matchId}/{stationId}/log", web.matchLogsViewGetHandler)
 	mux.HandleFunc("GET /match_review", web.matchReviewHandler)
 	mux.HandleFunc("GET /match_review/{matchId}/edit", web.matchReviewEditGetHandler)
 	mux.HandleFunc("POST /match_review/{matchId}/edit", web.matchReviewEditPostHandler)
+	mux.HandleFunc("GET /match_review/{matchId}/replay", web.matchReviewReplayGetHandler)
+	mux.HandleFunc("POST /match_review/{matchId}/replay", web.matchReviewReplayPostHandler)
 	mux.HandleFunc("GET /panels/scoring/{position}", web.scoringPanelHandler)
 	mux.HandleFunc("GET /panels/scoring/{position}/websocket", web.scoringPanelWebsocketHandler)
+	mux.HandleFunc("GET /panels/queueing", web.queueingPanelHandler)
+	mux.HandleFunc("GET /panels/queueing/websocket", web.queueingPanelWebsocketHandler)
 	mux.HandleFunc("GET /panels/referee", web.refereePanelHandler)
 	mux.HandleFunc("GET /panels/referee/foul_list", web.refereePanelFoulListHandler)
 	mux.HandleFunc("GET /panels/referee/websocket", web.refereePanelWebsocketHandler)
@@ -188,21 +213,32 @@ func (web *Web) newHandler() http.Handler {
 	mux.HandleFunc("GET /reports/csv/fta", web.ftaCsvReportHandler)
 	mux.HandleFunc("GET /reports/csv/rankings", web.rankingsCsvReportHandler)
 	mux.HandleFunc("GET /reports/csv/schedule/{type}", web.scheduleCsvReportHandler)
+	mux.HandleFunc("GET /reports/csv/schedule_quality/{type}", web.scheduleQualityCsvReportHandler)
+	mux.HandleFunc("GET /reports/csv/team_health", web.teamHealthCsvReportHandler)
 	mux.HandleFunc("GET /reports/csv/teams", web.teamsCsvReportHandler)
 	mux.HandleFunc("GET /reports/csv/wpa_keys", web.wpaKeysCsvReportHandler)
+	mux.HandleFunc("GET /reports/html/bracket", web.bracketHtmlReportHandler)
+	mux.HandleFunc("GET /reports/html/cycle_time/{type}", web.cycleTimeHtmlReportHandler)
+	mux.HandleFunc("GET /reports/html/schedule_quality/{type}", web.scheduleQualityHtmlReportHandler)
+	mux.HandleFunc("GET /reports/html/team_health", web.teamHealthHtmlReportHandler)
+	mux.HandleFunc("GET /reports/html/version_compliance", web.versionComplianceHtmlReportHandler)
 	mux.HandleFunc("GET /reports/pdf/alliances", web.alliancesPdfReportHandler)
 	mux.HandleFunc("GET /reports/pdf/backups", web.backupsPdfReportHandler)
 	mux.HandleFunc("GET /reports/pdf/bracket", web.bracketPdfReportHandler)
 	mux.HandleFunc("GET /reports/pdf/coupons", web.couponsPdfReportHandler)
+	mux.HandleFunc("GET /reports/pdf/inspection", web.inspectionPdfReportHandler)
 	mux.HandleFunc("GET /reports/pdf/cycle/{type}", web.cyclePdfReportHandler)
 	mux.HandleFunc("GET /reports/pdf/judging_schedule", web.judgingSchedulePdfReportHandler)
 	mux.HandleFunc("GET /reports/pdf/rankings", web.rankingsPdfReportHandler)
 	mux.HandleFunc("GET /reports/pdf/schedule/{type}", web.schedulePdfReportHandler)
+	mux.HandleFunc("GET /reports/pdf/schedule_quality/{type}", web.scheduleQualityPdfReportHandler)
 	mux.HandleFunc("GET /reports/pdf/teams", web.teamsPdfReportHandler)
 	mux.HandleFunc("GET /setup/awards", web.awardsGetHandler)
 	mux.HandleFunc("POST /setup/awards", web.awardsPostHandler)
 	mux.HandleFunc("GET /setup/breaks", web.breaksGetHandler)
 	mux.HandleFunc("POST /setup/breaks", web.breaksPostHandler)
+	mux.HandleFunc("GET /setup/championship", web.championshipGetHandler)
+	mux.HandleFunc("POST /setup/championship", web.championshipPostHandler)
 	mux.HandleFunc("POST /setup/db/clear/{type}", web.clearDbHandler)
 	mux.HandleFunc("POST /setup/db/restore", web.restoreDbHandler)
 	mux.HandleFunc("GET /setup/db/save", web.saveDbHandler)
@@ -212,13 +248,29 @@ func (web *Web) newHandler() http.Handler {
 	mux.HandleFunc("POST /setup/game_config", web.gameConfigPostHandler)
 	mux.HandleFunc("GET /setup/field_testing", web.fieldTestingGetHandler)
 	mux.HandleFunc("GET /setup/field_testing/websocket", web.fieldTestingWebsocketHandler)
+	mux.HandleFunc("GET /setup/fields", web.fieldsGetHandler)
+	mux.HandleFunc("POST /setup/fields", web.fieldsPostHandler)
+	mux.HandleFunc("GET /setup/hooks", web.hooksGetHandler)
+	mux.HandleFunc("POST /setup/hooks", web.hooksPostHandler)
+	mux.HandleFunc("GET /setup/inspection", web.inspectionGetHandler)
+	mux.HandleFunc("POST /setup/inspection", web.inspectionPostHandler)
 	mux.HandleFunc("GET /setup/judging", web.judgingGetHandler)
+	mux.HandleFunc("POST /setup/judging/blackouts", web.judgingBlackoutPostHandler)
+	mux.HandleFunc("POST /setup/judging/blackouts/{id}/delete", web.judgingBlackoutDeletePostHandler)
 	mux.HandleFunc("POST /setup/judging/clear", web.judgingClearPostHandler)
 	mux.HandleFunc("POST /setup/judging/generate", web.judgingGeneratePostHandler)
+	mux.HandleFunc("POST /setup/judging/panels", web.judgingPanelPostHandler)
+	mux.HandleFunc("POST /setup/judging/panels/{id}/delete", web.judgingPanelDeletePostHandler)
 	mux.HandleFunc("GET /setup/lower_thirds", web.lowerThirdsGetHandler)
 	mux.HandleFunc("GET /setup/lower_thirds/websocket", web.lowerThirdsWebsocketHandler)
+	mux.HandleFunc("GET /setup/practice_queue", web.practiceQueueGetHandler)
+	mux.HandleFunc("POST /setup/practice_queue/clear", web.practiceQueueClearPostHandler)
+	mux.HandleFunc("POST /setup/practice_queue/join", web.practiceQueueJoinPostHandler)
+	mux.HandleFunc("POST /setup/practice_queue/leave", web.practiceQueueLeavePostHandler)
+	mux.HandleFunc("POST /setup/practice_queue/load_next", web.practiceQueueLoadNextPostHandler)
 	mux.HandleFunc("GET /setup/schedule", web.scheduleGetHandler)
 	mux.HandleFunc("POST /setup/schedule/generate", web.scheduleGeneratePostHandler)
+	mux.HandleFunc("POST /setup/schedule/reschedule", web.scheduleReschedulePostHandler)
 	mux.HandleFunc("POST /setup/schedule/save", web.scheduleSavePostHandler)
 	mux.HandleFunc("GET /setup/settings", web.settingsGetHandler)
 	mux.HandleFunc("POST /setup/settings", web.settingsPostHandler)
@@ -48,6 +48,19 @@ func (web *Web) postHttpResponse(path string, body string) *httptest.ResponseRec
 	return recorder
 }
 
+func (web *Web) postHttpResponseWithHeaders(
+	path string, body string, headers map[string]string,
+) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; param=value")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	web.newHandler().ServeHTTP(recorder, req)
+	return recorder
+}
+
 // Starts a real local HTTP server that can be used by more sophisticated tests.
 func (web *Web) startTestServer() (*httptest.Server, string) {
 	server := httptest.NewServer(web.newHandler())